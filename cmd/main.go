@@ -2,13 +2,20 @@ package cmd
 
 import (
 	"context"
+	"data_processor/internal/auth"
+	"data_processor/internal/common"
 	"data_processor/internal/repo"
+	"data_processor/internal/scanevents"
 	data_processor "data_processor/internal/transport"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc"
 	"log"
 	"net"
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 func main() {
@@ -22,16 +29,138 @@ func main() {
 	// Инициализация репозиториев
 	repositories := repo.NewPgxRepository(pool)
 
-	// Создание gRPC сервера
+	// Переводим пароли, сохранённые в открытом виде, на argon2id
+	migrated, err := repositories.MigrateLegacyPasswords(context.Background())
+	if err != nil {
+		log.Fatalf("failed to migrate legacy passwords: %v", err)
+	}
+	if migrated > 0 {
+		log.Printf("migrated %d legacy passwords to argon2id", migrated)
+	}
+
+	// Администраторы, назначаемые при старте (нужны, чтобы создать первых пользователей)
+	for _, name := range strings.Split(os.Getenv("ADMIN_USERS"), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		user, err := repositories.GetUserByName(context.Background(), name)
+		if common.IsNotFound(err) {
+			log.Printf("admin user %s not found", name)
+			continue
+		}
+		if err != nil {
+			log.Fatalf("failed to get admin user %s: %v", name, err)
+		}
+		if err := repositories.SetUserAdmin(context.Background(), user.ID, true); err != nil {
+			log.Fatalf("failed to grant admin to %s: %v", name, err)
+		}
+	}
+
+	// Ключи подписи access token
+	accessTTL := durationFromEnv("AUTH_ACCESS_TOKEN_TTL", 15*time.Minute)
+	refreshTTL := durationFromEnv("AUTH_REFRESH_TOKEN_TTL", 30*24*time.Hour)
+	rotation := durationFromEnv("AUTH_KEY_ROTATION_INTERVAL", 24*time.Hour)
+	keysDir := os.Getenv("AUTH_KEYS_DIR")
+	keys := auth.NewKeySet(rotation + accessTTL)
+	if err := refreshSigningKeys(keys, keysDir); err != nil {
+		log.Fatalf("failed to initialize signing keys: %v", err)
+	}
+	go func() {
+		// Каталог ключей перечитываем чаще, чтобы подхватывать новые файлы без перезапуска
+		interval := rotation
+		if keysDir != "" {
+			interval = time.Minute
+		}
+		for range time.Tick(interval) {
+			if err := refreshSigningKeys(keys, keysDir); err != nil {
+				log.Printf("failed to refresh signing keys: %v", err)
+			}
+		}
+	}()
+
+	issuer := os.Getenv("AUTH_ISSUER")
+	if issuer == "" {
+		issuer = "data_processor"
+	}
+	tokens := auth.NewTokenIssuer(keys, issuer, accessTTL, refreshTTL)
+
+	// JWKS для проверки токенов сторонними сервисами
+	jwksAddr := os.Getenv("JWKS_ADDR")
+	if jwksAddr == "" {
+		jwksAddr = ":8081"
+	}
+	go func() {
+		mux := http.NewServeMux()
+		mux.Handle("/.well-known/jwks.json", keys)
+		log.Printf("JWKS served on %s", jwksAddr)
+		if err := http.ListenAndServe(jwksAddr, mux); err != nil {
+			log.Fatalf("failed to serve JWKS: %v", err)
+		}
+	}()
+
+	// Каждый метод должен иметь политику авторизации
+	if err := data_processor.ValidateMethodPolicies(); err != nil {
+		log.Fatalf("invalid authorization policies: %v", err)
+	}
+
+	// Ключ подписи page token должен совпадать на всех репликах, иначе курсор не переживёт балансировку
+	var pageTokens *auth.PageTokenSigner
+	if secret := os.Getenv("PAGE_TOKEN_SECRET"); secret != "" {
+		pageTokens = auth.NewPageTokenSigner([]byte(secret))
+	} else {
+		log.Printf("PAGE_TOKEN_SECRET is not set, page tokens are valid only within this process")
+		pageTokens, err = auth.NewRandomPageTokenSigner()
+		if err != nil {
+			log.Fatalf("failed to initialize page tokens: %v", err)
+		}
+	}
+
+	server := data_processor.NewServer(repositories, tokens, pageTokens)
+
+	// Очередь сканов: просроченные аренды возвращаются в очередь и без обращений воркеров
+	scanQueue := common.ScanQueueOptions{
+		LeaseTTL:        durationFromEnv("SCAN_LEASE_TTL", common.DefaultScanQueueOptions.LeaseTTL),
+		MaxAttempts:     intFromEnv("SCAN_MAX_ATTEMPTS", common.DefaultScanQueueOptions.MaxAttempts),
+		RetryBackoff:    durationFromEnv("SCAN_RETRY_BACKOFF", common.DefaultScanQueueOptions.RetryBackoff),
+		MaxRetryBackoff: durationFromEnv("SCAN_MAX_RETRY_BACKOFF", common.DefaultScanQueueOptions.MaxRetryBackoff),
+	}
+	server.SetScanQueueOptions(scanQueue)
+	go func() {
+		for range time.Tick(scanQueue.LeaseTTL / 2) {
+			requeued, failed, err := repositories.RequeueExpiredScans(context.Background(), scanQueue)
+			if err != nil {
+				log.Printf("failed to requeue expired scans: %v", err)
+				continue
+			}
+			if requeued > 0 || failed > 0 {
+				log.Printf("expired scan leases: %d requeued, %d failed", requeued, failed)
+			}
+		}
+	}()
+
+	// Изменения сканов с любой реплики приходят через LISTEN/NOTIFY и будят подписки WatchScan
+	scanEvents := scanevents.NewHub()
+	server.SetScanEvents(scanEvents)
+	go scanEvents.Run(context.Background(), repositories.ListenScanChanges, 5*time.Second)
+
+	// Создание gRPC сервера. Запрос проверяется до авторизации: политики читают id ресурсов
+	// из запроса и должны получать уже проверенные значения
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-			log.Printf("gRPC method: %s", info.FullMethod)
-			return handler(ctx, req)
-		}),
+		grpc.ChainUnaryInterceptor(
+			func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+				log.Printf("gRPC method: %s", info.FullMethod)
+				return handler(ctx, req)
+			},
+			data_processor.ValidationUnaryInterceptor(),
+			server.AuthUnaryInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			data_processor.ValidationStreamInterceptor(),
+			server.AuthStreamInterceptor(),
+		),
 	)
 
-	server := data_processor.NewServer(repositories)
-
 	// Регистрация сервисов
 	data_processor.RegisterUserServiceServer(grpcServer, server)
 	data_processor.RegisterOrganizationServiceServer(grpcServer, server)
@@ -43,6 +172,7 @@ func main() {
 	data_processor.RegisterScanRuleServiceServer(grpcServer, server)
 	data_processor.RegisterPermissionServiceServer(grpcServer, server)
 	data_processor.RegisterRoleServiceServer(grpcServer, server)
+	data_processor.RegisterAuthServiceServer(grpcServer, server)
 
 	// Запуск сервера
 	lis, err := net.Listen("tcp", ":50051")
@@ -55,3 +185,37 @@ func main() {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+// refreshSigningKeys загружает ключи из каталога, если он задан (ротацию выполняет оператор,
+// ключи общие для всех реплик), иначе генерирует новый ключ в памяти.
+func refreshSigningKeys(keys *auth.KeySet, dir string) error {
+	if dir != "" {
+		return keys.LoadDir(dir)
+	}
+	_, err := keys.Rotate()
+	return err
+}
+
+func durationFromEnv(name string, def time.Duration) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return def
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", name, err)
+	}
+	return d
+}
+
+func intFromEnv(name string, def int) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", name, err)
+	}
+	return n
+}
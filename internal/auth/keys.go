@@ -0,0 +1,201 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SigningKey is an Ed25519 key used to sign access tokens.
+type SigningKey struct {
+	ID        string
+	Private   ed25519.PrivateKey
+	CreatedAt time.Time
+}
+
+// KeySet holds the active signing key and the previous keys that are still
+// accepted for verification until the tokens signed with them expire.
+type KeySet struct {
+	mu        sync.RWMutex
+	keys      []*SigningKey // от старых к новым, последний — активный
+	retention time.Duration
+}
+
+// NewKeySet creates an empty key set. Keys that stopped being active more than
+// retention ago are dropped on rotation; retention should exceed the access token TTL.
+func NewKeySet(retention time.Duration) *KeySet {
+	return &KeySet{retention: retention}
+}
+
+// Rotate generates a new key and makes it active.
+func (k *KeySet) Rotate() (*SigningKey, error) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	kid := make([]byte, 8)
+	if _, err := rand.Read(kid); err != nil {
+		return nil, fmt.Errorf("failed to generate key id: %w", err)
+	}
+
+	key := &SigningKey{ID: hex.EncodeToString(kid), Private: priv, CreatedAt: time.Now()}
+	k.Add(key)
+	return key, nil
+}
+
+// Add makes the key active and prunes keys that are past retention.
+func (k *KeySet) Add(key *SigningKey) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.keys = append(k.keys, key)
+	k.pruneLocked(time.Now())
+}
+
+// LoadDir replaces the key set with the PKCS#8 PEM Ed25519 keys found in dir.
+// The key id is the file name without extension; the most recently modified
+// file becomes the active key. This lets several replicas share keys that are
+// rotated by the operator.
+func (k *KeySet) LoadDir(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no signing keys found in %s", dir)
+	}
+
+	keys := make([]*SigningKey, 0, len(files))
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return fmt.Errorf("%s: no PEM block found", file)
+		}
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		priv, ok := parsed.(ed25519.PrivateKey)
+		if !ok {
+			return fmt.Errorf("%s: not an Ed25519 private key", file)
+		}
+		keys = append(keys, &SigningKey{
+			ID:        strings.TrimSuffix(filepath.Base(file), filepath.Ext(file)),
+			Private:   priv,
+			CreatedAt: info.ModTime(),
+		})
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].CreatedAt.Before(keys[j].CreatedAt) })
+
+	k.mu.Lock()
+	k.keys = keys
+	k.mu.Unlock()
+	return nil
+}
+
+// Active returns the key new tokens are signed with.
+func (k *KeySet) Active() (*SigningKey, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if len(k.keys) == 0 {
+		return nil, errors.New("no active signing key")
+	}
+	return k.keys[len(k.keys)-1], nil
+}
+
+// PublicKey returns the verification key with the given id.
+func (k *KeySet) PublicKey(kid string) (ed25519.PublicKey, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	for _, key := range k.keys {
+		if key.ID == kid {
+			return key.Private.Public().(ed25519.PublicKey), true
+		}
+	}
+	return nil, false
+}
+
+func (k *KeySet) pruneLocked(now time.Time) {
+	if k.retention <= 0 || len(k.keys) < 2 {
+		return
+	}
+
+	// Ключ остаётся валидным retention после того, как его сменил следующий
+	kept := k.keys[:0]
+	for i, key := range k.keys {
+		if i < len(k.keys)-1 && now.Sub(k.keys[i+1].CreatedAt) > k.retention {
+			continue
+		}
+		kept = append(kept, key)
+	}
+	k.keys = kept
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+}
+
+// JWKS returns the public keys as a JSON Web Key Set.
+func (k *KeySet) JWKS() ([]byte, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	set := struct {
+		Keys []jwk `json:"keys"`
+	}{Keys: make([]jwk, 0, len(k.keys))}
+
+	for _, key := range k.keys {
+		set.Keys = append(set.Keys, jwk{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(key.Private.Public().(ed25519.PublicKey)),
+			Kid: key.ID,
+			Use: "sig",
+			Alg: "EdDSA",
+		})
+	}
+
+	return json.Marshal(set)
+}
+
+// ServeHTTP serves the JWKS document, e.g. at /.well-known/jwks.json.
+func (k *KeySet) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	body, err := k.JWKS()
+	if err != nil {
+		http.Error(w, "failed to build JWKS", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "max-age=300")
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"data_processor/internal/common"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+type pageTokenPayload struct {
+	List string         `json:"l"`
+	Key  common.PageKey `json:"k"`
+}
+
+// PageTokenSigner encodes keyset positions into opaque page tokens. Tokens are signed with
+// HMAC-SHA256 and bound to a list, so a client can neither forge a position nor reuse a token
+// from another list.
+type PageTokenSigner struct {
+	secret []byte
+}
+
+func NewPageTokenSigner(secret []byte) *PageTokenSigner {
+	return &PageTokenSigner{secret: secret}
+}
+
+// NewRandomPageTokenSigner creates a signer with a random secret. Tokens stay valid only
+// within this process.
+func NewRandomPageTokenSigner() (*PageTokenSigner, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate page token secret: %w", err)
+	}
+	return NewPageTokenSigner(secret), nil
+}
+
+// Sign returns a token pointing right after key in the given list.
+func (s *PageTokenSigner) Sign(list string, key common.PageKey) (string, error) {
+	payload, err := json.Marshal(pageTokenPayload{List: list, Key: key})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(s.mac(payload)), nil
+}
+
+// Verify checks the signature and that the token was issued for the given list.
+func (s *PageTokenSigner) Verify(token, list string) (common.PageKey, error) {
+	encodedPayload, encodedMAC, found := strings.Cut(token, ".")
+	if !found {
+		return common.PageKey{}, ErrInvalidPageToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return common.PageKey{}, ErrInvalidPageToken
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil {
+		return common.PageKey{}, ErrInvalidPageToken
+	}
+	if !hmac.Equal(mac, s.mac(payload)) {
+		return common.PageKey{}, ErrInvalidPageToken
+	}
+
+	var decoded pageTokenPayload
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return common.PageKey{}, ErrInvalidPageToken
+	}
+	// Токен одного списка нельзя подставить в другой (например, версии другого приложения)
+	if decoded.List != list {
+		return common.PageKey{}, fmt.Errorf("%w: issued for another list", ErrInvalidPageToken)
+	}
+	return decoded.Key, nil
+}
+
+func (s *PageTokenSigner) mac(payload []byte) []byte {
+	h := hmac.New(sha256.New, s.secret)
+	h.Write(payload)
+	return h.Sum(nil)
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"data_processor/internal/common"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageTokenSigner(t *testing.T) {
+	signer := NewPageTokenSigner([]byte("secret"))
+	key := common.PageKey{Time: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), ID: 42}
+
+	t.Run("Round Trip", func(t *testing.T) {
+		token, err := signer.Sign("scans:7", key)
+		require.NoError(t, err)
+
+		decoded, err := signer.Verify(token, "scans:7")
+		require.NoError(t, err)
+		assert.Equal(t, key.ID, decoded.ID)
+		assert.True(t, key.Time.Equal(decoded.Time))
+	})
+
+	t.Run("Other List", func(t *testing.T) {
+		token, err := signer.Sign("scans:7", key)
+		require.NoError(t, err)
+
+		_, err = signer.Verify(token, "scans:8")
+		assert.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+
+	t.Run("Tampered Token", func(t *testing.T) {
+		token, err := signer.Sign("users", common.PageKey{ID: 1})
+		require.NoError(t, err)
+
+		// Подменяем позицию, сохраняя подпись
+		forged, err := NewPageTokenSigner([]byte("other")).Sign("users", common.PageKey{ID: 1000})
+		require.NoError(t, err)
+		payload, _, _ := strings.Cut(forged, ".")
+		_, mac, _ := strings.Cut(token, ".")
+
+		_, err = signer.Verify(payload+"."+mac, "users")
+		assert.ErrorIs(t, err, ErrInvalidPageToken)
+
+		_, err = signer.Verify("garbage", "users")
+		assert.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+}
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Лёгкие параметры, чтобы тесты не тратили время на argon2
+var testPasswordParams = PasswordParams{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func TestHashPassword(t *testing.T) {
+	hash, err := HashPassword("secret", testPasswordParams)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+	assert.True(t, IsPasswordHash(hash))
+
+	other, err := HashPassword("secret", testPasswordParams)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other, "salt must differ between hashes")
+}
+
+func TestVerifyPassword(t *testing.T) {
+	hash, err := HashPassword("secret", testPasswordParams)
+	require.NoError(t, err)
+
+	t.Run("Correct password", func(t *testing.T) {
+		ok, rehash, err := VerifyPassword("secret", hash, testPasswordParams)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.False(t, rehash)
+	})
+
+	t.Run("Wrong password", func(t *testing.T) {
+		ok, rehash, err := VerifyPassword("wrong", hash, testPasswordParams)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.False(t, rehash)
+	})
+
+	t.Run("Changed parameters require rehash", func(t *testing.T) {
+		stronger := testPasswordParams
+		stronger.Iterations = 2
+		ok, rehash, err := VerifyPassword("secret", hash, stronger)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, rehash)
+	})
+
+	t.Run("Legacy plaintext", func(t *testing.T) {
+		ok, rehash, err := VerifyPassword("secret", "secret", testPasswordParams)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, rehash)
+
+		ok, rehash, err = VerifyPassword("wrong", "secret", testPasswordParams)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.False(t, rehash)
+	})
+
+	t.Run("Plaintext with hash prefix", func(t *testing.T) {
+		for _, stored := range []string{"$argon2id$v=19$broken", "$argon2id$v=19$m=1024,t=1,p=1$$"} {
+			assert.False(t, IsPasswordHash(stored), stored)
+
+			ok, rehash, err := VerifyPassword(stored, stored, testPasswordParams)
+			require.NoError(t, err)
+			assert.True(t, ok, stored)
+			assert.True(t, rehash, stored)
+
+			ok, _, err = VerifyPassword("secret", stored, testPasswordParams)
+			require.NoError(t, err)
+			assert.False(t, ok, stored)
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"context"
+	"data_processor/internal/common"
+)
+
+// Principal is the authenticated caller of an RPC.
+type Principal struct {
+	UserID    common.UserID
+	SessionID int
+	IsAdmin   bool
+	IsWorker  bool
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the caller set by the authorization interceptor.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
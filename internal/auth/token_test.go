@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestIssuer(t *testing.T) (*TokenIssuer, *KeySet) {
+	keys := NewKeySet(time.Hour)
+	_, err := keys.Rotate()
+	require.NoError(t, err)
+	return NewTokenIssuer(keys, "data_processor", 15*time.Minute, 24*time.Hour), keys
+}
+
+func TestTokenIssuer(t *testing.T) {
+	t.Run("Issue and Verify", func(t *testing.T) {
+		issuer, _ := newTestIssuer(t)
+
+		token, expiresAt, err := issuer.Issue(42, 7)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(15*time.Minute), expiresAt, time.Second)
+
+		claims, err := issuer.Verify(token)
+		require.NoError(t, err)
+		userID, err := claims.UserID()
+		require.NoError(t, err)
+		assert.Equal(t, 42, userID)
+		assert.Equal(t, 7, claims.SessionID)
+	})
+
+	t.Run("Tampered Token", func(t *testing.T) {
+		issuer, _ := newTestIssuer(t)
+		token, _, err := issuer.Issue(42, 7)
+		require.NoError(t, err)
+
+		parts := strings.Split(token, ".")
+		other, _, err := issuer.Issue(1, 1)
+		require.NoError(t, err)
+		forged := parts[0] + "." + strings.Split(other, ".")[1] + "." + parts[2]
+
+		_, err = issuer.Verify(forged)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("Expired Token", func(t *testing.T) {
+		issuer, _ := newTestIssuer(t)
+		token, _, err := issuer.Issue(42, 7)
+		require.NoError(t, err)
+
+		issuer.now = func() time.Time { return time.Now().Add(time.Hour) }
+		_, err = issuer.Verify(token)
+		assert.ErrorIs(t, err, ErrExpiredToken)
+	})
+
+	t.Run("Key Rotation", func(t *testing.T) {
+		issuer, keys := newTestIssuer(t)
+		oldToken, _, err := issuer.Issue(42, 7)
+		require.NoError(t, err)
+
+		_, err = keys.Rotate()
+		require.NoError(t, err)
+		newToken, _, err := issuer.Issue(42, 7)
+		require.NoError(t, err)
+
+		// Токены, подписанные предыдущим ключом, остаются валидными
+		_, err = issuer.Verify(oldToken)
+		require.NoError(t, err)
+		_, err = issuer.Verify(newToken)
+		require.NoError(t, err)
+
+		var set struct {
+			Keys []map[string]string `json:"keys"`
+		}
+		body, err := keys.JWKS()
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &set))
+		assert.Len(t, set.Keys, 2)
+	})
+
+	t.Run("Retention Drops Old Keys", func(t *testing.T) {
+		keys := NewKeySet(time.Minute)
+		seed, err := keys.Rotate()
+		require.NoError(t, err)
+
+		// old сменился на next две минуты назад, next сменяется только сейчас
+		keys = NewKeySet(time.Minute)
+		keys.Add(&SigningKey{ID: "old", Private: seed.Private, CreatedAt: time.Now().Add(-3 * time.Minute)})
+		keys.Add(&SigningKey{ID: "next", Private: seed.Private, CreatedAt: time.Now().Add(-2 * time.Minute)})
+		_, err = keys.Rotate()
+		require.NoError(t, err)
+
+		_, ok := keys.PublicKey("old")
+		assert.False(t, ok)
+		_, ok = keys.PublicKey("next")
+		assert.True(t, ok)
+	})
+}
+
+func TestRefreshToken(t *testing.T) {
+	token, hash, err := NewRefreshToken()
+	require.NoError(t, err)
+	assert.Equal(t, hash, HashRefreshToken(token))
+	assert.Len(t, hash, 64)
+}
@@ -0,0 +1,114 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorKind classifies domain errors; the transport maps each kind to a gRPC status code.
+type ErrorKind int
+
+const (
+	// KindNotFound: the requested entity does not exist.
+	KindNotFound ErrorKind = iota + 1
+	// KindAlreadyExists: the entity would duplicate an existing one.
+	KindAlreadyExists
+	// KindInvalidReference: a field refers to an entity that does not exist.
+	KindInvalidReference
+	// KindConflict: the entity is in a state that does not allow the operation.
+	KindConflict
+	// KindValidation: a field value is not acceptable.
+	KindValidation
+)
+
+// Error is a domain error returned by repositories for conditions the caller can act on.
+// Resource and ID name the entity involved; Field names the offending request field.
+// Err keeps the underlying database error for logs and is not part of the message,
+// which is safe to return to clients.
+type Error struct {
+	Kind     ErrorKind
+	Resource string
+	ID       string
+	Field    string
+	Msg      string
+	Err      error
+}
+
+func (e *Error) Error() string {
+	msg := e.Msg
+	if msg == "" {
+		switch e.Kind {
+		case KindNotFound:
+			msg = "not found"
+		case KindAlreadyExists:
+			msg = "already exists"
+		case KindInvalidReference:
+			msg = "refers to a missing entity"
+		case KindConflict:
+			msg = "conflict"
+		case KindValidation:
+			msg = "invalid value"
+		}
+	}
+	if e.Resource != "" {
+		msg = e.Resource + " " + msg
+	}
+	if e.Field != "" {
+		msg = e.Field + ": " + msg
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is makes errors.Is(err, ErrNotFound) and the other kind sentinels match any error of that kind.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Kind == e.Kind && t.Resource == "" && t.ID == "" && t.Field == "" && t.Msg == ""
+}
+
+// Sentinels matching every domain error of their kind with errors.Is.
+var (
+	ErrNotFound         = &Error{Kind: KindNotFound}
+	ErrAlreadyExists    = &Error{Kind: KindAlreadyExists}
+	ErrInvalidReference = &Error{Kind: KindInvalidReference}
+	ErrConflict         = &Error{Kind: KindConflict}
+	ErrValidation       = &Error{Kind: KindValidation}
+)
+
+// ErrRevisionMismatch is returned by conditional updates and deletes when the row exists but
+// its revision differs from the expected one, i.e. it was changed concurrently.
+var ErrRevisionMismatch = &Error{Kind: KindConflict, Msg: "revision mismatch"}
+
+// NotFoundError reports that the resource with the given id does not exist.
+func NotFoundError(resource string, id any) error {
+	return &Error{Kind: KindNotFound, Resource: resource, ID: fmt.Sprint(id)}
+}
+
+// AlreadyExistsError reports that a resource with the same key already exists.
+func AlreadyExistsError(resource, msg string) error {
+	return &Error{Kind: KindAlreadyExists, Resource: resource, Msg: msg}
+}
+
+// InvalidReferenceError reports that field refers to a resource that does not exist.
+func InvalidReferenceError(field, resource string, id any) error {
+	return &Error{Kind: KindInvalidReference, Field: field, Resource: resource, ID: fmt.Sprint(id),
+		Msg: fmt.Sprintf("with id %v does not exist", id)}
+}
+
+// ValidationError reports that the value of field is not acceptable.
+func ValidationError(field, msg string) error {
+	return &Error{Kind: KindValidation, Field: field, Msg: msg}
+}
+
+// ConflictError reports that the resource state does not allow the operation.
+func ConflictError(resource, msg string) error {
+	return &Error{Kind: KindConflict, Resource: resource, Msg: msg}
+}
+
+// IsNotFound reports whether err is a KindNotFound domain error.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
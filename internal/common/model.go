@@ -6,9 +6,15 @@ type UserID int
 type TeamID int
 type OrgID int
 type User struct {
-	ID       UserID
-	Name     string
+	ID   UserID
+	Name string
+	// Password is the plaintext password passed to CreateUser/UpdateUser; it is never read back
 	Password string
+	// PasswordHash is the stored argon2id hash (or a legacy plaintext value not yet migrated)
+	PasswordHash string
+	IsAdmin      bool
+	// IsWorker allows leasing and processing scans of every organization
+	IsWorker bool
 }
 
 type Role struct {
@@ -19,6 +25,7 @@ type Role struct {
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	OwnerID     UserID
+	Revision    int
 }
 type PermissionReadWrite struct {
 	Read  bool
@@ -34,12 +41,16 @@ type Permission struct {
 	Write          bool
 	OrganizationID *int
 	TeamID         *int
+	Revision       int
 }
 
 type Organization struct {
 	ID          int
 	ProjectName string
 	OwnerID     UserID
+	// Revision is incremented by every update. A non-zero Revision passed to an update or delete
+	// makes it conditional, see ErrRevisionMismatch.
+	Revision int
 }
 
 type Team struct {
@@ -48,6 +59,7 @@ type Team struct {
 	OwnerID        UserID
 	Folder         *string
 	OrganizationID int
+	Revision       int
 }
 
 type Application struct {
@@ -55,6 +67,7 @@ type Application struct {
 	Name        string
 	Description *string
 	TeamID      int
+	Revision    int
 }
 
 type Version struct {
@@ -64,20 +77,192 @@ type Version struct {
 }
 
 type Scan struct {
-	ID        int
+	ID int
+	// ScanDate is the moment the scan was created
 	ScanDate  time.Time
 	VersionID int
+	Status    ScanStatus
+	// Type is empty for scans created before scan types were recorded
+	Type        ScanType
+	InitiatorID *UserID
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+	// ExitReason explains why a scan failed or was cancelled
+	ExitReason *string
+	// Attempts counts the leases of the scan, see ScanQueueOptions
+	Attempts int
+	// AvailableAt is the earliest moment a queued scan may be leased
+	AvailableAt    time.Time
+	LeaseOwner     *string
+	LeaseExpiresAt *time.Time
+	// Phase, Progress (in percent) and ProgressMessage are reported by the worker running the scan
+	Phase           *string
+	Progress        int
+	ProgressMessage *string
+}
+
+// ScanProgress is a progress report of the worker running a scan.
+type ScanProgress struct {
+	Phase   string
+	Percent int
+	Message string
+}
+
+// ScanLease is a scan handed to a worker together with the scan rules of its application.
+type ScanLease struct {
+	Scan *Scan
+	Rule *EffectiveScanRule
 }
 
+// ScanQueueOptions configure the scan queue. A lease that is not renewed for LeaseTTL expires;
+// the scan is queued again after RetryBackoff, doubled for each further attempt up to
+// MaxRetryBackoff, and fails once it has been leased MaxAttempts times.
+type ScanQueueOptions struct {
+	LeaseTTL        time.Duration
+	MaxAttempts     int
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+}
+
+var DefaultScanQueueOptions = ScanQueueOptions{
+	LeaseTTL:        5 * time.Minute,
+	MaxAttempts:     3,
+	RetryBackoff:    30 * time.Second,
+	MaxRetryBackoff: 10 * time.Minute,
+}
+
+// ScanStatus is the lifecycle state of a scan, see ScanStatus.CanTransitionTo.
+type ScanStatus string
+
+const (
+	ScanQueued    ScanStatus = "queued"
+	ScanRunning   ScanStatus = "running"
+	ScanSucceeded ScanStatus = "succeeded"
+	ScanFailed    ScanStatus = "failed"
+	ScanCancelled ScanStatus = "cancelled"
+)
+
+type ScanType string
+
+const (
+	ScanTypeSAST ScanType = "sast"
+	ScanTypeSCA  ScanType = "sca"
+)
+
 type ScanInfo struct {
 	ID     int
 	ScanID int
 }
 
+// Finding is a single result reported by a scanning tool. Fingerprint identifies the same issue
+// across scans; a tool that does not provide one gets Finding.DefaultFingerprint.
+type Finding struct {
+	ID          int
+	ScanID      int
+	Tool        string
+	ToolVersion *string
+	RuleID      string
+	Severity    Severity
+	CWE         *int
+	File        *string
+	StartLine   *int
+	EndLine     *int
+	Message     string
+	Fingerprint string
+	// PartialFingerprints are the fingerprints contributed by the tool, keyed by their kind
+	PartialFingerprints map[string]string
+	// Suppression is nil unless the finding was suppressed
+	Suppression *Suppression
+}
+
+// Suppression records that a finding was suppressed, in the source code (for example with a
+// "nolint" comment) or outside of it. A rejected suppression or one under review keeps the
+// finding in effect.
+type Suppression struct {
+	Kind          SuppressionKind
+	Status        SuppressionStatus
+	Justification string
+}
+
+type SuppressionKind string
+
+const (
+	SuppressionInSource SuppressionKind = "in_source"
+	SuppressionExternal SuppressionKind = "external"
+)
+
+type SuppressionStatus string
+
+const (
+	SuppressionAccepted    SuppressionStatus = "accepted"
+	SuppressionUnderReview SuppressionStatus = "under_review"
+	SuppressionRejected    SuppressionStatus = "rejected"
+)
+
+// BaselineState tells how a finding compares to the previous scan: it is new, unchanged (a
+// finding with the same tool and fingerprint was reported before), or absent (it was reported
+// by the previous scan only).
+type BaselineState string
+
+const (
+	BaselineNew       BaselineState = "new"
+	BaselineUnchanged BaselineState = "unchanged"
+	BaselineAbsent    BaselineState = "absent"
+)
+
+// FindingRule describes a rule of the tool that reported the findings of a scan.
+type FindingRule struct {
+	ScanID           int
+	Tool             string
+	RuleID           string
+	Name             string
+	ShortDescription string
+	FullDescription  string
+	Help             string
+	HelpURI          string
+	Tags             []string
+}
+
+// Component is a package listed in the software bill of materials of a scan. Ref identifies it
+// within the scan: the bom-ref of a CycloneDX component or the SPDXID of an SPDX package.
+type Component struct {
+	ID     int
+	ScanID int
+	Ref    string
+	// Primary marks the component the bill of materials describes, e.g. the application itself
+	Primary bool
+	// Name includes the CycloneDX group, e.g. org.apache.commons/commons-lang3
+	Name    string
+	Version *string
+	PURL    *string
+	// Licenses are SPDX license identifiers, license names or SPDX license expressions
+	Licenses []string
+	// Hashes are hex digests keyed by algorithm in the CycloneDX spelling: SHA-256, MD5, ...
+	Hashes map[string]string
+	// DependsOn are the refs of the direct dependencies of the component
+	DependsOn []string
+}
+
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityLow      Severity = "low"
+	SeverityMedium   Severity = "medium"
+	SeverityHigh     Severity = "high"
+	SeverityCritical Severity = "critical"
+)
+
+// Severities lists the severities from the least to the most severe.
+var Severities = []Severity{SeverityInfo, SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical}
+
+// ScanRule holds scan settings for an organization, a team or an application. The level is the
+// most specific scope that is set: a team rule has no ApplicationID, an organization rule neither
+// TeamID nor ApplicationID. Settings left nil are inherited from the wider levels.
 type ScanRule struct {
 	ID                    int
-	ApplicationID         int
-	TeamID                int
+	ApplicationID         *int
+	TeamID                *int
 	OrganizationID        int
 	SCAScanEnabled        *bool
 	SASTScanEnabled       *bool
@@ -86,6 +271,67 @@ type ScanRule struct {
 	ExcludeDirRegexpQueue []string
 	ForcedDoOwnSBOM       *bool
 	ActiveBlockingSCA     *bool
+	Revision              int
+}
+
+// ScanRuleLevel is the scope a scan rule applies to.
+type ScanRuleLevel string
+
+const (
+	ScanRuleLevelOrganization ScanRuleLevel = "organization"
+	ScanRuleLevelTeam         ScanRuleLevel = "team"
+	ScanRuleLevelApplication  ScanRuleLevel = "application"
+)
+
+// ScanRuleSource tells which rule of the chain supplied an effective setting.
+type ScanRuleSource struct {
+	Field  string
+	Level  ScanRuleLevel
+	RuleID int
+}
+
+// EffectiveScanRule is the scan rule of an application merged over the organization → team → application chain.
+type EffectiveScanRule struct {
+	ApplicationID  int
+	TeamID         int
+	OrganizationID int
+	// Settings holds the merged values; its ID, scope and Revision are not set
+	Settings ScanRule
+	// Sources has an entry for every setting supplied by some level, in field order
+	Sources []ScanRuleSource
+	// Chain holds the rules that were merged, from the organization to the application
+	Chain []*ScanRule
+}
+
+// ScanRuleOperation is the change recorded in a scan rule revision.
+type ScanRuleOperation string
+
+const (
+	ScanRuleInserted ScanRuleOperation = "insert"
+	ScanRuleUpdated  ScanRuleOperation = "update"
+	ScanRuleDeleted  ScanRuleOperation = "delete"
+)
+
+// ScanRuleRevision is an entry of the scan rule history with snapshots of the rule before and
+// after the change. Before is nil for an insert, After for a delete.
+type ScanRuleRevision struct {
+	ID        int
+	RuleID    int
+	Operation ScanRuleOperation
+	// ActorID is nil for changes made outside of an authenticated request
+	ActorID *UserID
+	// RestoredFrom is the revision whose state the change restored
+	RestoredFrom *int
+	ChangedAt    time.Time
+	Before       *ScanRule
+	After        *ScanRule
+}
+
+// ScanRuleChange is a field that differs between two states of a scan rule.
+type ScanRuleChange struct {
+	Field string
+	Old   any
+	New   any
 }
 
 type RoleScope struct {
@@ -98,3 +344,118 @@ type RoleWithPermissions struct {
 	Permissions []*Permission
 	Scope       RoleScope
 }
+
+type Session struct {
+	ID               int
+	UserID           UserID
+	RefreshTokenHash string
+	UserAgent        *string
+	CreatedAt        time.Time
+	LastUsedAt       time.Time
+	ExpiresAt        time.Time
+	RevokedAt        *time.Time
+}
+
+// Page selects a window of a list. Zero Limit returns every row starting at Offset.
+// When After is set, the window starts right after that key (keyset pagination).
+// Filter is an AIP-160 expression and OrderBy an AIP-132 sort order over the entity's
+// filterable fields; keyset pagination applies only to the default order.
+type Page struct {
+	Limit   int
+	Offset  int
+	After   *PageKey
+	Filter  string
+	OrderBy string
+}
+
+// PageKey is the sort key of the last row of a page. Time is used only by lists ordered by date.
+// Lists with a custom OrderBy cannot use a key and continue from Offset instead.
+type PageKey struct {
+	Time   time.Time `json:"t,omitempty"`
+	ID     int       `json:"id,omitempty"`
+	Offset int       `json:"o,omitempty"`
+}
+
+// TeamMemberRole defines what a team member may do: maintainers manage the team and its applications,
+// developers change applications of the team, viewers only read.
+type TeamMemberRole string
+
+const (
+	TeamMemberMaintainer TeamMemberRole = "maintainer"
+	TeamMemberDeveloper  TeamMemberRole = "developer"
+	TeamMemberViewer     TeamMemberRole = "viewer"
+)
+
+type TeamMember struct {
+	TeamID    int
+	UserID    UserID
+	Role      TeamMemberRole
+	CreatedAt time.Time
+}
+
+type TeamMembership struct {
+	Team *Team
+	Role TeamMemberRole
+}
+
+type ResourceType string
+
+const (
+	ResourceOrganization ResourceType = "organization"
+	ResourceTeam         ResourceType = "team"
+	ResourceApplication  ResourceType = "application"
+	ResourceVersion      ResourceType = "version"
+	ResourceScan         ResourceType = "scan"
+	ResourceScanInfo     ResourceType = "scan_info"
+	ResourceScanRule     ResourceType = "scan_rule"
+)
+
+type ResourceRef struct {
+	Type ResourceType
+	ID   int
+}
+
+// ResourceScope is the place of a resource in the organization → team → application hierarchy.
+type ResourceScope struct {
+	OrganizationID      int
+	OrganizationOwnerID UserID
+	TeamID              *int
+	TeamOwnerID         *UserID
+	ApplicationID       *int
+}
+
+// GrantSource tells why a user holds a right on a resource.
+type GrantSource string
+
+const (
+	GrantSourceAdministrator     GrantSource = "administrator"
+	GrantSourceOrganizationOwner GrantSource = "organization_owner"
+	GrantSourceTeamOwner         GrantSource = "team_owner"
+	GrantSourceOrganizationRole  GrantSource = "organization_role"
+	GrantSourceTeamRole          GrantSource = "team_role"
+	GrantSourceTeamMember        GrantSource = "team_member"
+)
+
+type PermissionGrant struct {
+	Source         GrantSource
+	Read           bool
+	Write          bool
+	RoleID         *int
+	RoleName       *string
+	PermissionID   *int
+	PermissionName *string
+	OrganizationID *int
+	TeamID         *int
+	MemberRole     *TeamMemberRole
+}
+
+// EffectivePermissions are the rights of a user on a resource merged over the organization → team → application chain.
+type EffectivePermissions struct {
+	UserID   UserID
+	Resource ResourceRef
+	Scope    ResourceScope
+	// Read is also granted by write access
+	Read   bool
+	Write  bool
+	Grants []PermissionGrant
+}
@@ -1,10 +1,215 @@
 package common
 
-import "errors"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"slices"
+)
 
 func (p Permission) Validate() error {
 	if p.OrganizationID != nil && p.TeamID != nil {
-		return errors.New("permission cannot belong to both organization and team")
+		return ValidationError("team_id", "permission cannot belong to both organization and team")
 	}
 	return nil
 }
+
+func (r TeamMemberRole) Validate() error {
+	switch r {
+	case TeamMemberMaintainer, TeamMemberDeveloper, TeamMemberViewer:
+		return nil
+	}
+	return ValidationError("role", fmt.Sprintf("unknown team member role %q", r))
+}
+
+// scanTransitions — допустимые переходы статусов скана; из завершённых статусов выхода нет
+var scanTransitions = map[ScanStatus][]ScanStatus{
+	ScanQueued:  {ScanRunning, ScanCancelled},
+	ScanRunning: {ScanSucceeded, ScanFailed, ScanCancelled},
+}
+
+// CanTransitionTo reports whether a scan in status s may move to status to.
+func (s ScanStatus) CanTransitionTo(to ScanStatus) bool {
+	return slices.Contains(scanTransitions[s], to)
+}
+
+// Finished reports whether the status is final.
+func (s ScanStatus) Finished() bool {
+	return s == ScanSucceeded || s == ScanFailed || s == ScanCancelled
+}
+
+// ScanStatusesBefore returns the statuses from which a scan may move to status to.
+func ScanStatusesBefore(to ScanStatus) []ScanStatus {
+	var from []ScanStatus
+	for _, status := range []ScanStatus{ScanQueued, ScanRunning} {
+		if status.CanTransitionTo(to) {
+			from = append(from, status)
+		}
+	}
+	return from
+}
+
+// ScanEnabled reports whether the rule allows scans of the given type. An unset setting allows them.
+func (r *ScanRule) ScanEnabled(t ScanType) bool {
+	var enabled *bool
+	switch t {
+	case ScanTypeSAST:
+		enabled = r.SASTScanEnabled
+	case ScanTypeSCA:
+		enabled = r.SCAScanEnabled
+	}
+	return enabled == nil || *enabled
+}
+
+// Level returns the scope the rule applies to.
+func (r *ScanRule) Level() ScanRuleLevel {
+	switch {
+	case r.ApplicationID != nil:
+		return ScanRuleLevelApplication
+	case r.TeamID != nil:
+		return ScanRuleLevelTeam
+	}
+	return ScanRuleLevelOrganization
+}
+
+// scanRuleSettings — наследуемые поля правила в порядке proto. merge копирует значение из src,
+// если оно задано, и сообщает, было ли оно задано
+var scanRuleSettings = []struct {
+	field string
+	merge func(dst, src *ScanRule) bool
+}{
+	{"sca_scan_enabled", func(dst, src *ScanRule) bool { return mergeBool(&dst.SCAScanEnabled, src.SCAScanEnabled) }},
+	{"sast_scan_enabled", func(dst, src *ScanRule) bool { return mergeBool(&dst.SASTScanEnabled, src.SASTScanEnabled) }},
+	{"allow_incremental_scans", func(dst, src *ScanRule) bool {
+		return mergeBool(&dst.AllowIncrementalScans, src.AllowIncrementalScans)
+	}},
+	{"allow_sast_empty_code", func(dst, src *ScanRule) bool { return mergeBool(&dst.AllowSASTEmptyCode, src.AllowSASTEmptyCode) }},
+	// Пустая очередь не переопределяет унаследованную: в API пустой список не отличить от незаданного
+	{"exclude_dir_regexp_queue", func(dst, src *ScanRule) bool {
+		if len(src.ExcludeDirRegexpQueue) == 0 {
+			return false
+		}
+		dst.ExcludeDirRegexpQueue = src.ExcludeDirRegexpQueue
+		return true
+	}},
+	{"forced_do_own_sbom", func(dst, src *ScanRule) bool { return mergeBool(&dst.ForcedDoOwnSBOM, src.ForcedDoOwnSBOM) }},
+	{"active_blocking_sca", func(dst, src *ScanRule) bool { return mergeBool(&dst.ActiveBlockingSCA, src.ActiveBlockingSCA) }},
+}
+
+func mergeBool(dst **bool, src *bool) bool {
+	if src == nil {
+		return false
+	}
+	*dst = src
+	return true
+}
+
+// MergeScanRules merges a chain of rules ordered from the widest level to the most specific one.
+// Every setting is taken from the most specific rule that sets it.
+func MergeScanRules(chain []*ScanRule) (ScanRule, []ScanRuleSource) {
+	var settings ScanRule
+	var sources []ScanRuleSource
+	for _, setting := range scanRuleSettings {
+		var source *ScanRule
+		for _, rule := range chain {
+			if setting.merge(&settings, rule) {
+				source = rule
+			}
+		}
+		if source != nil {
+			sources = append(sources, ScanRuleSource{Field: setting.field, Level: source.Level(), RuleID: source.ID})
+		}
+	}
+	return settings, sources
+}
+
+// scanRuleFields — поля правила, сравниваемые DiffScanRules, в порядке proto
+var scanRuleFields = []struct {
+	field string
+	value func(r *ScanRule) any
+}{
+	{"application_id", func(r *ScanRule) any { return r.ApplicationID }},
+	{"team_id", func(r *ScanRule) any { return r.TeamID }},
+	{"organization_id", func(r *ScanRule) any { return r.OrganizationID }},
+	{"sca_scan_enabled", func(r *ScanRule) any { return r.SCAScanEnabled }},
+	{"sast_scan_enabled", func(r *ScanRule) any { return r.SASTScanEnabled }},
+	{"allow_incremental_scans", func(r *ScanRule) any { return r.AllowIncrementalScans }},
+	{"allow_sast_empty_code", func(r *ScanRule) any { return r.AllowSASTEmptyCode }},
+	{"exclude_dir_regexp_queue", func(r *ScanRule) any { return r.ExcludeDirRegexpQueue }},
+	{"forced_do_own_sbom", func(r *ScanRule) any { return r.ForcedDoOwnSBOM }},
+	{"active_blocking_sca", func(r *ScanRule) any { return r.ActiveBlockingSCA }},
+}
+
+// DiffScanRules returns the fields that differ between two states of a rule. A nil state is a
+// rule that does not exist; all its fields are nil. Pointer values are dereferenced.
+func DiffScanRules(from, to *ScanRule) []ScanRuleChange {
+	var changes []ScanRuleChange
+	for _, f := range scanRuleFields {
+		oldValue, newValue := fieldValue(from, f.value), fieldValue(to, f.value)
+		if !reflect.DeepEqual(oldValue, newValue) {
+			changes = append(changes, ScanRuleChange{Field: f.field, Old: oldValue, New: newValue})
+		}
+	}
+	return changes
+}
+
+func fieldValue(rule *ScanRule, value func(*ScanRule) any) any {
+	if rule == nil {
+		return nil
+	}
+	v := reflect.ValueOf(value(rule))
+	switch {
+	case v.Kind() == reflect.Pointer && v.IsNil(), v.Kind() == reflect.Slice && v.Len() == 0:
+		return nil
+	case v.Kind() == reflect.Pointer:
+		return v.Elem().Interface()
+	}
+	return v.Interface()
+}
+
+// SeveritiesAtLeast returns the severities that are at least as severe as min; an unknown min
+// yields none.
+func SeveritiesAtLeast(min Severity) []Severity {
+	i := slices.Index(Severities, min)
+	if i < 0 {
+		return nil
+	}
+	return Severities[i:]
+}
+
+func (f *Finding) Validate() error {
+	if !slices.Contains(Severities, f.Severity) {
+		return ValidationError("severity", fmt.Sprintf("unknown severity %q", f.Severity))
+	}
+	if f.EndLine != nil && (f.StartLine == nil || *f.EndLine < *f.StartLine) {
+		return ValidationError("end_line", "must not be before start_line")
+	}
+	if sup := f.Suppression; sup != nil {
+		if sup.Kind != SuppressionInSource && sup.Kind != SuppressionExternal {
+			return ValidationError("suppression.kind", fmt.Sprintf("unknown suppression kind %q", sup.Kind))
+		}
+		switch sup.Status {
+		case SuppressionAccepted, SuppressionUnderReview, SuppressionRejected:
+		default:
+			return ValidationError("suppression.status", fmt.Sprintf("unknown suppression status %q", sup.Status))
+		}
+	}
+	return nil
+}
+
+// Suppressed reports whether the finding has a suppression in effect.
+func (f *Finding) Suppressed() bool {
+	return f.Suppression != nil && f.Suppression.Status == SuppressionAccepted
+}
+
+// DefaultFingerprint identifies the finding by its tool, rule, file and message. Lines are left
+// out, so the fingerprint survives code being moved within the file.
+func (f *Finding) DefaultFingerprint() string {
+	file := ""
+	if f.File != nil {
+		file = *f.File
+	}
+	sum := sha256.Sum256([]byte(f.Tool + "\x00" + f.RuleID + "\x00" + file + "\x00" + f.Message))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,184 @@
+// Package excludes compiles the exclude_dir_regexp_queue of a scan rule and matches file paths
+// against it.
+//
+// Patterns use RE2 syntax and are matched, unanchored, against the directory part of a
+// slash-separated path relative to the repository root:
+//
+//	vendor           excludes vendor/lib/a.go and third_party/vendor/b.go
+//	^node_modules    excludes node_modules/x/index.js, but not web/node_modules/y.js
+//	(^|/)testdata$   excludes pkg/testdata/input.txt
+//
+// The queue is ordered: a path is excluded by the first pattern that matches it.
+package excludes
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+)
+
+const (
+	// MaxPatternLen is the maximum length of a pattern in bytes.
+	MaxPatternLen = 255
+	// maxProgramSize ограничивает размер скомпилированной программы: повторения вроде
+	// (\w{50}){20} разворачиваются в тысячу инструкций и замедляют каждое сопоставление
+	maxProgramSize = 1000
+	// maxRepeatDepth ограничивает вложенность повторений
+	maxRepeatDepth = 3
+)
+
+// Error is an invalid pattern. Index is the 0-based position of the pattern in the queue and
+// Pos the 1-based position of the offending character in the pattern.
+type Error struct {
+	Index   int
+	Pattern string
+	Pos     int
+	Msg     string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("pattern %d: %s at position %d", e.Index, e.Msg, e.Pos)
+}
+
+// Matcher is a compiled exclude queue.
+type Matcher struct {
+	patterns []string
+	regexps  []*regexp.Regexp
+}
+
+// Compile checks and compiles the patterns. The first invalid pattern is reported as *Error.
+func Compile(patterns []string) (*Matcher, error) {
+	m := &Matcher{
+		patterns: patterns,
+		regexps:  make([]*regexp.Regexp, 0, len(patterns)),
+	}
+	for i, pattern := range patterns {
+		re, err := compilePattern(pattern)
+		if err != nil {
+			err.Index = i
+			err.Pattern = pattern
+			return nil, err
+		}
+		m.regexps = append(m.regexps, re)
+	}
+	return m, nil
+}
+
+func compilePattern(pattern string) (*regexp.Regexp, *Error) {
+	if pattern == "" {
+		return nil, &Error{Pos: 1, Msg: "empty pattern"}
+	}
+	if len(pattern) > MaxPatternLen {
+		return nil, &Error{Pos: MaxPatternLen + 1, Msg: fmt.Sprintf("pattern is longer than %d bytes", MaxPatternLen)}
+	}
+
+	parsed, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, syntaxError(pattern, err)
+	}
+	if depth := repeatDepth(parsed); depth > maxRepeatDepth {
+		return nil, &Error{Pos: 1, Msg: fmt.Sprintf("repetitions are nested %d levels deep, at most %d are allowed", depth, maxRepeatDepth)}
+	}
+	prog, err := syntax.Compile(parsed.Simplify())
+	if err != nil {
+		return nil, syntaxError(pattern, err)
+	}
+	if len(prog.Inst) > maxProgramSize {
+		return nil, &Error{Pos: 1, Msg: "pattern is too complex"}
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, syntaxError(pattern, err)
+	}
+	return re, nil
+}
+
+// syntaxError переводит ошибку regexp/syntax в Error: позицией считается первое вхождение
+// фрагмента, на который указывает парсер
+func syntaxError(pattern string, err error) *Error {
+	var syntaxErr *syntax.Error
+	if !errors.As(err, &syntaxErr) {
+		return &Error{Pos: 1, Msg: err.Error()}
+	}
+	pos := 1
+	switch {
+	case syntaxErr.Code == syntax.ErrMissingParen || syntaxErr.Code == syntax.ErrUnexpectedParen:
+		// Для скобок парсер возвращает всё выражение, позицию находим сами
+		pos = unbalancedParen(pattern) + 1
+		return &Error{Pos: pos, Msg: string(syntaxErr.Code)}
+	case syntaxErr.Expr != "":
+		if i := strings.Index(pattern, syntaxErr.Expr); i >= 0 {
+			pos = i + 1
+		}
+	}
+	return &Error{Pos: pos, Msg: fmt.Sprintf("%s: `%s`", syntaxErr.Code, syntaxErr.Expr)}
+}
+
+// unbalancedParen возвращает индекс лишней закрывающей скобки или последней незакрытой
+// открывающей. Экранированные символы и классы [...] пропускаются.
+func unbalancedParen(pattern string) int {
+	var open []int
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\':
+			i++
+		case inClass:
+			inClass = c != ']'
+		case c == '[':
+			inClass = true
+			// ']' сразу после '[' или '[^' — литерал
+			if strings.HasPrefix(pattern[i+1:], "^") {
+				i++
+			}
+			if strings.HasPrefix(pattern[i+1:], "]") {
+				i++
+			}
+		case c == '(':
+			open = append(open, i)
+		case c == ')':
+			if len(open) == 0 {
+				return i
+			}
+			open = open[:len(open)-1]
+		}
+	}
+	if len(open) > 0 {
+		return open[len(open)-1]
+	}
+	return 0
+}
+
+func repeatDepth(re *syntax.Regexp) int {
+	depth := 0
+	for _, sub := range re.Sub {
+		depth = max(depth, repeatDepth(sub))
+	}
+	switch re.Op {
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		depth++
+	}
+	return depth
+}
+
+// Match returns the first pattern of the queue that excludes the file and its index.
+// ok is false if the file is not excluded.
+func (m *Matcher) Match(file string) (pattern string, index int, ok bool) {
+	dir := Dir(file)
+	for i, re := range m.regexps {
+		if re.MatchString(dir) {
+			return m.patterns[i], i, true
+		}
+	}
+	return "", 0, false
+}
+
+// Dir returns the directory the patterns are matched against: the path is cleaned and made
+// relative to the repository root. Files in the root have an empty directory.
+func Dir(file string) string {
+	return strings.TrimPrefix(path.Dir(path.Clean("/"+file)), "/")
+}
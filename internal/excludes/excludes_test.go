@@ -0,0 +1,77 @@
+package excludes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch(t *testing.T) {
+	m, err := Compile([]string{"^node_modules", "vendor", `(^|/)testdata$`})
+	require.NoError(t, err)
+
+	tests := []struct {
+		file    string
+		pattern string
+		index   int
+		ok      bool
+	}{
+		{"node_modules/x/index.js", "^node_modules", 0, true},
+		{"web/node_modules/y.js", "", 0, false},
+		{"third_party/vendor/b.go", "vendor", 1, true},
+		{"./vendor/lib/a.go", "vendor", 1, true},
+		{"pkg/testdata/input.txt", `(^|/)testdata$`, 2, true},
+		{"pkg/testdata/nested/input.txt", "", 0, false},
+		// Сопоставляется только каталог, имя файла не учитывается
+		{"vendor.go", "", 0, false},
+		{"cmd/main.go", "", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			pattern, index, ok := m.Match(tt.file)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.pattern, pattern)
+			assert.Equal(t, tt.index, index)
+		})
+	}
+}
+
+func TestDir(t *testing.T) {
+	assert.Equal(t, "", Dir("main.go"))
+	assert.Equal(t, "a/b", Dir("./a/b/c.go"))
+	assert.Equal(t, "a/b", Dir("/a/b/c.go"))
+	assert.Equal(t, "b", Dir("a/../b/c.go"))
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		index    int
+		pos      int
+		msg      string
+	}{
+		{"Unclosed Group", []string{"vendor", "build/(out"}, 1, 7, "missing closing )"},
+		{"Unexpected Paren", []string{`(a)[)]\)b)`}, 0, 10, "unexpected )"},
+		{"Bad Class", []string{"dist[z-a]"}, 0, 6, "invalid character class range"},
+		{"Bad Repetition", []string{"a**"}, 0, 2, "invalid nested repetition operator"},
+		{"Bad Escape", []string{`src\q`}, 0, 4, "invalid escape sequence"},
+		{"Empty", []string{""}, 0, 1, "empty pattern"},
+		{"Too Long", []string{strings.Repeat("a", MaxPatternLen+1)}, 0, MaxPatternLen + 1, "longer than"},
+		{"Nested Repetitions", []string{"(((a+b)*c)+d)*"}, 0, 1, "nested 4 levels deep"},
+		{"Too Complex", []string{`(\w{50}){20}`}, 0, 1, "too complex"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.patterns)
+			var patternErr *Error
+			require.ErrorAs(t, err, &patternErr)
+			assert.Equal(t, tt.index, patternErr.Index)
+			assert.Equal(t, tt.patterns[tt.index], patternErr.Pattern)
+			assert.Equal(t, tt.pos, patternErr.Pos)
+			assert.Contains(t, patternErr.Msg, tt.msg)
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package filter
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Type is the type of a filterable column; it decides which operators and literals are accepted.
+type Type int
+
+const (
+	String Type = iota
+	Int
+	Bool
+	Timestamp
+	Date
+)
+
+// Field maps a name used in filters to a column.
+type Field struct {
+	Column string
+	Type   Type
+}
+
+// Schema is the allow-list of fields of one entity. Fields missing from the schema cannot be
+// filtered or sorted on.
+type Schema map[string]Field
+
+// Compile parses the filter and returns an SQL condition. Literals are never inlined: arg
+// registers a query argument and returns its placeholder. An empty filter compiles to "".
+func Compile(input string, schema Schema, arg func(any) string) (string, error) {
+	expr, err := Parse(input)
+	if err != nil || expr == nil {
+		return "", err
+	}
+	return compile(expr, schema, arg)
+}
+
+func compile(expr Expr, schema Schema, arg func(any) string) (string, error) {
+	switch e := expr.(type) {
+	case *Logical:
+		left, err := compile(e.Left, schema, arg)
+		if err != nil {
+			return "", err
+		}
+		right, err := compile(e.Right, schema, arg)
+		if err != nil {
+			return "", err
+		}
+		return "(" + left + " " + e.Op + " " + right + ")", nil
+	case *Not:
+		inner, err := compile(e.Expr, schema, arg)
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + inner, nil
+	case *Comparison:
+		return compileComparison(e, schema, arg)
+	}
+	return "", errorf(expr.position(), "unsupported expression")
+}
+
+func compileComparison(c *Comparison, schema Schema, arg func(any) string) (string, error) {
+	field, ok := schema[c.Field]
+	if !ok {
+		return "", errorf(c.pos, "unknown field %q", c.Field)
+	}
+	column := field.Column
+
+	if !c.Value.Quoted && c.Value.Text == "null" {
+		switch c.Op {
+		case "=", ":":
+			return "(" + column + " IS NULL)", nil
+		case "!=":
+			return "(" + column + " IS NOT NULL)", nil
+		}
+		return "", errorf(c.Value.pos, "null can only be compared with = or !=")
+	}
+
+	if field.Type == String {
+		return compileString(c, column, arg)
+	}
+
+	// Для нестроковых полей «:» (has) означает равенство
+	op := c.Op
+	if op == ":" {
+		op = "="
+	}
+
+	var value any
+	switch field.Type {
+	case Int:
+		n, err := strconv.ParseInt(c.Value.Text, 10, 64)
+		if err != nil {
+			return "", errorf(c.Value.pos, "field %q expects an integer", c.Field)
+		}
+		value = n
+	case Bool:
+		b, err := strconv.ParseBool(c.Value.Text)
+		if err != nil {
+			return "", errorf(c.Value.pos, "field %q expects true or false", c.Field)
+		}
+		if op != "=" && op != "!=" {
+			return "", errorf(c.pos, "field %q only supports = and !=", c.Field)
+		}
+		value = b
+	case Timestamp, Date:
+		t, err := parseTime(c.Value.Text)
+		if err != nil {
+			return "", errorf(c.Value.pos, "field %q expects a date (2006-01-02) or an RFC 3339 timestamp", c.Field)
+		}
+		value = t
+	}
+
+	if op == "!=" {
+		op = "<>"
+	}
+	return "(" + column + " " + op + " " + arg(value) + ")", nil
+}
+
+// compileString: «=» с * превращается в LIKE, «:» ищет подстроку без учёта регистра
+func compileString(c *Comparison, column string, arg func(any) string) (string, error) {
+	pattern, wildcard := likePattern(c.Value.Text)
+	switch c.Op {
+	case ":":
+		return "(" + column + " ILIKE " + arg("%"+pattern+"%") + ")", nil
+	case "=", "!=":
+		if wildcard {
+			op := "LIKE"
+			if c.Op == "!=" {
+				op = "NOT LIKE"
+			}
+			return "(" + column + " " + op + " " + arg(pattern) + ")", nil
+		}
+		op := "="
+		if c.Op == "!=" {
+			op = "<>"
+		}
+		return "(" + column + " " + op + " " + arg(unescape(c.Value.Text)) + ")", nil
+	default:
+		return "(" + column + " " + c.Op + " " + arg(unescape(c.Value.Text)) + ")", nil
+	}
+}
+
+// likePattern переводит * в %, экранируя собственные спецсимволы LIKE
+func likePattern(text string) (string, bool) {
+	var b strings.Builder
+	wildcard := false
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; c {
+		case '\\':
+			if i+1 < len(text) {
+				i++
+				b.WriteString(`\` + string(text[i]))
+			}
+		case '*':
+			b.WriteByte('%')
+			wildcard = true
+		case '%', '_':
+			b.WriteString(`\` + string(c))
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String(), wildcard
+}
+
+func unescape(text string) string {
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\\' && i+1 < len(text) {
+			i++
+		}
+		b.WriteByte(text[i])
+	}
+	return b.String()
+}
+
+func parseTime(text string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, text); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, text)
+}
+
+// OrderBy parses an AIP-132 order_by ("name desc, id") and returns the SQL ORDER BY list.
+// The tiebreaker column is appended unless already present, so the order is always total.
+func OrderBy(input string, schema Schema, tiebreaker string) (string, error) {
+	var terms []string
+	seen := make(map[string]bool)
+
+	offset := 0
+	for _, part := range strings.Split(input, ",") {
+		words := splitWords(part, offset)
+		if len(words) == 0 {
+			return "", errorf(offset, "empty order_by term")
+		}
+		offset += len(part) + 1
+
+		field, ok := schema[words[0].text]
+		if !ok {
+			return "", errorf(words[0].pos, "unknown field %q", words[0].text)
+		}
+
+		direction := ""
+		if len(words) > 1 {
+			switch strings.ToLower(words[1].text) {
+			case "asc":
+			case "desc":
+				direction = " DESC"
+			default:
+				return "", errorf(words[1].pos, "expected asc or desc but found %q", words[1].text)
+			}
+		}
+		if len(words) > 2 {
+			return "", errorf(words[2].pos, "unexpected %q", words[2].text)
+		}
+
+		if seen[field.Column] {
+			continue
+		}
+		seen[field.Column] = true
+		terms = append(terms, field.Column+direction)
+	}
+
+	if !seen[tiebreaker] {
+		terms = append(terms, tiebreaker)
+	}
+	return strings.Join(terms, ", "), nil
+}
+
+// splitWords делит терм order_by по пробелам, запоминая позиции слов во всей строке
+func splitWords(part string, offset int) []token {
+	var result []token
+	start := -1
+	for i := 0; i <= len(part); i++ {
+		if i < len(part) && part[i] != ' ' && part[i] != '\t' {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			result = append(result, token{kind: tokenWord, text: part[start:i], pos: offset + start})
+			start = -1
+		}
+	}
+	return result
+}
@@ -0,0 +1,124 @@
+package filter
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = Schema{
+	"id":          {Column: "id", Type: Int},
+	"name":        {Column: "name", Type: String},
+	"description": {Column: "description", Type: String},
+	"team_id":     {Column: "team_id", Type: Int},
+	"is_active":   {Column: "is_active", Type: Bool},
+	"scan_date":   {Column: "scan_date", Type: Date},
+}
+
+func compileTest(input string) (string, []any, error) {
+	var args []any
+	sql, err := Compile(input, testSchema, func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	})
+	return sql, args, err
+}
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		sql   string
+		args  []any
+	}{
+		{"Empty", "  ", "", nil},
+		{"Equality", `team_id = 3`, "(team_id = $1)", []any{int64(3)}},
+		{"Wildcard", `name = "payments-*"`, "(name LIKE $1)", []any{"payments-%"}},
+		{"Bare Wildcard", `name=payments-*`, "(name LIKE $1)", []any{"payments-%"}},
+		{"LIKE Characters Escaped", `name = "a_b%*"`, "(name LIKE $1)", []any{`a\_b\%%`}},
+		{"Escaped Star", `name = "a\*"`, "(name = $1)", []any{"a*"}},
+		{"Has", `description:legacy`, "(description ILIKE $1)", []any{"%legacy%"}},
+		{"Not Equal", `name != "x"`, "(name <> $1)", []any{"x"}},
+		{"Bool", `is_active = false`, "(is_active = $1)", []any{false}},
+		{"Date", `scan_date > 2026-01-01`, "(scan_date > $1)", []any{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}},
+		{"Timestamp", `scan_date >= "2026-01-01T10:00:00Z"`, "(scan_date >= $1)", []any{time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)}},
+		{"Null", `description = null`, "(description IS NULL)", nil},
+		{"Not Null", `description != null`, "(description IS NOT NULL)", nil},
+		{"Implicit AND", `team_id = 3 name = "a*"`, "((team_id = $1) AND (name LIKE $2))", []any{int64(3), "a%"}},
+		{
+			"Precedence",
+			`team_id = 1 OR team_id = 2 AND NOT is_active = true`,
+			"((team_id = $1) OR ((team_id = $2) AND NOT (is_active = $3)))",
+			[]any{int64(1), int64(2), true},
+		},
+		{"Parentheses", `-(team_id = 1 OR team_id = 2)`, "NOT ((team_id = $1) OR (team_id = $2))", []any{int64(1), int64(2)}},
+		{"Negative Number", `id > -1`, "(id > $1)", []any{int64(-1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := compileTest(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.sql, sql)
+			assert.Equal(t, tt.args, args)
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		pos   int
+	}{
+		{"Unknown Field", `password = "x"`, 1},
+		{"Unknown Field Later", `team_id = 1 AND password = "x"`, 17},
+		{"Missing Operator", `team_id 1`, 9},
+		{"Missing Value", `team_id =`, 10},
+		{"Not An Integer", `team_id = abc`, 11},
+		{"Not A Bool", `is_active = maybe`, 13},
+		{"Bool Ordering", `is_active > true`, 1},
+		{"Bad Date", `scan_date > "yesterday"`, 13},
+		{"Unterminated String", `name = "abc`, 8},
+		{"Unbalanced Parenthesis", `(team_id = 1`, 13},
+		{"Unexpected Parenthesis", `team_id = 1)`, 12},
+		{"Bad Character", `team_id = 1 & id = 2`, 13},
+		{"Null Ordering", `description > null`, 15},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := compileTest(tt.input)
+			var filterErr *Error
+			require.ErrorAs(t, err, &filterErr)
+			assert.Equal(t, tt.pos, filterErr.Pos, filterErr.Error())
+		})
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	order, err := OrderBy("name desc, team_id", testSchema, "id")
+	require.NoError(t, err)
+	assert.Equal(t, "name DESC, team_id, id", order)
+
+	// Явно указанный tiebreaker не дублируется
+	order, err = OrderBy("id desc", testSchema, "id")
+	require.NoError(t, err)
+	assert.Equal(t, "id DESC", order)
+
+	var filterErr *Error
+	_, err = OrderBy("name, password", testSchema, "id")
+	require.ErrorAs(t, err, &filterErr)
+	assert.Equal(t, 7, filterErr.Pos)
+
+	_, err = OrderBy("name sideways", testSchema, "id")
+	require.ErrorAs(t, err, &filterErr)
+	assert.Equal(t, 6, filterErr.Pos)
+
+	_, err = OrderBy("name,,id", testSchema, "id")
+	require.ErrorAs(t, err, &filterErr)
+	assert.Equal(t, 6, filterErr.Pos)
+}
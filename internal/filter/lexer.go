@@ -0,0 +1,130 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenMinus
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) isKeyword(keyword string) bool {
+	// Ключевые слова AIP-160 пишутся только заглавными: поле может называться "and"
+	return t.kind == tokenWord && t.text == keyword
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of filter"
+	case tokenString:
+		return fmt.Sprintf("string %q", t.text)
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+func lex(input string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case c == '=' || c == ':':
+			tokens = append(tokens, token{kind: tokenOperator, text: string(c), pos: i})
+			i++
+		case c == '<' || c == '>' || c == '!':
+			if i+1 < len(input) && input[i+1] == '=' {
+				tokens = append(tokens, token{kind: tokenOperator, text: input[i : i+2], pos: i})
+				i += 2
+				continue
+			}
+			if c == '!' {
+				return nil, errorf(i, "unexpected character '!'")
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: string(c), pos: i})
+			i++
+		case c == '"' || c == '\'':
+			text, end, err := lexString(input, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: i})
+			i = end
+		case c == '-' && !(i+1 < len(input) && isDigit(input[i+1])):
+			tokens = append(tokens, token{kind: tokenMinus, text: "-", pos: i})
+			i++
+		case isWordStart(c):
+			end := i + 1
+			// Числа и даты (2026-01-01T10:00:00Z) могут содержать ':' и '+', имена полей — нет
+			numeric := isDigit(c) || c == '-'
+			for end < len(input) && (isWordChar(input[end]) || numeric && (input[end] == ':' || input[end] == '+')) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: input[i:end], pos: i})
+			i = end
+		default:
+			return nil, errorf(i, "unexpected character %q", c)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(input)}), nil
+}
+
+// lexString читает строку в кавычках; обратная косая черта экранирует следующий символ
+func lexString(input string, start int) (string, int, error) {
+	quote := input[start]
+	var b strings.Builder
+	for i := start + 1; i < len(input); i++ {
+		switch input[i] {
+		case '\\':
+			if i+1 == len(input) {
+				return "", 0, errorf(i, "unterminated escape sequence")
+			}
+			i++
+			// \* и \\ сохраняются экранированными: их разбирает компилятор шаблонов
+			if input[i] == '*' || input[i] == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(input[i])
+		case quote:
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(input[i])
+		}
+	}
+	return "", 0, errorf(start, "unterminated string")
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isWordStart(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || isDigit(c) || c == '_' || c == '*' || c == '-'
+}
+
+func isWordChar(c byte) bool {
+	return isWordStart(c) || c == '.'
+}
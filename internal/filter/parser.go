@@ -0,0 +1,198 @@
+// Package filter implements a subset of the AIP-160 filter language for list endpoints
+// and compiles it to parameterized SQL against an allow-list of columns.
+//
+// Supported syntax:
+//
+//	name = "payments-*"                  equality, * is a wildcard
+//	scan_date >= 2026-01-01              comparison: = != < <= > >=
+//	description:"legacy"                 has: substring match for strings
+//	is_active = false AND team_id = 3    AND, OR, NOT, -, parentheses
+//	description = null                   IS NULL / IS NOT NULL
+//
+// Terms separated only by whitespace are joined with AND.
+package filter
+
+import (
+	"fmt"
+)
+
+// Error is a syntax or validation error in a filter or order_by expression.
+// Pos is the 1-based position of the offending character.
+type Error struct {
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Msg, e.Pos)
+}
+
+func errorf(pos int, format string, args ...any) *Error {
+	return &Error{Pos: pos + 1, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Expr is a node of a parsed filter.
+type Expr interface {
+	position() int
+}
+
+// Logical joins two expressions with AND or OR.
+type Logical struct {
+	Op          string
+	Left, Right Expr
+	pos         int
+}
+
+// Not negates an expression.
+type Not struct {
+	Expr Expr
+	pos  int
+}
+
+// Comparison restricts a field: Field Op Value.
+type Comparison struct {
+	Field string
+	Op    string
+	Value Value
+	pos   int
+}
+
+// Value is a literal on the right side of a comparison. Quoted is false for bare words and numbers.
+type Value struct {
+	Text   string
+	Quoted bool
+	pos    int
+}
+
+func (e *Logical) position() int    { return e.pos }
+func (e *Not) position() int        { return e.pos }
+func (e *Comparison) position() int { return e.pos }
+
+// Parse parses a filter expression. An empty filter returns a nil Expr.
+func Parse(input string) (Expr, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, nil
+	}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, errorf(tok.pos, "unexpected %s", tok)
+	}
+	return expr, nil
+}
+
+type parser struct {
+	tokens []token
+	i      int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.i]
+	if tok.kind != tokenEOF {
+		p.i++
+	}
+	return tok
+}
+
+// or := and ("OR" and)*
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().isKeyword("OR") {
+		op := p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &Logical{Op: "OR", Left: left, Right: right, pos: op.pos}
+	}
+	return left, nil
+}
+
+// and := unary (["AND"] unary)*
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		switch {
+		case tok.isKeyword("AND"):
+			p.next()
+		case tok.kind == tokenEOF || tok.kind == tokenRParen || tok.isKeyword("OR"):
+			return left, nil
+		}
+		// Термы, разделённые пробелом, объединяются через AND
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &Logical{Op: "AND", Left: left, Right: right, pos: tok.pos}
+	}
+}
+
+// unary := ("NOT" | "-") unary | "(" or ")" | comparison
+func (p *parser) parseUnary() (Expr, error) {
+	tok := p.peek()
+	switch {
+	case tok.isKeyword("NOT") || tok.kind == tokenMinus:
+		p.next()
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr, pos: tok.pos}, nil
+	case tok.kind == tokenLParen:
+		p.next()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, errorf(closing.pos, "expected ) but found %s", closing)
+		}
+		return expr, nil
+	default:
+		return p.parseComparison()
+	}
+}
+
+// comparison := field op value
+func (p *parser) parseComparison() (Expr, error) {
+	field := p.next()
+	if field.kind != tokenWord || field.isKeyword("AND") || field.isKeyword("OR") {
+		return nil, errorf(field.pos, "expected field name but found %s", field)
+	}
+
+	op := p.next()
+	if op.kind != tokenOperator {
+		return nil, errorf(op.pos, "expected comparison operator after %q but found %s", field.text, op)
+	}
+
+	value := p.next()
+	if value.kind != tokenWord && value.kind != tokenString {
+		return nil, errorf(value.pos, "expected value but found %s", value)
+	}
+
+	return &Comparison{
+		Field: field.text,
+		Op:    op.text,
+		Value: Value{Text: value.text, Quoted: value.kind == tokenString, pos: value.pos},
+		pos:   field.pos,
+	}, nil
+}
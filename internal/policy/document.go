@@ -0,0 +1,214 @@
+// Package policy describes scan rules as a document keyed by organization, team and application
+// names, so that they can be kept in version control and applied to the database:
+//
+//	version: 1
+//	organizations:
+//	  - name: acme
+//	    rule:
+//	      sca_scan_enabled: true
+//	      exclude_dir_regexp_queue: [vendor, node_modules]
+//	    teams:
+//	      - name: payments
+//	        applications:
+//	          - name: billing
+//	            rule:
+//	              active_blocking_sca: true
+//
+// A node without a rule only leads to its children. The document is authoritative for the
+// organizations it lists: applying it deletes their rules that the document does not mention.
+// Organizations that are not listed are left alone. JSON documents use the same keys.
+package policy
+
+import (
+	"bytes"
+	"data_processor/internal/excludes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Version is the only supported document version.
+const Version = 1
+
+// Format is the encoding of a document.
+type Format int
+
+const (
+	YAML Format = iota
+	JSON
+)
+
+type Document struct {
+	Version       int            `yaml:"version" json:"version"`
+	Organizations []Organization `yaml:"organizations" json:"organizations"`
+}
+
+type Organization struct {
+	Name  string `yaml:"name" json:"name"`
+	Rule  *Rule  `yaml:"rule,omitempty" json:"rule,omitempty"`
+	Teams []Team `yaml:"teams,omitempty" json:"teams,omitempty"`
+}
+
+type Team struct {
+	Name         string        `yaml:"name" json:"name"`
+	Rule         *Rule         `yaml:"rule,omitempty" json:"rule,omitempty"`
+	Applications []Application `yaml:"applications,omitempty" json:"applications,omitempty"`
+}
+
+type Application struct {
+	Name string `yaml:"name" json:"name"`
+	Rule *Rule  `yaml:"rule,omitempty" json:"rule,omitempty"`
+}
+
+// Rule holds the settings of a scan rule. Unset settings are inherited from the wider levels.
+type Rule struct {
+	SCAScanEnabled        *bool    `yaml:"sca_scan_enabled,omitempty" json:"sca_scan_enabled,omitempty"`
+	SASTScanEnabled       *bool    `yaml:"sast_scan_enabled,omitempty" json:"sast_scan_enabled,omitempty"`
+	AllowIncrementalScans *bool    `yaml:"allow_incremental_scans,omitempty" json:"allow_incremental_scans,omitempty"`
+	AllowSASTEmptyCode    *bool    `yaml:"allow_sast_empty_code,omitempty" json:"allow_sast_empty_code,omitempty"`
+	ExcludeDirRegexpQueue []string `yaml:"exclude_dir_regexp_queue,omitempty" json:"exclude_dir_regexp_queue,omitempty"`
+	ForcedDoOwnSBOM       *bool    `yaml:"forced_do_own_sbom,omitempty" json:"forced_do_own_sbom,omitempty"`
+	ActiveBlockingSCA     *bool    `yaml:"active_blocking_sca,omitempty" json:"active_blocking_sca,omitempty"`
+}
+
+// Error is an invalid document. Path points to the offending element, for example
+// organizations[0].teams[1].name; it is empty for syntax errors.
+type Error struct {
+	Path string
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	if e.Path == "" {
+		return e.Msg
+	}
+	return e.Path + ": " + e.Msg
+}
+
+func errorf(path, format string, args ...any) *Error {
+	return &Error{Path: path, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Parse decodes and validates a document. Unknown keys are rejected so that a misspelt setting
+// is not silently ignored.
+func Parse(data []byte, format Format) (*Document, error) {
+	var doc Document
+	var err error
+	switch format {
+	case YAML:
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&doc)
+	case JSON:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&doc)
+	default:
+		return nil, fmt.Errorf("unknown format %d", format)
+	}
+	if errors.Is(err, io.EOF) {
+		return nil, errorf("", "document is empty")
+	}
+	if err != nil {
+		return nil, errorf("", "invalid document: %v", err)
+	}
+
+	if err := doc.validate(); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// Marshal encodes a document; the same document always produces the same output.
+func Marshal(doc *Document, format Format) ([]byte, error) {
+	switch format {
+	case YAML:
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(doc); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case JSON:
+		data, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	}
+	return nil, fmt.Errorf("unknown format %d", format)
+}
+
+func (d *Document) validate() error {
+	if d.Version != Version {
+		return errorf("version", "unsupported version %d, expected %d", d.Version, Version)
+	}
+
+	orgNames := map[string]bool{}
+	for i, org := range d.Organizations {
+		path := fmt.Sprintf("organizations[%d]", i)
+		if err := checkName(path, org.Name, orgNames); err != nil {
+			return err
+		}
+		if err := org.Rule.validate(path + ".rule"); err != nil {
+			return err
+		}
+
+		teamNames := map[string]bool{}
+		for j, team := range org.Teams {
+			path := fmt.Sprintf("%s.teams[%d]", path, j)
+			if err := checkName(path, team.Name, teamNames); err != nil {
+				return err
+			}
+			if err := team.Rule.validate(path + ".rule"); err != nil {
+				return err
+			}
+
+			appNames := map[string]bool{}
+			for k, app := range team.Applications {
+				path := fmt.Sprintf("%s.applications[%d]", path, k)
+				if err := checkName(path, app.Name, appNames); err != nil {
+					return err
+				}
+				if err := app.Rule.validate(path + ".rule"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func checkName(path, name string, seen map[string]bool) error {
+	if strings.TrimSpace(name) == "" {
+		return errorf(path+".name", "must not be empty")
+	}
+	if seen[name] {
+		return errorf(path+".name", "%q is listed twice", name)
+	}
+	seen[name] = true
+	return nil
+}
+
+func (r *Rule) validate(path string) error {
+	if r == nil {
+		return nil
+	}
+	if _, err := excludes.Compile(r.ExcludeDirRegexpQueue); err != nil {
+		var patternErr *excludes.Error
+		if errors.As(err, &patternErr) {
+			return errorf(fmt.Sprintf("%s.exclude_dir_regexp_queue[%d]", path, patternErr.Index),
+				"%s at position %d", patternErr.Msg, patternErr.Pos)
+		}
+		return errorf(path+".exclude_dir_regexp_queue", "%v", err)
+	}
+	return nil
+}
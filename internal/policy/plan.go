@@ -0,0 +1,304 @@
+package policy
+
+import (
+	"cmp"
+	"data_processor/internal/common"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// State is the part of the database a document is exported from or applied to: the
+// organizations with their teams, applications and scan rules.
+type State struct {
+	Organizations []*common.Organization
+	Teams         []*common.Team
+	Applications  []*common.Application
+	Rules         []*common.ScanRule
+}
+
+// Action is the kind of change a plan makes to a rule.
+type Action string
+
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+// Change is a step of a plan. Rule is the desired rule for Create and Update, with the id of the
+// updated rule, and the existing rule for Delete. Fields lists what the step changes.
+type Change struct {
+	Action Action
+	// Path names the scope of the rule: organization[/team[/application]]
+	Path   string
+	Rule   *common.ScanRule
+	Fields []common.ScanRuleChange
+}
+
+// scopeKey — уровень правила: идентификаторы организации, команды и приложения (0 — не задан)
+type scopeKey struct {
+	org, team, app int
+}
+
+func ruleKey(rule *common.ScanRule) scopeKey {
+	key := scopeKey{org: rule.OrganizationID}
+	if rule.TeamID != nil {
+		key.team = *rule.TeamID
+	}
+	if rule.ApplicationID != nil {
+		key.app = *rule.ApplicationID
+	}
+	return key
+}
+
+// index — состояние, разложенное по уровням
+type index struct {
+	orgs  map[int]*common.Organization
+	teams map[int]*common.Team
+	apps  map[int]*common.Application
+	// teamsByOrg и appsByTeam — дочерние узлы по именам; имена в БД не уникальны
+	teamsByOrg map[int]map[string][]*common.Team
+	appsByTeam map[int]map[string][]*common.Application
+	// rules — правила уровня в порядке id; больше одного бывает у правил, созданных до проверки уникальности
+	rules map[scopeKey][]*common.ScanRule
+}
+
+func newIndex(state *State) *index {
+	idx := &index{
+		orgs:       map[int]*common.Organization{},
+		teams:      map[int]*common.Team{},
+		apps:       map[int]*common.Application{},
+		teamsByOrg: map[int]map[string][]*common.Team{},
+		appsByTeam: map[int]map[string][]*common.Application{},
+		rules:      map[scopeKey][]*common.ScanRule{},
+	}
+	for _, org := range state.Organizations {
+		idx.orgs[org.ID] = org
+	}
+	for _, team := range state.Teams {
+		idx.teams[team.ID] = team
+		if idx.teamsByOrg[team.OrganizationID] == nil {
+			idx.teamsByOrg[team.OrganizationID] = map[string][]*common.Team{}
+		}
+		idx.teamsByOrg[team.OrganizationID][team.TeamName] = append(idx.teamsByOrg[team.OrganizationID][team.TeamName], team)
+	}
+	for _, app := range state.Applications {
+		idx.apps[app.ID] = app
+		if idx.appsByTeam[app.TeamID] == nil {
+			idx.appsByTeam[app.TeamID] = map[string][]*common.Application{}
+		}
+		idx.appsByTeam[app.TeamID][app.Name] = append(idx.appsByTeam[app.TeamID][app.Name], app)
+	}
+	rules := slices.Clone(state.Rules)
+	slices.SortFunc(rules, func(a, b *common.ScanRule) int { return cmp.Compare(a.ID, b.ID) })
+	for _, rule := range rules {
+		key := ruleKey(rule)
+		idx.rules[key] = append(idx.rules[key], rule)
+	}
+	return idx
+}
+
+// path возвращает имя уровня; узлы, которых нет в состоянии, называются по id
+func (idx *index) path(key scopeKey) string {
+	parts := []string{fmt.Sprintf("organization#%d", key.org)}
+	if org := idx.orgs[key.org]; org != nil {
+		parts[0] = org.ProjectName
+	}
+	if key.team != 0 {
+		part := fmt.Sprintf("team#%d", key.team)
+		if team := idx.teams[key.team]; team != nil {
+			part = team.TeamName
+		}
+		parts = append(parts, part)
+	}
+	if key.app != 0 {
+		part := fmt.Sprintf("application#%d", key.app)
+		if app := idx.apps[key.app]; app != nil {
+			part = app.Name
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, "/")
+}
+
+// Export builds the document of the organizations in the state. Organizations, teams and
+// applications are sorted by name, and nodes without rules below them are left out. Names
+// that are not unique within their parent cannot be told apart in a document; they are
+// reported as common.ErrConflict.
+func Export(state *State) (*Document, error) {
+	idx := newIndex(state)
+	doc := &Document{Version: Version, Organizations: []Organization{}}
+
+	orgs := slices.Clone(state.Organizations)
+	slices.SortFunc(orgs, func(a, b *common.Organization) int {
+		return cmp.Or(cmp.Compare(a.ProjectName, b.ProjectName), cmp.Compare(a.ID, b.ID))
+	})
+	for i, org := range orgs {
+		if i > 0 && orgs[i-1].ProjectName == org.ProjectName {
+			return nil, common.ConflictError("organization", fmt.Sprintf("organization name %q is not unique", org.ProjectName))
+		}
+		node := Organization{Name: org.ProjectName, Rule: idx.exportRule(scopeKey{org: org.ID})}
+
+		for _, teamName := range sortedKeys(idx.teamsByOrg[org.ID]) {
+			teams := idx.teamsByOrg[org.ID][teamName]
+			if len(teams) > 1 {
+				return nil, common.ConflictError("team", fmt.Sprintf("team name %q is not unique in organization %q", teamName, org.ProjectName))
+			}
+			team := teams[0]
+			teamNode := Team{Name: team.TeamName, Rule: idx.exportRule(scopeKey{org: org.ID, team: team.ID})}
+
+			for _, appName := range sortedKeys(idx.appsByTeam[team.ID]) {
+				apps := idx.appsByTeam[team.ID][appName]
+				if len(apps) > 1 {
+					return nil, common.ConflictError("application", fmt.Sprintf("application name %q is not unique in team %q", appName, team.TeamName))
+				}
+				rule := idx.exportRule(scopeKey{org: org.ID, team: team.ID, app: apps[0].ID})
+				if rule != nil {
+					teamNode.Applications = append(teamNode.Applications, Application{Name: appName, Rule: rule})
+				}
+			}
+			if teamNode.Rule != nil || len(teamNode.Applications) > 0 {
+				node.Teams = append(node.Teams, teamNode)
+			}
+		}
+		if node.Rule != nil || len(node.Teams) > 0 {
+			doc.Organizations = append(doc.Organizations, node)
+		}
+	}
+	return doc, nil
+}
+
+// exportRule возвращает настройки правила уровня; из дубликатов действует правило с меньшим id
+func (idx *index) exportRule(key scopeKey) *Rule {
+	rules := idx.rules[key]
+	if len(rules) == 0 {
+		return nil
+	}
+	rule := rules[0]
+	return &Rule{
+		SCAScanEnabled:        rule.SCAScanEnabled,
+		SASTScanEnabled:       rule.SASTScanEnabled,
+		AllowIncrementalScans: rule.AllowIncrementalScans,
+		AllowSASTEmptyCode:    rule.AllowSASTEmptyCode,
+		ExcludeDirRegexpQueue: rule.ExcludeDirRegexpQueue,
+		ForcedDoOwnSBOM:       rule.ForcedDoOwnSBOM,
+		ActiveBlockingSCA:     rule.ActiveBlockingSCA,
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Plan compares the document with the state and returns the changes that make the rules of the
+// listed organizations match it, ordered by path. The state must hold the listed organizations
+// with all their teams, applications and rules. Names that do not resolve to exactly one
+// organization, team or application are reported as *Error.
+func Plan(doc *Document, state *State) ([]Change, error) {
+	idx := newIndex(state)
+	orgsByName := map[string][]*common.Organization{}
+	for _, org := range state.Organizations {
+		orgsByName[org.ProjectName] = append(orgsByName[org.ProjectName], org)
+	}
+
+	desired := map[scopeKey]*Rule{}
+	var orgIDs []int
+	for i, orgNode := range doc.Organizations {
+		path := fmt.Sprintf("organizations[%d]", i)
+		org, err := resolve(path, "organization", orgNode.Name, orgsByName[orgNode.Name])
+		if err != nil {
+			return nil, err
+		}
+		orgIDs = append(orgIDs, org.ID)
+		desired[scopeKey{org: org.ID}] = orgNode.Rule
+
+		for j, teamNode := range orgNode.Teams {
+			path := fmt.Sprintf("%s.teams[%d]", path, j)
+			team, err := resolve(path, "team", teamNode.Name, idx.teamsByOrg[org.ID][teamNode.Name])
+			if err != nil {
+				return nil, err
+			}
+			desired[scopeKey{org: org.ID, team: team.ID}] = teamNode.Rule
+
+			for k, appNode := range teamNode.Applications {
+				path := fmt.Sprintf("%s.applications[%d]", path, k)
+				app, err := resolve(path, "application", appNode.Name, idx.appsByTeam[team.ID][appNode.Name])
+				if err != nil {
+					return nil, err
+				}
+				desired[scopeKey{org: org.ID, team: team.ID, app: app.ID}] = appNode.Rule
+			}
+		}
+	}
+
+	var changes []Change
+	for key, rule := range desired {
+		if rule == nil {
+			continue
+		}
+		want := rule.scanRule(key)
+		existing := idx.rules[key]
+		if len(existing) == 0 {
+			changes = append(changes, Change{Action: Create, Path: idx.path(key), Rule: want, Fields: common.DiffScanRules(nil, want)})
+			continue
+		}
+		want.ID = existing[0].ID
+		if fields := common.DiffScanRules(existing[0], want); len(fields) > 0 {
+			changes = append(changes, Change{Action: Update, Path: idx.path(key), Rule: want, Fields: fields})
+		}
+	}
+	// Удаляются правила перечисленных организаций, которых нет в документе, и дубликаты уровней
+	for key, rules := range idx.rules {
+		if !slices.Contains(orgIDs, key.org) {
+			continue
+		}
+		if desired[key] != nil {
+			rules = rules[1:]
+		}
+		for _, rule := range rules {
+			changes = append(changes, Change{Action: Delete, Path: idx.path(key), Rule: rule, Fields: common.DiffScanRules(rule, nil)})
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b Change) int {
+		return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Rule.ID, b.Rule.ID))
+	})
+	return changes, nil
+}
+
+func resolve[T any](path, kind, name string, found []T) (T, error) {
+	var zero T
+	switch len(found) {
+	case 0:
+		return zero, errorf(path+".name", "%s %q not found", kind, name)
+	case 1:
+		return found[0], nil
+	}
+	return zero, errorf(path+".name", "%s name %q is ambiguous, %d %ss have it", kind, name, len(found), kind)
+}
+
+func (r *Rule) scanRule(key scopeKey) *common.ScanRule {
+	rule := &common.ScanRule{
+		OrganizationID:        key.org,
+		SCAScanEnabled:        r.SCAScanEnabled,
+		SASTScanEnabled:       r.SASTScanEnabled,
+		AllowIncrementalScans: r.AllowIncrementalScans,
+		AllowSASTEmptyCode:    r.AllowSASTEmptyCode,
+		ExcludeDirRegexpQueue: r.ExcludeDirRegexpQueue,
+		ForcedDoOwnSBOM:       r.ForcedDoOwnSBOM,
+		ActiveBlockingSCA:     r.ActiveBlockingSCA,
+	}
+	if key.team != 0 {
+		rule.TeamID = &key.team
+	}
+	if key.app != 0 {
+		rule.ApplicationID = &key.app
+	}
+	return rule
+}
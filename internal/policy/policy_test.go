@@ -0,0 +1,191 @@
+package policy
+
+import (
+	"data_processor/internal/common"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func testState() *State {
+	return &State{
+		Organizations: []*common.Organization{{ID: 1, ProjectName: "acme"}, {ID: 2, ProjectName: "globex"}},
+		Teams: []*common.Team{
+			{ID: 10, TeamName: "payments", OrganizationID: 1},
+			{ID: 11, TeamName: "search", OrganizationID: 1},
+			{ID: 20, TeamName: "payments", OrganizationID: 2},
+		},
+		Applications: []*common.Application{
+			{ID: 100, Name: "billing", TeamID: 10},
+			{ID: 101, Name: "ledger", TeamID: 10},
+			{ID: 200, Name: "billing", TeamID: 20},
+		},
+		Rules: []*common.ScanRule{
+			{ID: 1, OrganizationID: 1, SCAScanEnabled: ptr(true), ExcludeDirRegexpQueue: []string{"vendor"}},
+			{ID: 2, OrganizationID: 1, TeamID: ptr(10), ApplicationID: ptr(100), ActiveBlockingSCA: ptr(true)},
+			{ID: 3, OrganizationID: 1, TeamID: ptr(11), SASTScanEnabled: ptr(false)},
+			{ID: 4, OrganizationID: 2, SCAScanEnabled: ptr(false)},
+		},
+	}
+}
+
+const testDocument = `version: 1
+organizations:
+  - name: acme
+    rule:
+      sca_scan_enabled: true
+      exclude_dir_regexp_queue:
+        - vendor
+    teams:
+      - name: payments
+        applications:
+          - name: billing
+            rule:
+              active_blocking_sca: true
+      - name: search
+        rule:
+          sast_scan_enabled: false
+`
+
+func TestExport(t *testing.T) {
+	state := testState()
+	state.Organizations = state.Organizations[:1]
+	state.Rules = state.Rules[:3]
+
+	doc, err := Export(state)
+	require.NoError(t, err)
+	data, err := Marshal(doc, YAML)
+	require.NoError(t, err)
+	assert.Equal(t, testDocument, string(data))
+
+	// Экспорт, применённый к тому же состоянию, ничего не меняет
+	parsed, err := Parse(data, YAML)
+	require.NoError(t, err)
+	changes, err := Plan(parsed, state)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	data, err = Marshal(doc, JSON)
+	require.NoError(t, err)
+	fromJSON, err := Parse(data, JSON)
+	require.NoError(t, err)
+	assert.Equal(t, parsed, fromJSON)
+}
+
+func TestExportAmbiguousNames(t *testing.T) {
+	state := testState()
+	state.Teams = append(state.Teams, &common.Team{ID: 12, TeamName: "search", OrganizationID: 1})
+
+	_, err := Export(state)
+	assert.ErrorIs(t, err, common.ErrConflict)
+}
+
+func TestPlan(t *testing.T) {
+	doc, err := Parse([]byte(`version: 1
+organizations:
+  - name: acme
+    rule:
+      sca_scan_enabled: false
+      exclude_dir_regexp_queue: [vendor]
+    teams:
+      - name: payments
+        rule: {}
+        applications:
+          - name: billing
+            rule:
+              active_blocking_sca: true
+`), YAML)
+	require.NoError(t, err)
+
+	changes, err := Plan(doc, testState())
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	assert.Equal(t, Update, changes[0].Action)
+	assert.Equal(t, "acme", changes[0].Path)
+	assert.Equal(t, 1, changes[0].Rule.ID)
+	assert.Equal(t, []common.ScanRuleChange{{Field: "sca_scan_enabled", Old: true, New: false}}, changes[0].Fields)
+
+	assert.Equal(t, Create, changes[1].Action)
+	assert.Equal(t, "acme/payments", changes[1].Path)
+	assert.Equal(t, ptr(10), changes[1].Rule.TeamID)
+	assert.Nil(t, changes[1].Rule.ApplicationID)
+
+	// Правило команды search в документе не упомянуто; правила globex не затрагиваются
+	assert.Equal(t, Delete, changes[2].Action)
+	assert.Equal(t, "acme/search", changes[2].Path)
+	assert.Equal(t, 3, changes[2].Rule.ID)
+}
+
+func TestPlanDuplicateRules(t *testing.T) {
+	state := testState()
+	state.Rules = append(state.Rules, &common.ScanRule{ID: 5, OrganizationID: 1, SCAScanEnabled: ptr(false)})
+	doc, err := Parse([]byte("version: 1\norganizations:\n  - name: acme\n    rule: {sca_scan_enabled: true, exclude_dir_regexp_queue: [vendor]}\n"), YAML)
+	require.NoError(t, err)
+
+	changes, err := Plan(doc, state)
+	require.NoError(t, err)
+	var deleted []int
+	for _, change := range changes {
+		require.Equal(t, Delete, change.Action)
+		deleted = append(deleted, change.Rule.ID)
+	}
+	assert.Equal(t, []int{5, 2, 3}, deleted)
+}
+
+func TestPlanErrors(t *testing.T) {
+	state := testState()
+	state.Organizations = append(state.Organizations, &common.Organization{ID: 3, ProjectName: "globex"})
+
+	tests := []struct {
+		name string
+		doc  string
+		path string
+	}{
+		{"Unknown Organization", "version: 1\norganizations:\n  - name: initech\n", "organizations[0].name"},
+		{"Ambiguous Organization", "version: 1\norganizations:\n  - name: globex\n", "organizations[0].name"},
+		{"Team Of Another Organization", "version: 1\norganizations:\n  - name: acme\n    teams:\n      - name: support\n", "organizations[0].teams[0].name"},
+		{"Unknown Application", "version: 1\norganizations:\n  - name: acme\n    teams:\n      - name: search\n        applications:\n          - name: billing\n", "organizations[0].teams[0].applications[0].name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse([]byte(tt.doc), YAML)
+			require.NoError(t, err)
+			_, err = Plan(doc, state)
+			var docErr *Error
+			require.ErrorAs(t, err, &docErr)
+			assert.Equal(t, tt.path, docErr.Path)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		doc    string
+		path   string
+		msg    string
+	}{
+		{"Empty", YAML, "", "", "empty"},
+		{"Syntax", YAML, "version: [1", "", "invalid document"},
+		{"Unknown Key", YAML, "version: 1\norganizations:\n  - name: acme\n    rule: {sca_enabled: true}\n", "", "sca_enabled"},
+		{"Unknown JSON Key", JSON, `{"version": 1, "orgs": []}`, "", "orgs"},
+		{"Version", YAML, "version: 2\n", "version", "unsupported version"},
+		{"Empty Name", YAML, "version: 1\norganizations:\n  - name: acme\n    teams:\n      - name: ' '\n", "organizations[0].teams[0].name", "must not be empty"},
+		{"Duplicate Name", JSON, `{"version": 1, "organizations": [{"name": "acme"}, {"name": "acme"}]}`, "organizations[1].name", "listed twice"},
+		{"Bad Pattern", YAML, "version: 1\norganizations:\n  - name: acme\n    rule: {exclude_dir_regexp_queue: [vendor, 'a(']}\n", "organizations[0].rule.exclude_dir_regexp_queue[1]", "missing closing )"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.doc), tt.format)
+			var docErr *Error
+			require.ErrorAs(t, err, &docErr)
+			assert.Equal(t, tt.path, docErr.Path)
+			assert.Contains(t, docErr.Msg, tt.msg)
+		})
+	}
+}
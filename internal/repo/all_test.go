@@ -2,7 +2,13 @@ package repo
 
 import (
 	"context"
+	"data_processor/internal/auth"
 	"data_processor/internal/common"
+	"data_processor/internal/filter"
+	"data_processor/internal/policy"
+	"errors"
+	"fmt"
+	"io"
 	"testing"
 	"time"
 
@@ -80,6 +86,10 @@ func TestUserRepository(t *testing.T) {
 		fetchedUser, err := repo.GetUserByName(ctx, user.Name)
 		require.NoError(t, err)
 		assert.Equal(t, user.ID, fetchedUser.ID)
+
+		// Имя однозначно определяет пользователя при входе
+		err = repo.CreateUser(ctx, &common.User{Name: user.Name, Password: "other"})
+		assert.ErrorIs(t, err, common.ErrAlreadyExists)
 	})
 
 	t.Run("Update and Delete User", func(t *testing.T) {
@@ -91,18 +101,34 @@ func TestUserRepository(t *testing.T) {
 		require.NoError(t, err)
 
 		user.Password = "new_pass"
-		err = repo.UpdateUser(ctx, user)
+		returned, err := repo.UpdateUser(ctx, user, []string{"password"})
 		require.NoError(t, err)
+		require.NotNil(t, returned)
+		assert.Equal(t, user.Name, returned.Name)
 
 		updatedUser, err := repo.GetUserByID(ctx, user.ID)
 		require.NoError(t, err)
-		assert.Equal(t, "new_pass", updatedUser.Password)
+		assert.True(t, auth.IsPasswordHash(updatedUser.PasswordHash))
+		assert.Empty(t, updatedUser.Password)
+
+		verified, err := repo.VerifyCredentials(ctx, user.Name, "new_pass")
+		require.NoError(t, err)
+		require.NotNil(t, verified)
+		assert.Equal(t, user.ID, verified.ID)
+
+		// Обновление без пароля в маске не должно трогать хеш
+		renamed := &common.User{ID: user.ID, Name: "renamed"}
+		_, err = repo.UpdateUser(ctx, renamed, []string{"name"})
+		require.NoError(t, err)
+		renamedUser, err := repo.GetUserByID(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, updatedUser.PasswordHash, renamedUser.PasswordHash)
 
 		err = repo.DeleteUser(ctx, user.ID)
 		require.NoError(t, err)
 
 		deletedUser, err := repo.GetUserByID(ctx, user.ID)
-		require.NoError(t, err)
+		assert.ErrorIs(t, err, common.ErrNotFound)
 		assert.Nil(t, deletedUser)
 	})
 
@@ -118,10 +144,104 @@ func TestUserRepository(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		userList, err := repo.ListUsers(ctx)
+		userList, _, err := repo.ListUsers(ctx, common.Page{})
 		require.NoError(t, err)
 		assert.GreaterOrEqual(t, len(userList), 2)
 	})
+
+	t.Run("Verify Credentials", func(t *testing.T) {
+		user := &common.User{Name: "verify_user", Password: "right_pass"}
+		err := repo.CreateUser(ctx, user)
+		require.NoError(t, err)
+		assert.NotEqual(t, "right_pass", user.PasswordHash)
+
+		verified, err := repo.VerifyCredentials(ctx, "verify_user", "right_pass")
+		require.NoError(t, err)
+		require.NotNil(t, verified)
+		assert.Equal(t, user.ID, verified.ID)
+
+		verified, err = repo.VerifyCredentials(ctx, "verify_user", "wrong_pass")
+		require.NoError(t, err)
+		assert.Nil(t, verified)
+
+		verified, err = repo.VerifyCredentials(ctx, "missing_user", "right_pass")
+		require.NoError(t, err)
+		assert.Nil(t, verified)
+	})
+
+	t.Run("Rehash On Login", func(t *testing.T) {
+		// Запись со старым открытым паролем
+		var id common.UserID
+		err := pool.QueryRow(ctx, `INSERT INTO users (name, password) VALUES ('legacy_user', 'legacy_pass') RETURNING id`).Scan(&id)
+		require.NoError(t, err)
+
+		verified, err := repo.VerifyCredentials(ctx, "legacy_user", "legacy_pass")
+		require.NoError(t, err)
+		require.NotNil(t, verified)
+
+		stored, err := repo.GetUserByID(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, auth.IsPasswordHash(stored.PasswordHash))
+
+		// Смена параметров приводит к перехешированию при следующем входе
+		params := auth.DefaultPasswordParams
+		params.Iterations++
+		repo.SetPasswordParams(params)
+		defer repo.SetPasswordParams(auth.DefaultPasswordParams)
+
+		verified, err = repo.VerifyCredentials(ctx, "legacy_user", "legacy_pass")
+		require.NoError(t, err)
+		require.NotNil(t, verified)
+
+		rehashed, err := repo.GetUserByID(ctx, id)
+		require.NoError(t, err)
+		assert.NotEqual(t, stored.PasswordHash, rehashed.PasswordHash)
+	})
+
+	t.Run("Set User Admin", func(t *testing.T) {
+		user := &common.User{Name: "admin_user", Password: "password"}
+		require.NoError(t, repo.CreateUser(ctx, user))
+
+		require.NoError(t, repo.SetUserAdmin(ctx, user.ID, true))
+		fetched, err := repo.GetUserByID(ctx, user.ID)
+		require.NoError(t, err)
+		assert.True(t, fetched.IsAdmin)
+	})
+
+	t.Run("Update Worker Flag", func(t *testing.T) {
+		user := &common.User{Name: "worker_user", Password: "password"}
+		require.NoError(t, repo.CreateUser(ctx, user))
+
+		updated, err := repo.UpdateUser(ctx, &common.User{ID: user.ID, IsWorker: true}, []string{"is_worker"})
+		require.NoError(t, err)
+		assert.True(t, updated.IsWorker)
+		assert.False(t, updated.IsAdmin)
+
+		fetched, err := repo.GetUserByName(ctx, "worker_user")
+		require.NoError(t, err)
+		assert.True(t, fetched.IsWorker)
+	})
+
+	t.Run("Migrate Legacy Passwords", func(t *testing.T) {
+		_, err := pool.Exec(ctx, `INSERT INTO users (name, password) VALUES ('plain1', 'p1'), ('plain2', 'p2'), ('plain3', '$argon2id$p3')`)
+		require.NoError(t, err)
+
+		migrated, err := repo.MigrateLegacyPasswords(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 3, migrated)
+
+		migrated, err = repo.MigrateLegacyPasswords(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, migrated)
+
+		verified, err := repo.VerifyCredentials(ctx, "plain1", "p1")
+		require.NoError(t, err)
+		assert.NotNil(t, verified)
+
+		verified, err = repo.VerifyCredentials(ctx, "plain3", "$argon2id$p3")
+		require.NoError(t, err)
+		assert.NotNil(t, verified)
+	})
 }
 
 func TestPermissionRepository(t *testing.T) {
@@ -164,18 +284,18 @@ func TestPermissionRepository(t *testing.T) {
 		require.NoError(t, err)
 
 		perm.Write = true
-		err = repo.UpdatePermission(ctx, perm)
+		_, err = repo.UpdatePermission(ctx, perm, []string{"write"})
 		require.NoError(t, err)
 
 		updatedPerm, err := repo.GetPermissionByID(ctx, perm.ID)
 		require.NoError(t, err)
 		assert.True(t, updatedPerm.Write)
 
-		err = repo.DeletePermission(ctx, perm.ID)
+		err = repo.DeletePermission(ctx, perm.ID, 0)
 		require.NoError(t, err)
 
 		deletedPerm, err := repo.GetPermissionByID(ctx, perm.ID)
-		require.NoError(t, err)
+		assert.ErrorIs(t, err, common.ErrNotFound)
 		assert.Nil(t, deletedPerm)
 	})
 
@@ -191,12 +311,162 @@ func TestPermissionRepository(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		permList, err := repo.ListPermissions(ctx)
+		permList, _, err := repo.ListPermissions(ctx, common.Page{})
 		require.NoError(t, err)
 		assert.GreaterOrEqual(t, len(permList), 2)
 	})
 }
 
+func TestResolveResourceScope(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewPgxRepository(pool)
+	ctx := context.Background()
+
+	user := createTestUser(t, repo)
+	org := createTestOrg(t, repo, user.ID)
+	team := createTestTeam(t, repo, user.ID, org.ID)
+	app := &common.Application{Name: "app", TeamID: team.ID}
+	require.NoError(t, repo.CreateApplication(ctx, app))
+	version := &common.Version{ApplicationID: app.ID, Version: "1.0.0"}
+	require.NoError(t, repo.CreateVersion(ctx, version))
+	scan := &common.Scan{ScanDate: time.Now(), VersionID: version.ID}
+	require.NoError(t, repo.CreateScan(ctx, scan))
+
+	t.Run("Organization", func(t *testing.T) {
+		scope, err := repo.ResolveResourceScope(ctx, common.ResourceRef{Type: common.ResourceOrganization, ID: org.ID})
+		require.NoError(t, err)
+		require.NotNil(t, scope)
+		assert.Equal(t, org.ID, scope.OrganizationID)
+		assert.Equal(t, user.ID, scope.OrganizationOwnerID)
+		assert.Nil(t, scope.TeamID)
+	})
+
+	t.Run("Scan", func(t *testing.T) {
+		// Скан поднимается через версию и приложение до команды и организации
+		scope, err := repo.ResolveResourceScope(ctx, common.ResourceRef{Type: common.ResourceScan, ID: scan.ID})
+		require.NoError(t, err)
+		require.NotNil(t, scope)
+		assert.Equal(t, org.ID, scope.OrganizationID)
+		require.NotNil(t, scope.TeamID)
+		assert.Equal(t, team.ID, *scope.TeamID)
+		require.NotNil(t, scope.ApplicationID)
+		assert.Equal(t, app.ID, *scope.ApplicationID)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		scope, err := repo.ResolveResourceScope(ctx, common.ResourceRef{Type: common.ResourceVersion, ID: 9999})
+		require.NoError(t, err)
+		assert.Nil(t, scope)
+	})
+}
+
+func TestEffectivePermissions(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewPgxRepository(pool)
+	ctx := context.Background()
+
+	owner := createTestUser(t, repo)
+	org := createTestOrg(t, repo, owner.ID)
+	team := createTestTeam(t, repo, owner.ID, org.ID)
+	app := &common.Application{Name: "app", TeamID: team.ID}
+	require.NoError(t, repo.CreateApplication(ctx, app))
+
+	member := &common.User{Name: "member", Password: "password"}
+	require.NoError(t, repo.CreateUser(ctx, member))
+
+	appRef := common.ResourceRef{Type: common.ResourceApplication, ID: app.ID}
+
+	t.Run("No Grants", func(t *testing.T) {
+		eff, err := repo.GetEffectivePermissions(ctx, member.ID, appRef)
+		require.NoError(t, err)
+		require.NotNil(t, eff)
+		assert.False(t, eff.Read)
+		assert.False(t, eff.Write)
+		assert.Empty(t, eff.Grants)
+	})
+
+	t.Run("Owner", func(t *testing.T) {
+		eff, err := repo.GetEffectivePermissions(ctx, owner.ID, appRef)
+		require.NoError(t, err)
+		assert.True(t, eff.Write)
+		require.Len(t, eff.Grants, 2)
+		assert.Equal(t, common.GrantSourceOrganizationOwner, eff.Grants[0].Source)
+		assert.Equal(t, common.GrantSourceTeamOwner, eff.Grants[1].Source)
+	})
+
+	t.Run("Organization Grant Inherited By Application", func(t *testing.T) {
+		// Право на чтение организации распространяется на её команды и приложения
+		role, err := repo.CreateRole(ctx, &common.Role{Name: "org_readers", OwnerID: owner.ID})
+		require.NoError(t, err)
+		perm := &common.Permission{Name: "org_read", Read: true, OrganizationID: &org.ID}
+		require.NoError(t, repo.CreatePermission(ctx, perm))
+		require.NoError(t, repo.AddPermission(ctx, role.Role.ID, perm))
+		require.NoError(t, repo.AssignRoleToUser(ctx, member.ID, role.Role.ID))
+
+		eff, err := repo.GetEffectivePermissions(ctx, member.ID, appRef)
+		require.NoError(t, err)
+		assert.True(t, eff.Read)
+		assert.False(t, eff.Write)
+		require.Len(t, eff.Grants, 1)
+		assert.Equal(t, common.GrantSourceOrganizationRole, eff.Grants[0].Source)
+		assert.Equal(t, role.Role.ID, *eff.Grants[0].RoleID)
+		assert.Equal(t, perm.ID, *eff.Grants[0].PermissionID)
+	})
+
+	t.Run("Team Grant Does Not Cover Organization", func(t *testing.T) {
+		role, err := repo.CreateRole(ctx, &common.Role{Name: "team_writers", OwnerID: owner.ID})
+		require.NoError(t, err)
+		perm := &common.Permission{Name: "team_write", Write: true, TeamID: &team.ID}
+		require.NoError(t, repo.CreatePermission(ctx, perm))
+		require.NoError(t, repo.AddPermission(ctx, role.Role.ID, perm))
+		require.NoError(t, repo.AssignRoleToUser(ctx, member.ID, role.Role.ID))
+
+		eff, err := repo.GetEffectivePermissions(ctx, member.ID, appRef)
+		require.NoError(t, err)
+		assert.True(t, eff.Write)
+
+		eff, err = repo.GetEffectivePermissions(ctx, member.ID, common.ResourceRef{Type: common.ResourceOrganization, ID: org.ID})
+		require.NoError(t, err)
+		assert.True(t, eff.Read)
+		assert.False(t, eff.Write)
+	})
+
+	t.Run("Missing Resource", func(t *testing.T) {
+		eff, err := repo.GetEffectivePermissions(ctx, member.ID, common.ResourceRef{Type: common.ResourceApplication, ID: 9999})
+		require.NoError(t, err)
+		assert.Nil(t, eff)
+	})
+
+	t.Run("Batch Check", func(t *testing.T) {
+		refs := []common.ResourceRef{
+			{Type: common.ResourceOrganization, ID: org.ID},
+			{Type: common.ResourceTeam, ID: team.ID},
+			appRef,
+			{Type: common.ResourceApplication, ID: 9999},
+		}
+
+		rights, err := repo.CheckPermissions(ctx, member.ID, refs)
+		require.NoError(t, err)
+		require.Len(t, rights, len(refs))
+
+		// Результаты совпадают с поштучным резолвером
+		for i, ref := range refs {
+			eff, err := repo.GetEffectivePermissions(ctx, member.ID, ref)
+			require.NoError(t, err)
+			if eff == nil {
+				assert.Equal(t, common.PermissionReadWrite{}, rights[i])
+				continue
+			}
+			assert.Equal(t, eff.Read, rights[i].Read, ref)
+			assert.Equal(t, eff.Write, rights[i].Write, ref)
+		}
+	})
+}
+
 func TestOrganizationRepository(t *testing.T) {
 	pool, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -231,18 +501,18 @@ func TestOrganizationRepository(t *testing.T) {
 		require.NoError(t, err)
 
 		org.ProjectName = "updated_name"
-		err = repo.UpdateOrganization(ctx, org)
+		_, err = repo.UpdateOrganization(ctx, org, []string{"project_name"})
 		require.NoError(t, err)
 
 		updatedOrg, err := repo.GetOrganizationByID(ctx, org.ID)
 		require.NoError(t, err)
 		assert.Equal(t, "updated_name", updatedOrg.ProjectName)
 
-		err = repo.DeleteOrganization(ctx, org.ID)
+		err = repo.DeleteOrganization(ctx, org.ID, 0)
 		require.NoError(t, err)
 
 		deletedOrg, err := repo.GetOrganizationByID(ctx, org.ID)
-		require.NoError(t, err)
+		assert.ErrorIs(t, err, common.ErrNotFound)
 		assert.Nil(t, deletedOrg)
 	})
 
@@ -309,6 +579,94 @@ func TestTeamRepository(t *testing.T) {
 		require.NoError(t, err)
 		assert.GreaterOrEqual(t, len(teamList), 2)
 	})
+
+	t.Run("Missing References", func(t *testing.T) {
+		// Нарушение внешнего ключа переводится в ошибку с полем запроса
+		err := repo.CreateTeam(ctx, &common.Team{TeamName: "orphan", OwnerID: user.ID, OrganizationID: 9999})
+		require.ErrorIs(t, err, common.ErrInvalidReference)
+		var domainErr *common.Error
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, "organization_id", domainErr.Field)
+		assert.Equal(t, "organization", domainErr.Resource)
+		assert.Equal(t, "9999", domainErr.ID)
+
+		_, err = repo.UpdateTeam(ctx, &common.Team{ID: 9999, TeamName: "ghost"}, []string{"team_name"})
+		assert.ErrorIs(t, err, common.ErrNotFound)
+	})
+}
+
+func TestTeamMemberRepository(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewPgxRepository(pool)
+	ctx := context.Background()
+
+	owner := createTestUser(t, repo)
+	org := createTestOrg(t, repo, owner.ID)
+	team := createTestTeam(t, repo, owner.ID, org.ID)
+	app := &common.Application{Name: "app", TeamID: team.ID}
+	require.NoError(t, repo.CreateApplication(ctx, app))
+
+	dev := &common.User{Name: "developer", Password: "password"}
+	require.NoError(t, repo.CreateUser(ctx, dev))
+
+	t.Run("Add and List Members", func(t *testing.T) {
+		member := &common.TeamMember{TeamID: team.ID, UserID: dev.ID, Role: common.TeamMemberViewer}
+		require.NoError(t, repo.AddTeamMember(ctx, member))
+		assert.False(t, member.CreatedAt.IsZero())
+
+		// Повторное добавление меняет роль
+		member.Role = common.TeamMemberDeveloper
+		require.NoError(t, repo.AddTeamMember(ctx, member))
+
+		members, err := repo.ListTeamMembers(ctx, team.ID)
+		require.NoError(t, err)
+		require.Len(t, members, 1)
+		assert.Equal(t, common.TeamMemberDeveloper, members[0].Role)
+
+		memberships, err := repo.ListTeamsForUser(ctx, dev.ID)
+		require.NoError(t, err)
+		require.Len(t, memberships, 1)
+		assert.Equal(t, team.ID, memberships[0].Team.ID)
+	})
+
+	t.Run("Invalid Role", func(t *testing.T) {
+		err := repo.AddTeamMember(ctx, &common.TeamMember{TeamID: team.ID, UserID: dev.ID, Role: "owner"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Developer Rights", func(t *testing.T) {
+		// Разработчик пишет в приложения команды, но команду только читает
+		eff, err := repo.GetEffectivePermissions(ctx, dev.ID, common.ResourceRef{Type: common.ResourceApplication, ID: app.ID})
+		require.NoError(t, err)
+		assert.True(t, eff.Write)
+		require.Len(t, eff.Grants, 1)
+		assert.Equal(t, common.GrantSourceTeamMember, eff.Grants[0].Source)
+
+		eff, err = repo.GetEffectivePermissions(ctx, dev.ID, common.ResourceRef{Type: common.ResourceTeam, ID: team.ID})
+		require.NoError(t, err)
+		assert.True(t, eff.Read)
+		assert.False(t, eff.Write)
+
+		rights, err := repo.CheckPermissions(ctx, dev.ID, []common.ResourceRef{
+			{Type: common.ResourceTeam, ID: team.ID},
+			{Type: common.ResourceApplication, ID: app.ID},
+			{Type: common.ResourceOrganization, ID: org.ID},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, common.PermissionReadWrite{Read: true, Write: false}, rights[0])
+		assert.Equal(t, common.PermissionReadWrite{Read: true, Write: true}, rights[1])
+		assert.Equal(t, common.PermissionReadWrite{}, rights[2])
+	})
+
+	t.Run("Remove Member", func(t *testing.T) {
+		require.NoError(t, repo.RemoveTeamMember(ctx, team.ID, dev.ID))
+
+		members, err := repo.ListTeamMembers(ctx, team.ID)
+		require.NoError(t, err)
+		assert.Empty(t, members)
+	})
 }
 
 func TestApplicationRepository(t *testing.T) {
@@ -356,6 +714,26 @@ func TestApplicationRepository(t *testing.T) {
 		require.NoError(t, err)
 		assert.GreaterOrEqual(t, len(appList), 2)
 	})
+
+	t.Run("Filter and Order Applications", func(t *testing.T) {
+		for _, name := range []string{"payments-api", "payments-web", "billing"} {
+			require.NoError(t, repo.CreateApplication(ctx, &common.Application{Name: name, TeamID: team.ID}))
+		}
+
+		expr := fmt.Sprintf(`team_id = %d AND name = "payments-*"`, team.ID)
+		apps, total, err := repo.ListApplications(ctx, common.Page{Filter: expr, OrderBy: "name desc"})
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		require.Len(t, apps, 2)
+		assert.Equal(t, "payments-web", apps[0].Name)
+		assert.Equal(t, "payments-api", apps[1].Name)
+
+		// Поля вне списка разрешённых отклоняются до обращения к базе
+		var filterErr *filter.Error
+		_, _, err = repo.ListApplications(ctx, common.Page{Filter: `secret = "x"`})
+		require.ErrorAs(t, err, &filterErr)
+		assert.Equal(t, 1, filterErr.Pos)
+	})
 }
 
 func TestVersionRepository(t *testing.T) {
@@ -403,9 +781,29 @@ func TestVersionRepository(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		versionList, err := repo.ListVersions(ctx, app.ID)
+		versionList, total, err := repo.ListVersions(ctx, app.ID, common.Page{})
 		require.NoError(t, err)
 		assert.GreaterOrEqual(t, len(versionList), 2)
+		assert.Equal(t, len(versionList), total)
+	})
+
+	t.Run("Paginate Versions", func(t *testing.T) {
+		all, total, err := repo.ListVersions(ctx, app.ID, common.Page{})
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, total, 3)
+
+		// Страница из одного элемента со сдвигом возвращает следующую по id версию и полное количество
+		page, pageTotal, err := repo.ListVersions(ctx, app.ID, common.Page{Limit: 1, Offset: 1})
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		assert.Equal(t, all[1].ID, page[0].ID)
+		assert.Equal(t, total, pageTotal)
+
+		// За пределами списка страница пустая, но количество сохраняется
+		page, pageTotal, err = repo.ListVersions(ctx, app.ID, common.Page{Limit: 10, Offset: total})
+		require.NoError(t, err)
+		assert.Empty(t, page)
+		assert.Equal(t, total, pageTotal)
 	})
 }
 
@@ -448,6 +846,45 @@ func TestScanRepository(t *testing.T) {
 		assert.Equal(t, scan.VersionID, fetchedScan.VersionID)
 	})
 
+	t.Run("Lifecycle", func(t *testing.T) {
+		scan := &common.Scan{ScanDate: time.Now(), VersionID: version.ID, Type: common.ScanTypeSCA, InitiatorID: &user.ID}
+		require.NoError(t, repo.CreateScan(ctx, scan))
+		assert.Equal(t, common.ScanQueued, scan.Status)
+
+		// Завершить можно только запущенный скан
+		_, err := repo.TransitionScan(ctx, scan.ID, common.ScanSucceeded, "", nil)
+		assert.ErrorIs(t, err, common.ErrConflict)
+
+		running, err := repo.TransitionScan(ctx, scan.ID, common.ScanRunning, "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, common.ScanRunning, running.Status)
+		assert.NotNil(t, running.StartedAt)
+		assert.Nil(t, running.FinishedAt)
+
+		reason := "scanner crashed"
+		failed, err := repo.TransitionScan(ctx, scan.ID, common.ScanFailed, "", &reason)
+		require.NoError(t, err)
+		assert.Equal(t, common.ScanFailed, failed.Status)
+		assert.Equal(t, &reason, failed.ExitReason)
+		assert.NotNil(t, failed.FinishedAt)
+
+		// Из завершённого статуса выхода нет
+		_, err = repo.TransitionScan(ctx, scan.ID, common.ScanCancelled, "", nil)
+		assert.ErrorIs(t, err, common.ErrConflict)
+		_, err = repo.TransitionScan(ctx, -1, common.ScanRunning, "", nil)
+		assert.ErrorIs(t, err, common.ErrNotFound)
+
+		fetched, err := repo.GetScanByID(ctx, scan.ID)
+		require.NoError(t, err)
+		assert.Equal(t, common.ScanTypeSCA, fetched.Type)
+		assert.Equal(t, &user.ID, fetched.InitiatorID)
+
+		listed, _, err := repo.ListScans(ctx, version.ID, common.Page{Filter: `status = "failed"`})
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		assert.Equal(t, scan.ID, listed[0].ID)
+	})
+
 	t.Run("List Scans", func(t *testing.T) {
 		// Создаем несколько сканов
 		scans := []*common.Scan{
@@ -460,10 +897,185 @@ func TestScanRepository(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		scanList, err := repo.ListScans(ctx, version.ID)
+		scanList, _, err := repo.ListScans(ctx, version.ID, common.Page{})
 		require.NoError(t, err)
 		assert.GreaterOrEqual(t, len(scanList), 2)
 	})
+
+	t.Run("Keyset Scans", func(t *testing.T) {
+		// Несколько сканов за один день: порядок задаётся парой (scan_date, id)
+		day := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		for i := 0; i < 3; i++ {
+			require.NoError(t, repo.CreateScan(ctx, &common.Scan{ScanDate: day, VersionID: version.ID}))
+		}
+
+		all, total, err := repo.ListScans(ctx, version.ID, common.Page{})
+		require.NoError(t, err)
+		require.Equal(t, len(all), total)
+
+		// Постранично по ключу получаем те же сканы в том же порядке
+		var walked []*common.Scan
+		page := common.Page{Limit: 2}
+		for {
+			items, _, err := repo.ListScans(ctx, version.ID, page)
+			require.NoError(t, err)
+			walked = append(walked, items...)
+			if len(items) < page.Limit {
+				break
+			}
+			last := items[len(items)-1]
+			page.After = &common.PageKey{Time: last.ScanDate, ID: last.ID}
+		}
+		require.Len(t, walked, len(all))
+		for i := range all {
+			assert.Equal(t, all[i].ID, walked[i].ID)
+		}
+	})
+}
+
+func TestScanQueueRepository(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewPgxRepository(pool)
+	ctx := context.Background()
+
+	user := createTestUser(t, repo)
+	org := createTestOrg(t, repo, user.ID)
+	team := createTestTeam(t, repo, user.ID, org.ID)
+	app := &common.Application{Name: "app", TeamID: team.ID}
+	require.NoError(t, repo.CreateApplication(ctx, app))
+	version := &common.Version{ApplicationID: app.ID, Version: "1.0.0"}
+	require.NoError(t, repo.CreateVersion(ctx, version))
+
+	disabled := false
+	require.NoError(t, repo.CreateScanRule(ctx, &common.ScanRule{OrganizationID: org.ID, SCAScanEnabled: &disabled}))
+
+	sast := &common.Scan{ScanDate: time.Now(), VersionID: version.ID, Type: common.ScanTypeSAST}
+	require.NoError(t, repo.CreateScan(ctx, sast))
+	sca := &common.Scan{ScanDate: time.Now(), VersionID: version.ID, Type: common.ScanTypeSCA}
+	require.NoError(t, repo.CreateScan(ctx, sca))
+
+	opts := common.ScanQueueOptions{LeaseTTL: time.Minute, MaxAttempts: 2, RetryBackoff: time.Hour, MaxRetryBackoff: time.Hour}
+	expire := func(t *testing.T) {
+		_, err := pool.Exec(ctx, `UPDATE scans SET lease_expires_at = NOW() - interval '1 second' WHERE id = $1`, sast.ID)
+		require.NoError(t, err)
+	}
+
+	t.Run("Disabled By Rule", func(t *testing.T) {
+		lease, err := repo.LeaseScan(ctx, "w1", []common.ScanType{common.ScanTypeSCA}, opts)
+		require.NoError(t, err)
+		assert.Nil(t, lease)
+
+		cancelled, err := repo.GetScanByID(ctx, sca.ID)
+		require.NoError(t, err)
+		assert.Equal(t, common.ScanCancelled, cancelled.Status)
+		assert.Contains(t, *cancelled.ExitReason, "disabled")
+	})
+
+	t.Run("Lease And Heartbeat", func(t *testing.T) {
+		lease, err := repo.LeaseScan(ctx, "w1", []common.ScanType{common.ScanTypeSAST, common.ScanTypeSCA}, opts)
+		require.NoError(t, err)
+		require.NotNil(t, lease)
+		assert.Equal(t, sast.ID, lease.Scan.ID)
+		assert.Equal(t, common.ScanRunning, lease.Scan.Status)
+		assert.Equal(t, 1, lease.Scan.Attempts)
+		assert.Equal(t, "w1", *lease.Scan.LeaseOwner)
+		assert.False(t, lease.Rule.Settings.ScanEnabled(common.ScanTypeSCA))
+
+		// Арендованный скан другим воркерам не достаётся
+		other, err := repo.LeaseScan(ctx, "w2", []common.ScanType{common.ScanTypeSAST}, opts)
+		require.NoError(t, err)
+		assert.Nil(t, other)
+
+		_, err = repo.HeartbeatScan(ctx, sast.ID, "w2", opts.LeaseTTL)
+		assert.ErrorIs(t, err, common.ErrConflict)
+		extended, err := repo.HeartbeatScan(ctx, sast.ID, "w1", opts.LeaseTTL)
+		require.NoError(t, err)
+		assert.False(t, extended.LeaseExpiresAt.Before(*lease.Scan.LeaseExpiresAt))
+	})
+
+	t.Run("Progress", func(t *testing.T) {
+		_, err := repo.ReportScanProgress(ctx, sast.ID, "w2", common.ScanProgress{Percent: 10}, opts.LeaseTTL)
+		assert.ErrorIs(t, err, common.ErrConflict)
+		_, err = repo.ReportScanProgress(ctx, sast.ID, "w1", common.ScanProgress{Percent: 101}, opts.LeaseTTL)
+		assert.ErrorIs(t, err, common.ErrValidation)
+
+		listenCtx, stopListening := context.WithCancel(ctx)
+		changed := make(chan int, 100)
+		listened := make(chan error, 1)
+		go func() {
+			listened <- repo.ListenScanChanges(listenCtx, func(id int) { changed <- id })
+		}()
+
+		// LISTEN начинает слушать не сразу: отчёты повторяются, пока уведомление не придёт
+		var scan *common.Scan
+		for percent := 1; ; percent++ {
+			scan, err = repo.ReportScanProgress(ctx, sast.ID, "w1", common.ScanProgress{Phase: "analyzing", Percent: percent, Message: "a.go"}, opts.LeaseTTL)
+			require.NoError(t, err)
+			select {
+			case id := <-changed:
+				assert.Equal(t, sast.ID, id)
+			case <-time.After(100 * time.Millisecond):
+				require.Less(t, percent, 50, "no scan change notification")
+				continue
+			}
+			break
+		}
+		stopListening()
+		assert.Error(t, <-listened)
+
+		assert.Equal(t, "analyzing", *scan.Phase)
+		assert.Positive(t, scan.Progress)
+		assert.Equal(t, "a.go", *scan.ProgressMessage)
+	})
+
+	t.Run("Expired Lease", func(t *testing.T) {
+		expire(t)
+		requeued, failed, err := repo.RequeueExpiredScans(ctx, opts)
+		require.NoError(t, err)
+		assert.Equal(t, 1, requeued)
+		assert.Zero(t, failed)
+
+		scan, err := repo.GetScanByID(ctx, sast.ID)
+		require.NoError(t, err)
+		assert.Equal(t, common.ScanQueued, scan.Status)
+		assert.Nil(t, scan.LeaseOwner)
+		assert.True(t, scan.AvailableAt.After(time.Now()))
+		assert.Nil(t, scan.Phase)
+		assert.Zero(t, scan.Progress)
+
+		// До истечения задержки скан не выдаётся
+		lease, err := repo.LeaseScan(ctx, "w2", []common.ScanType{common.ScanTypeSAST}, opts)
+		require.NoError(t, err)
+		assert.Nil(t, lease)
+
+		_, err = pool.Exec(ctx, `UPDATE scans SET available_at = NOW() WHERE id = $1`, sast.ID)
+		require.NoError(t, err)
+		lease, err = repo.LeaseScan(ctx, "w2", []common.ScanType{common.ScanTypeSAST}, opts)
+		require.NoError(t, err)
+		require.NotNil(t, lease)
+		assert.Equal(t, 2, lease.Scan.Attempts)
+
+		// Завершить арендованный скан может только воркер, который его арендовал
+		_, err = repo.TransitionScan(ctx, sast.ID, common.ScanSucceeded, "w1", nil)
+		assert.ErrorIs(t, err, common.ErrConflict)
+		_, err = repo.TransitionScan(ctx, sast.ID, common.ScanSucceeded, "", nil)
+		assert.ErrorIs(t, err, common.ErrConflict)
+
+		// Попытки исчерпаны — скан завершается ошибкой
+		expire(t)
+		requeued, failed, err = repo.RequeueExpiredScans(ctx, opts)
+		require.NoError(t, err)
+		assert.Zero(t, requeued)
+		assert.Equal(t, 1, failed)
+
+		scan, err = repo.GetScanByID(ctx, sast.ID)
+		require.NoError(t, err)
+		assert.Equal(t, common.ScanFailed, scan.Status)
+		assert.NotNil(t, scan.FinishedAt)
+		assert.Contains(t, *scan.ExitReason, "w2")
+	})
 }
 
 func TestScanInfoRepository(t *testing.T) {
@@ -511,6 +1123,329 @@ func TestScanInfoRepository(t *testing.T) {
 	})
 }
 
+// findingSource отдаёт находки по одной, как поток загрузки
+func findingSource(findings ...*common.Finding) func() (*common.Finding, error) {
+	return func() (*common.Finding, error) {
+		if len(findings) == 0 {
+			return nil, io.EOF
+		}
+		f := findings[0]
+		findings = findings[1:]
+		return f, nil
+	}
+}
+
+func TestFindingRepository(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewPgxRepository(pool)
+	ctx := context.Background()
+
+	user := createTestUser(t, repo)
+	org := createTestOrg(t, repo, user.ID)
+	team := createTestTeam(t, repo, user.ID, org.ID)
+	app := &common.Application{Name: "app", TeamID: team.ID}
+	require.NoError(t, repo.CreateApplication(ctx, app))
+	version := &common.Version{ApplicationID: app.ID, Version: "1.0.0"}
+	require.NoError(t, repo.CreateVersion(ctx, version))
+	scan := &common.Scan{ScanDate: time.Now(), VersionID: version.ID, Type: common.ScanTypeSAST}
+	require.NoError(t, repo.CreateScan(ctx, scan))
+
+	finding := func(severity common.Severity, cwe int, file string) *common.Finding {
+		line := 3
+		return &common.Finding{Tool: "semgrep", RuleID: "rule", Severity: severity, CWE: &cwe, File: &file,
+			StartLine: &line, Message: "message", Fingerprint: file}
+	}
+
+	t.Run("Upload And List", func(t *testing.T) {
+		count, err := repo.ReplaceFindings(ctx, scan.ID, nil, findingSource(
+			finding(common.SeverityLow, 79, "web/a.js"),
+			finding(common.SeverityHigh, 89, "api/b.go"),
+			finding(common.SeverityCritical, 89, "api/c.go"),
+		))
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+
+		findings, total, err := repo.ListFindings(ctx, scan.ID, "", common.Page{})
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		require.Len(t, findings, 3)
+		assert.Equal(t, scan.ID, findings[0].ScanID)
+		assert.Equal(t, "web/a.js", *findings[0].File)
+		assert.Nil(t, findings[0].EndLine)
+
+		findings, total, err = repo.ListFindings(ctx, scan.ID, common.SeverityHigh, common.Page{})
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Len(t, findings, 2)
+
+		findings, _, err = repo.ListFindings(ctx, scan.ID, "", common.Page{Filter: `cwe = 89 AND file = "api/*" AND severity = "critical"`})
+		require.NoError(t, err)
+		require.Len(t, findings, 1)
+		assert.Equal(t, "api/c.go", *findings[0].File)
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		count, err := repo.ReplaceFindings(ctx, scan.ID, nil, findingSource(finding(common.SeverityMedium, 22, "x.go")))
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		// Ошибка источника откатывает загрузку целиком
+		failing := errors.New("stream broken")
+		next := findingSource(finding(common.SeverityLow, 1, "y.go"))
+		_, err = repo.ReplaceFindings(ctx, scan.ID, nil, func() (*common.Finding, error) {
+			if f, err := next(); err == nil {
+				return f, nil
+			}
+			return nil, failing
+		})
+		assert.ErrorIs(t, err, failing)
+
+		findings, total, err := repo.ListFindings(ctx, scan.ID, "", common.Page{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, "x.go", *findings[0].File)
+	})
+
+	t.Run("Rules", func(t *testing.T) {
+		sarifFinding := finding(common.SeverityHigh, 79, "web/render.js")
+		sarifFinding.Tool, sarifFinding.RuleID = "CodeQL", "js/xss"
+		toolVersion := "2.15.3"
+		sarifFinding.ToolVersion = &toolVersion
+		sarifFinding.PartialFingerprints = map[string]string{"primaryLocationLineHash": "a4b5f7f6fe3b2c1d:1"}
+		rules := []*common.FindingRule{
+			{Tool: "CodeQL", RuleID: "js/xss", Name: "XSS", ShortDescription: "Cross-site scripting",
+				HelpURI: "https://codeql.github.com/", Tags: []string{"security", "external/cwe/cwe-079"}},
+			{Tool: "CodeQL", RuleID: "js/unused-local-variable"},
+		}
+		_, err := repo.ReplaceFindings(ctx, scan.ID, rules, findingSource(sarifFinding))
+		require.NoError(t, err)
+
+		findings, _, err := repo.ListFindings(ctx, scan.ID, "", common.Page{})
+		require.NoError(t, err)
+		require.Len(t, findings, 1)
+		assert.Equal(t, "2.15.3", *findings[0].ToolVersion)
+		assert.Equal(t, sarifFinding.PartialFingerprints, findings[0].PartialFingerprints)
+
+		stored, err := repo.ListFindingRules(ctx, scan.ID)
+		require.NoError(t, err)
+		require.Len(t, stored, 2)
+		assert.Equal(t, "js/unused-local-variable", stored[0].RuleID)
+		assert.Equal(t, scan.ID, stored[1].ScanID)
+		assert.Equal(t, "Cross-site scripting", stored[1].ShortDescription)
+		assert.Equal(t, []string{"security", "external/cwe/cwe-079"}, stored[1].Tags)
+
+		// Загрузка без правил удаляет правила прошлой загрузки
+		_, err = repo.ReplaceFindings(ctx, scan.ID, nil, findingSource(finding(common.SeverityLow, 1, "x.go")))
+		require.NoError(t, err)
+		stored, err = repo.ListFindingRules(ctx, scan.ID)
+		require.NoError(t, err)
+		assert.Empty(t, stored)
+
+		findings, _, err = repo.ListFindings(ctx, scan.ID, "", common.Page{})
+		require.NoError(t, err)
+		assert.Nil(t, findings[0].ToolVersion)
+		assert.Empty(t, findings[0].PartialFingerprints)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := repo.ReplaceFindings(ctx, scan.ID+1000, nil, findingSource())
+		assert.ErrorIs(t, err, common.ErrNotFound)
+
+		sca := &common.Scan{ScanDate: time.Now(), VersionID: version.ID, Type: common.ScanTypeSCA}
+		require.NoError(t, repo.CreateScan(ctx, sca))
+		_, err = repo.ReplaceFindings(ctx, sca.ID, nil, findingSource(finding(common.SeverityLow, 1, "x.go")))
+		assert.ErrorIs(t, err, common.ErrConflict)
+
+		invalid := finding(common.SeverityLow, 1, "z.go")
+		invalid.EndLine = new(int)
+		_, err = repo.ReplaceFindings(ctx, scan.ID, nil, findingSource(invalid))
+		assert.ErrorIs(t, err, common.ErrValidation)
+
+		_, _, err = repo.ListFindings(ctx, scan.ID, "", common.Page{Filter: `message = "x"`})
+		var filterErr *filter.Error
+		assert.ErrorAs(t, err, &filterErr)
+	})
+
+	t.Run("Baseline", func(t *testing.T) {
+		walk := func(scanID int) ([]string, []common.BaselineState, []*common.Finding) {
+			var files []string
+			var states []common.BaselineState
+			var findings []*common.Finding
+			require.NoError(t, repo.WalkFindingsWithBaseline(ctx, scanID, func(f *common.Finding, state common.BaselineState) error {
+				files, states, findings = append(files, *f.File), append(states, state), append(findings, f)
+				return nil
+			}))
+			return files, states, findings
+		}
+
+		_, err := repo.TransitionScan(ctx, scan.ID, common.ScanRunning, "", nil)
+		require.NoError(t, err)
+		_, err = repo.TransitionScan(ctx, scan.ID, common.ScanSucceeded, "", nil)
+		require.NoError(t, err)
+		_, err = repo.ReplaceFindings(ctx, scan.ID, nil, findingSource(
+			finding(common.SeverityLow, 1, "fixed.go"),
+			finding(common.SeverityLow, 1, "kept.go"),
+		))
+		require.NoError(t, err)
+
+		// Незавершённый скан и скан другого типа не служат базой
+		queued := &common.Scan{ScanDate: time.Now(), VersionID: version.ID, Type: common.ScanTypeSAST}
+		require.NoError(t, repo.CreateScan(ctx, queued))
+		_, err = repo.ReplaceFindings(ctx, queued.ID, nil, findingSource(finding(common.SeverityLow, 1, "queued.go")))
+		require.NoError(t, err)
+		sca := &common.Scan{ScanDate: time.Now(), VersionID: version.ID, Type: common.ScanTypeSCA}
+		require.NoError(t, repo.CreateScan(ctx, sca))
+
+		current := &common.Scan{ScanDate: time.Now(), VersionID: version.ID, Type: common.ScanTypeSAST}
+		require.NoError(t, repo.CreateScan(ctx, current))
+		added := finding(common.SeverityHigh, 1, "added.go")
+		added.Suppression = &common.Suppression{Kind: common.SuppressionExternal, Status: common.SuppressionUnderReview, Justification: "triage"}
+		_, err = repo.ReplaceFindings(ctx, current.ID, nil, findingSource(finding(common.SeverityLow, 1, "kept.go"), added))
+		require.NoError(t, err)
+
+		files, states, findings := walk(current.ID)
+		assert.Equal(t, []string{"kept.go", "added.go", "fixed.go"}, files)
+		assert.Equal(t, []common.BaselineState{common.BaselineUnchanged, common.BaselineNew, common.BaselineAbsent}, states)
+		assert.Equal(t, added.Suppression, findings[1].Suppression)
+		assert.Nil(t, findings[0].Suppression)
+		assert.Equal(t, scan.ID, findings[2].ScanID)
+
+		files, states, _ = walk(scan.ID)
+		assert.Equal(t, []string{"fixed.go", "kept.go"}, files)
+		assert.Equal(t, []common.BaselineState{"", ""}, states)
+
+		files, _, _ = walk(sca.ID)
+		assert.Empty(t, files)
+
+		// База ищется во всех версиях приложения, но не в других приложениях. Дата скана
+		// задаётся клиентом и на выбор базы не влияет
+		nextVersion := &common.Version{ApplicationID: app.ID, Version: "1.1.0"}
+		require.NoError(t, repo.CreateVersion(ctx, nextVersion))
+		next := &common.Scan{ScanDate: time.Now().Add(-24 * time.Hour), VersionID: nextVersion.ID, Type: common.ScanTypeSAST}
+		require.NoError(t, repo.CreateScan(ctx, next))
+		_, err = repo.ReplaceFindings(ctx, next.ID, nil, findingSource(finding(common.SeverityLow, 1, "kept.go")))
+		require.NoError(t, err)
+		files, states, _ = walk(next.ID)
+		assert.Equal(t, []string{"kept.go", "fixed.go"}, files)
+		assert.Equal(t, []common.BaselineState{common.BaselineUnchanged, common.BaselineAbsent}, states)
+
+		otherApp := &common.Application{Name: "other-app", TeamID: team.ID}
+		require.NoError(t, repo.CreateApplication(ctx, otherApp))
+		otherVersion := &common.Version{ApplicationID: otherApp.ID, Version: "1.0.0"}
+		require.NoError(t, repo.CreateVersion(ctx, otherVersion))
+		other := &common.Scan{ScanDate: time.Now(), VersionID: otherVersion.ID, Type: common.ScanTypeSAST}
+		require.NoError(t, repo.CreateScan(ctx, other))
+		_, err = repo.ReplaceFindings(ctx, other.ID, nil, findingSource(finding(common.SeverityLow, 1, "kept.go")))
+		require.NoError(t, err)
+		_, states, _ = walk(other.ID)
+		assert.Equal(t, []common.BaselineState{""}, states)
+
+		err = repo.WalkFindingsWithBaseline(ctx, current.ID+1000, func(*common.Finding, common.BaselineState) error { return nil })
+		assert.ErrorIs(t, err, common.ErrNotFound)
+
+		invalid := finding(common.SeverityLow, 1, "z.go")
+		invalid.Suppression = &common.Suppression{Kind: "comment", Status: common.SuppressionAccepted}
+		_, err = repo.ReplaceFindings(ctx, current.ID, nil, findingSource(invalid))
+		assert.ErrorIs(t, err, common.ErrValidation)
+	})
+}
+
+func TestComponentRepository(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewPgxRepository(pool)
+	ctx := context.Background()
+
+	user := createTestUser(t, repo)
+	org := createTestOrg(t, repo, user.ID)
+	team := createTestTeam(t, repo, user.ID, org.ID)
+	app := &common.Application{Name: "app", TeamID: team.ID}
+	require.NoError(t, repo.CreateApplication(ctx, app))
+	version := &common.Version{ApplicationID: app.ID, Version: "1.0.0"}
+	require.NoError(t, repo.CreateVersion(ctx, version))
+	scan := &common.Scan{ScanDate: time.Now(), VersionID: version.ID, Type: common.ScanTypeSCA}
+	require.NoError(t, repo.CreateScan(ctx, scan))
+
+	str := func(s string) *string { return &s }
+	components := []*common.Component{
+		{Ref: "app", Primary: true, Name: "web-shop", Version: str("1.2.0"), DependsOn: []string{"lodash", "express"}},
+		{Ref: "express", Name: "express", Version: str("4.18.2"), PURL: str("pkg:npm/express@4.18.2"),
+			Licenses: []string{"MIT"}, Hashes: map[string]string{"SHA-512": "5b3a"}},
+		{Ref: "lodash", Name: "lodash", PURL: str("pkg:npm/lodash@4.17.21"), Licenses: []string{"MIT", "GPL-2.0-only"}},
+	}
+
+	t.Run("Replace And List", func(t *testing.T) {
+		require.NoError(t, repo.ReplaceComponents(ctx, scan.ID, components))
+		// Повторная загрузка заменяет компоненты прошлой
+		require.NoError(t, repo.ReplaceComponents(ctx, scan.ID, components))
+
+		stored, total, err := repo.ListComponents(ctx, scan.ID, common.Page{})
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		require.Len(t, stored, 3)
+		assert.Equal(t, scan.ID, stored[0].ScanID)
+		assert.True(t, stored[0].Primary)
+		assert.Equal(t, []string{"express", "lodash"}, stored[0].DependsOn)
+		assert.Equal(t, map[string]string{}, stored[0].Hashes)
+		assert.Equal(t, []string{}, stored[0].Licenses)
+		assert.Equal(t, "pkg:npm/express@4.18.2", *stored[1].PURL)
+		assert.Equal(t, map[string]string{"SHA-512": "5b3a"}, stored[1].Hashes)
+		assert.Nil(t, stored[2].Version)
+		assert.Empty(t, stored[2].DependsOn)
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		stored, total, err := repo.ListComponents(ctx, scan.ID, common.Page{Filter: `licenses : "GPL"`})
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, "lodash", stored[0].Name)
+
+		stored, _, err = repo.ListComponents(ctx, scan.ID, common.Page{Filter: `purl = "pkg:npm/*" AND is_primary = false`, OrderBy: "name desc"})
+		require.NoError(t, err)
+		require.Len(t, stored, 2)
+		assert.Equal(t, "lodash", stored[0].Name)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		sast := &common.Scan{ScanDate: time.Now(), VersionID: version.ID, Type: common.ScanTypeSAST}
+		require.NoError(t, repo.CreateScan(ctx, sast))
+		assert.ErrorIs(t, repo.ReplaceComponents(ctx, sast.ID, components), common.ErrConflict)
+		assert.ErrorIs(t, repo.ReplaceComponents(ctx, sast.ID+1000, components), common.ErrNotFound)
+
+		// Компоненты скана без типа не нашлись бы ни в ListComponents, ни в ExportSbom
+		untyped := &common.Scan{ScanDate: time.Now(), VersionID: version.ID}
+		require.NoError(t, repo.CreateScan(ctx, untyped))
+		assert.ErrorIs(t, repo.ReplaceComponents(ctx, untyped.ID, components), common.ErrConflict)
+
+		// Зависимость от компонента не из этого SBOM нарушает внешний ключ
+		dangling := []*common.Component{{Ref: "a", Name: "a", DependsOn: []string{"b"}}}
+		assert.Error(t, repo.ReplaceComponents(ctx, scan.ID, dangling))
+		_, total, err := repo.ListComponents(ctx, scan.ID, common.Page{})
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+	})
+
+	t.Run("Latest Scan", func(t *testing.T) {
+		_, err := repo.GetLatestScan(ctx, version.ID, common.ScanTypeSCA, common.ScanSucceeded)
+		assert.ErrorIs(t, err, common.ErrNotFound)
+
+		_, err = repo.TransitionScan(ctx, scan.ID, common.ScanRunning, "", nil)
+		require.NoError(t, err)
+		_, err = repo.TransitionScan(ctx, scan.ID, common.ScanSucceeded, "", nil)
+		require.NoError(t, err)
+		// Более поздний, но не завершённый скан не учитывается
+		queued := &common.Scan{ScanDate: time.Now(), VersionID: version.ID, Type: common.ScanTypeSCA}
+		require.NoError(t, repo.CreateScan(ctx, queued))
+
+		latest, err := repo.GetLatestScan(ctx, version.ID, common.ScanTypeSCA, common.ScanSucceeded)
+		require.NoError(t, err)
+		assert.Equal(t, scan.ID, latest.ID)
+	})
+}
+
 func TestScanRuleRepository(t *testing.T) {
 	pool, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -529,6 +1464,13 @@ func TestScanRuleRepository(t *testing.T) {
 	err := repo.CreateApplication(ctx, app)
 	require.NoError(t, err)
 
+	// На уровне может быть только одно правило, поэтому у каждого подтеста своё приложение
+	newApp := func(t *testing.T) *common.Application {
+		app := &common.Application{Name: t.Name(), TeamID: team.ID}
+		require.NoError(t, repo.CreateApplication(ctx, app))
+		return app
+	}
+
 	// Вспомогательные переменные для boolean полей
 	scaEnabled := true
 	sastEnabled := false
@@ -538,9 +1480,10 @@ func TestScanRuleRepository(t *testing.T) {
 	activeBlocking := false
 
 	t.Run("Create and Get ScanRule", func(t *testing.T) {
+		app := newApp(t)
 		rule := &common.ScanRule{
-			ApplicationID:         app.ID,
-			TeamID:                team.ID,
+			ApplicationID:         &app.ID,
+			TeamID:                &team.ID,
 			OrganizationID:        org.ID,
 			SCAScanEnabled:        &scaEnabled,
 			SASTScanEnabled:       &sastEnabled,
@@ -557,7 +1500,7 @@ func TestScanRuleRepository(t *testing.T) {
 		assert.NotZero(t, rule.ID)
 
 		// Тестируем получение по составному ключу
-		fetchedRule, err := repo.GetScanRuleByComposite(ctx, app.ID, team.ID, org.ID)
+		fetchedRule, err := repo.GetScanRuleByComposite(ctx, &app.ID, &team.ID, org.ID)
 		require.NoError(t, err)
 		require.NotNil(t, fetchedRule)
 
@@ -582,10 +1525,11 @@ func TestScanRuleRepository(t *testing.T) {
 	})
 
 	t.Run("Update ScanRule", func(t *testing.T) {
+		app := newApp(t)
 		// Создаем тестовое правило
 		rule := &common.ScanRule{
-			ApplicationID:         app.ID,
-			TeamID:                team.ID,
+			ApplicationID:         &app.ID,
+			TeamID:                &team.ID,
 			OrganizationID:        org.ID,
 			SCAScanEnabled:        &scaEnabled,
 			SASTScanEnabled:       &sastEnabled,
@@ -600,8 +1544,8 @@ func TestScanRuleRepository(t *testing.T) {
 		newExcludeDirs := []string{"build", "dist"}
 		updatedRule := &common.ScanRule{
 			ID:                    rule.ID,
-			ApplicationID:         app.ID,
-			TeamID:                team.ID,
+			ApplicationID:         &app.ID,
+			TeamID:                &team.ID,
 			OrganizationID:        org.ID,
 			SCAScanEnabled:        rule.SCAScanEnabled,
 			SASTScanEnabled:       &newSastEnabled,
@@ -609,7 +1553,9 @@ func TestScanRuleRepository(t *testing.T) {
 			ExcludeDirRegexpQueue: newExcludeDirs,
 		}
 
-		err = repo.UpdateScanRule(ctx, updatedRule)
+		_, err = repo.UpdateScanRule(ctx, updatedRule, []string{
+			"sca_scan_enabled", "sast_scan_enabled", "allow_incremental_scans", "exclude_dir_regexp_queue",
+		})
 		require.NoError(t, err)
 
 		// Проверяем обновленные данные
@@ -620,17 +1566,80 @@ func TestScanRuleRepository(t *testing.T) {
 		assert.Equal(t, updatedRule.ExcludeDirRegexpQueue, fetched.ExcludeDirRegexpQueue)
 	})
 
+	t.Run("Partial Update ScanRule", func(t *testing.T) {
+		app := newApp(t)
+		rule := &common.ScanRule{
+			ApplicationID:         &app.ID,
+			TeamID:                &team.ID,
+			OrganizationID:        org.ID,
+			SCAScanEnabled:        &scaEnabled,
+			SASTScanEnabled:       &sastEnabled,
+			ExcludeDirRegexpQueue: []string{"vendor"},
+		}
+		require.NoError(t, repo.CreateScanRule(ctx, rule))
+
+		// Поля вне маски не меняются, даже если в модели у них другие значения
+		updated, err := repo.UpdateScanRule(ctx, &common.ScanRule{ID: rule.ID}, []string{"exclude_dir_regexp_queue"})
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Empty(t, updated.ExcludeDirRegexpQueue)
+		assert.Equal(t, &app.ID, updated.ApplicationID)
+		assert.Equal(t, scaEnabled, *updated.SCAScanEnabled)
+		assert.Equal(t, sastEnabled, *updated.SASTScanEnabled)
+
+		_, err = repo.UpdateScanRule(ctx, rule, []string{"id"})
+		assert.Error(t, err)
+		_, err = repo.UpdateScanRule(ctx, rule, nil)
+		assert.Error(t, err)
+
+		missing, err := repo.UpdateScanRule(ctx, &common.ScanRule{ID: -1}, []string{"sca_scan_enabled"})
+		assert.ErrorIs(t, err, common.ErrNotFound)
+		assert.Nil(t, missing)
+	})
+
+	t.Run("Conditional Update ScanRule", func(t *testing.T) {
+		app := newApp(t)
+		rule := &common.ScanRule{
+			ApplicationID:  &app.ID,
+			TeamID:         &team.ID,
+			OrganizationID: org.ID,
+			SCAScanEnabled: &scaEnabled,
+		}
+		require.NoError(t, repo.CreateScanRule(ctx, rule))
+		assert.Equal(t, 1, rule.Revision)
+
+		// Каждое обновление увеличивает ревизию
+		updated, err := repo.UpdateScanRule(ctx, &common.ScanRule{ID: rule.ID, Revision: rule.Revision, SASTScanEnabled: &sastEnabled}, []string{"sast_scan_enabled"})
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, 2, updated.Revision)
+
+		// Второй писатель со старой ревизией не перезаписывает чужое изменение
+		_, err = repo.UpdateScanRule(ctx, &common.ScanRule{ID: rule.ID, Revision: rule.Revision}, []string{"sast_scan_enabled"})
+		assert.ErrorIs(t, err, common.ErrRevisionMismatch)
+		assert.ErrorIs(t, repo.DeleteScanRule(ctx, rule.ID, rule.Revision), common.ErrRevisionMismatch)
+
+		fetched, err := repo.GetScanRuleByID(ctx, rule.ID)
+		require.NoError(t, err)
+		assert.Equal(t, sastEnabled, *fetched.SASTScanEnabled)
+
+		require.NoError(t, repo.DeleteScanRule(ctx, rule.ID, updated.Revision))
+		missing, err := repo.UpdateScanRule(ctx, &common.ScanRule{ID: rule.ID, Revision: updated.Revision}, []string{"sast_scan_enabled"})
+		assert.ErrorIs(t, err, common.ErrNotFound)
+		assert.Nil(t, missing)
+	})
+
 	t.Run("List and Delete ScanRule", func(t *testing.T) {
+		app := newApp(t)
 		// Создаем несколько правил
 		rule1 := &common.ScanRule{
-			ApplicationID:  app.ID,
-			TeamID:         team.ID,
+			ApplicationID:  &app.ID,
+			TeamID:         &team.ID,
 			OrganizationID: org.ID,
 			SCAScanEnabled: &scaEnabled,
 		}
 		rule2 := &common.ScanRule{
-			ApplicationID:   app.ID,
-			TeamID:          team.ID,
+			TeamID:          &team.ID,
 			OrganizationID:  org.ID,
 			SASTScanEnabled: &sastEnabled,
 		}
@@ -638,25 +1647,26 @@ func TestScanRuleRepository(t *testing.T) {
 		require.NoError(t, repo.CreateScanRule(ctx, rule2))
 
 		// Тестируем получение списка
-		rules, err := repo.ListScanRules(ctx)
+		rules, _, err := repo.ListScanRules(ctx, common.Page{})
 		require.NoError(t, err)
 		assert.GreaterOrEqual(t, len(rules), 2)
 
 		// Тестируем удаление
-		err = repo.DeleteScanRule(ctx, rule1.ID)
+		err = repo.DeleteScanRule(ctx, rule1.ID, 0)
 		require.NoError(t, err)
 
 		// Проверяем, что правило удалено
 		deletedRule, err := repo.GetScanRuleByID(ctx, rule1.ID)
-		require.NoError(t, err)
+		assert.ErrorIs(t, err, common.ErrNotFound)
 		assert.Nil(t, deletedRule)
 	})
 
 	t.Run("Edge Cases", func(t *testing.T) {
+		app := newApp(t)
 		// Тест на NULL значения
 		nullRule := &common.ScanRule{
-			ApplicationID:  app.ID,
-			TeamID:         team.ID,
+			ApplicationID:  &app.ID,
+			TeamID:         &team.ID,
 			OrganizationID: org.ID,
 			// Остальные поля nil
 		}
@@ -669,4 +1679,311 @@ func TestScanRuleRepository(t *testing.T) {
 		assert.Nil(t, fetchedNull.SASTScanEnabled)
 		assert.Nil(t, fetchedNull.ExcludeDirRegexpQueue)
 	})
+
+	t.Run("Check Scope", func(t *testing.T) {
+		require.NoError(t, repo.CheckScanRuleScope(ctx, &app.ID, &team.ID, org.ID))
+
+		otherOrg := createTestOrg(t, repo, user.ID)
+		otherTeam := createTestTeam(t, repo, user.ID, otherOrg.ID)
+
+		var domainErr *common.Error
+		err := repo.CheckScanRuleScope(ctx, &app.ID, &otherTeam.ID, otherOrg.ID)
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, common.KindValidation, domainErr.Kind)
+		assert.Equal(t, "team_id", domainErr.Field)
+
+		err = repo.CheckScanRuleScope(ctx, &app.ID, &team.ID, otherOrg.ID)
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, "organization_id", domainErr.Field)
+
+		missingID := 9999
+		err = repo.CheckScanRuleScope(ctx, &missingID, &team.ID, org.ID)
+		assert.ErrorIs(t, err, common.ErrInvalidReference)
+
+		// Правила команды и организации
+		require.NoError(t, repo.CheckScanRuleScope(ctx, nil, &team.ID, org.ID))
+		require.NoError(t, repo.CheckScanRuleScope(ctx, nil, nil, org.ID))
+
+		err = repo.CheckScanRuleScope(ctx, nil, &team.ID, otherOrg.ID)
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, "organization_id", domainErr.Field)
+
+		err = repo.CheckScanRuleScope(ctx, &app.ID, nil, org.ID)
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, "team_id", domainErr.Field)
+	})
+
+	t.Run("Effective ScanRule", func(t *testing.T) {
+		// Отдельное приложение, чтобы правила других подтестов не попали в цепочку
+		effOrg := createTestOrg(t, repo, user.ID)
+		effTeam := createTestTeam(t, repo, user.ID, effOrg.ID)
+		effApp := &common.Application{Name: "effective-app", TeamID: effTeam.ID}
+		require.NoError(t, repo.CreateApplication(ctx, effApp))
+
+		eff, err := repo.GetEffectiveScanRule(ctx, effApp.ID)
+		require.NoError(t, err)
+		assert.Empty(t, eff.Chain)
+		assert.Empty(t, eff.Sources)
+		assert.Equal(t, effTeam.ID, eff.TeamID)
+		assert.Equal(t, effOrg.ID, eff.OrganizationID)
+
+		orgRule := &common.ScanRule{
+			OrganizationID:        effOrg.ID,
+			SCAScanEnabled:        &scaEnabled,
+			SASTScanEnabled:       &sastEnabled,
+			ExcludeDirRegexpQueue: []string{"vendor"},
+		}
+		teamRule := &common.ScanRule{OrganizationID: effOrg.ID, TeamID: &effTeam.ID, SASTScanEnabled: &allowIncremental}
+		appRule := &common.ScanRule{OrganizationID: effOrg.ID, TeamID: &effTeam.ID, ApplicationID: &effApp.ID, ExcludeDirRegexpQueue: []string{"build"}}
+		for _, rule := range []*common.ScanRule{appRule, orgRule, teamRule} {
+			require.NoError(t, repo.CreateScanRule(ctx, rule))
+		}
+
+		eff, err = repo.GetEffectiveScanRule(ctx, effApp.ID)
+		require.NoError(t, err)
+		require.Len(t, eff.Chain, 3)
+		assert.Equal(t, []int{orgRule.ID, teamRule.ID, appRule.ID}, []int{eff.Chain[0].ID, eff.Chain[1].ID, eff.Chain[2].ID})
+
+		assert.Equal(t, scaEnabled, *eff.Settings.SCAScanEnabled)
+		assert.Equal(t, allowIncremental, *eff.Settings.SASTScanEnabled)
+		assert.Equal(t, []string{"build"}, eff.Settings.ExcludeDirRegexpQueue)
+		assert.Nil(t, eff.Settings.ForcedDoOwnSBOM)
+		assert.Equal(t, []common.ScanRuleSource{
+			{Field: "sca_scan_enabled", Level: common.ScanRuleLevelOrganization, RuleID: orgRule.ID},
+			{Field: "sast_scan_enabled", Level: common.ScanRuleLevelTeam, RuleID: teamRule.ID},
+			{Field: "exclude_dir_regexp_queue", Level: common.ScanRuleLevelApplication, RuleID: appRule.ID},
+		}, eff.Sources)
+
+		// Правило уровня команды находится по составному ключу без приложения
+		fetched, err := repo.GetScanRuleByComposite(ctx, nil, &effTeam.ID, effOrg.ID)
+		require.NoError(t, err)
+		assert.Equal(t, teamRule.ID, fetched.ID)
+
+		// Второе правило того же уровня отклоняет уникальный индекс, в том числе без приложения
+		err = repo.CreateScanRule(ctx, &common.ScanRule{OrganizationID: effOrg.ID, TeamID: &effTeam.ID})
+		assert.ErrorIs(t, err, common.ErrAlreadyExists)
+		err = repo.CreateScanRule(ctx, &common.ScanRule{OrganizationID: effOrg.ID})
+		assert.ErrorIs(t, err, common.ErrAlreadyExists)
+
+		// Правило приложения без команды запрещено ограничением
+		err = repo.CreateScanRule(ctx, &common.ScanRule{OrganizationID: effOrg.ID, ApplicationID: &effApp.ID})
+		assert.ErrorIs(t, err, common.ErrValidation)
+
+		_, err = repo.GetEffectiveScanRule(ctx, 999999)
+		assert.ErrorIs(t, err, common.ErrNotFound)
+	})
+}
+
+func TestScanRuleRevisionRepository(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewPgxRepository(pool)
+	user := createTestUser(t, repo)
+	org := createTestOrg(t, repo, user.ID)
+	team := createTestTeam(t, repo, user.ID, org.ID)
+
+	// Изменения от имени пользователя записываются в историю с его id
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: user.ID})
+	blocking, notBlocking := true, false
+
+	rule := &common.ScanRule{OrganizationID: org.ID, TeamID: &team.ID, ActiveBlockingSCA: &notBlocking}
+	require.NoError(t, repo.CreateScanRule(ctx, rule))
+	updated, err := repo.UpdateScanRule(ctx, &common.ScanRule{ID: rule.ID, ActiveBlockingSCA: &blocking}, []string{"active_blocking_sca"})
+	require.NoError(t, err)
+	require.NoError(t, repo.DeleteScanRule(ctx, rule.ID, updated.Revision))
+
+	t.Run("List", func(t *testing.T) {
+		revisions, total, err := repo.ListScanRuleRevisions(ctx, rule.ID, common.Page{})
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		require.Len(t, revisions, 3)
+
+		assert.Equal(t, common.ScanRuleInserted, revisions[0].Operation)
+		assert.Nil(t, revisions[0].Before)
+		assert.Equal(t, notBlocking, *revisions[0].After.ActiveBlockingSCA)
+		require.NotNil(t, revisions[0].ActorID)
+		assert.Equal(t, user.ID, *revisions[0].ActorID)
+
+		assert.Equal(t, common.ScanRuleUpdated, revisions[1].Operation)
+		assert.Equal(t, []common.ScanRuleChange{{Field: "active_blocking_sca", Old: false, New: true}},
+			common.DiffScanRules(revisions[1].Before, revisions[1].After))
+
+		assert.Equal(t, common.ScanRuleDeleted, revisions[2].Operation)
+		assert.Nil(t, revisions[2].After)
+		assert.Equal(t, &team.ID, revisions[2].Before.TeamID)
+
+		filtered, total, err := repo.ListScanRuleRevisions(ctx, rule.ID, common.Page{Filter: `operation = "update"`})
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, revisions[1].ID, filtered[0].ID)
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		revisions, _, err := repo.ListScanRuleRevisions(ctx, rule.ID, common.Page{})
+		require.NoError(t, err)
+
+		// Ревизию удаления восстановить нельзя
+		_, err = repo.RestoreScanRuleRevision(ctx, revisions[2], 0)
+		assert.ErrorIs(t, err, common.ErrValidation)
+
+		// Удалённое правило создаётся заново с прежним id
+		restored, err := repo.RestoreScanRuleRevision(ctx, revisions[0], 0)
+		require.NoError(t, err)
+		assert.Equal(t, rule.ID, restored.ID)
+		assert.Equal(t, notBlocking, *restored.ActiveBlockingSCA)
+
+		restored, err = repo.RestoreScanRuleRevision(ctx, revisions[1], restored.Revision)
+		require.NoError(t, err)
+		assert.Equal(t, blocking, *restored.ActiveBlockingSCA)
+
+		_, err = repo.RestoreScanRuleRevision(ctx, revisions[0], restored.Revision-1)
+		assert.ErrorIs(t, err, common.ErrRevisionMismatch)
+
+		history, total, err := repo.ListScanRuleRevisions(ctx, rule.ID, common.Page{})
+		require.NoError(t, err)
+		assert.Equal(t, 5, total)
+		assert.Equal(t, common.ScanRuleInserted, history[3].Operation)
+		assert.Equal(t, &revisions[0].ID, history[3].RestoredFrom)
+		assert.Equal(t, &revisions[1].ID, history[4].RestoredFrom)
+
+		fetched, err := repo.GetScanRuleRevision(ctx, history[4].ID)
+		require.NoError(t, err)
+		assert.Equal(t, common.ScanRuleUpdated, fetched.Operation)
+
+		_, err = repo.GetScanRuleRevision(ctx, -1)
+		assert.ErrorIs(t, err, common.ErrNotFound)
+	})
+}
+
+func TestScanRulePolicyRepository(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewPgxRepository(pool)
+	user := createTestUser(t, repo)
+	org := createTestOrg(t, repo, user.ID)
+	team := createTestTeam(t, repo, user.ID, org.ID)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: user.ID})
+
+	enabled := true
+	teamRule := &common.ScanRule{OrganizationID: org.ID, TeamID: &team.ID, SASTScanEnabled: &enabled}
+	require.NoError(t, repo.CreateScanRule(ctx, teamRule))
+
+	doc, err := policy.Parse([]byte(`version: 1
+organizations:
+  - name: test_org
+    rule:
+      sca_scan_enabled: true
+`), policy.YAML)
+	require.NoError(t, err)
+
+	t.Run("Dry Run", func(t *testing.T) {
+		changes, err := repo.ApplyScanRulePolicy(ctx, doc, true)
+		require.NoError(t, err)
+		require.Len(t, changes, 2)
+		assert.Equal(t, policy.Create, changes[0].Action)
+		assert.Equal(t, policy.Delete, changes[1].Action)
+		assert.Equal(t, teamRule.ID, changes[1].Rule.ID)
+
+		_, err = repo.GetScanRuleByID(ctx, teamRule.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Apply", func(t *testing.T) {
+		changes, err := repo.ApplyScanRulePolicy(ctx, doc, false)
+		require.NoError(t, err)
+		require.Len(t, changes, 2)
+		created := changes[0].Rule
+		assert.NotZero(t, created.ID)
+
+		_, err = repo.GetScanRuleByID(ctx, teamRule.ID)
+		assert.ErrorIs(t, err, common.ErrNotFound)
+
+		// Изменения записаны в историю от имени пользователя
+		revisions, _, err := repo.ListScanRuleRevisions(ctx, created.ID, common.Page{})
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+		assert.Equal(t, &user.ID, revisions[0].ActorID)
+
+		// Повторное применение ничего не меняет, а экспорт возвращает тот же документ
+		changes, err = repo.ApplyScanRulePolicy(ctx, doc, false)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+
+		state, err := repo.GetScanRulePolicyState(ctx, []int{org.ID})
+		require.NoError(t, err)
+		exported, err := policy.Export(state)
+		require.NoError(t, err)
+		assert.Equal(t, doc, exported)
+	})
+
+	t.Run("Unknown Name", func(t *testing.T) {
+		unknown, err := policy.Parse([]byte("version: 1\norganizations:\n  - name: test_org\n    teams:\n      - name: missing\n"), policy.YAML)
+		require.NoError(t, err)
+		_, err = repo.ApplyScanRulePolicy(ctx, unknown, false)
+		var docErr *policy.Error
+		assert.ErrorAs(t, err, &docErr)
+	})
+}
+
+func TestSessionRepository(t *testing.T) {
+	pool, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewPgxRepository(pool)
+	ctx := context.Background()
+
+	user := createTestUser(t, repo)
+	agent := "grpc-go/1.74.2"
+
+	t.Run("Create and Rotate Session", func(t *testing.T) {
+		session := &common.Session{UserID: user.ID, RefreshTokenHash: "hash-1", UserAgent: &agent}
+		err := repo.CreateSession(ctx, session, time.Hour)
+		require.NoError(t, err)
+		assert.NotZero(t, session.ID)
+		assert.True(t, session.ExpiresAt.After(time.Now()))
+
+		fetched, err := repo.GetSessionByTokenHash(ctx, "hash-1")
+		require.NoError(t, err)
+		require.NotNil(t, fetched)
+		assert.Equal(t, session.ID, fetched.ID)
+		assert.Equal(t, agent, *fetched.UserAgent)
+
+		rotated, err := repo.RotateSessionToken(ctx, "hash-1", "hash-2", time.Hour)
+		require.NoError(t, err)
+		require.NotNil(t, rotated)
+		assert.Equal(t, session.ID, rotated.ID)
+
+		// Старый токен больше не принимается
+		reused, err := repo.RotateSessionToken(ctx, "hash-1", "hash-3", time.Hour)
+		require.NoError(t, err)
+		assert.Nil(t, reused)
+	})
+
+	t.Run("Revoke Session", func(t *testing.T) {
+		session := &common.Session{UserID: user.ID, RefreshTokenHash: "revoke-1"}
+		require.NoError(t, repo.CreateSession(ctx, session, time.Hour))
+
+		err := repo.RevokeSession(ctx, session.ID)
+		require.NoError(t, err)
+
+		fetched, err := repo.GetSessionByID(ctx, session.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, fetched.RevokedAt)
+
+		rotated, err := repo.RotateSessionToken(ctx, "revoke-1", "revoke-2", time.Hour)
+		require.NoError(t, err)
+		assert.Nil(t, rotated)
+	})
+
+	t.Run("List Sessions", func(t *testing.T) {
+		active, err := repo.ListSessions(ctx, user.ID, false)
+		require.NoError(t, err)
+		assert.Len(t, active, 1)
+
+		all, err := repo.ListSessions(ctx, user.ID, true)
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+	})
 }
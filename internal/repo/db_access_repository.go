@@ -0,0 +1,259 @@
+package repo
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"sort"
+	"strings"
+)
+
+// resourceScopeSources поднимаются от ресурса к команде и организации, в которые он входит.
+// %[1]s — выражение с id ресурса.
+var resourceScopeSources = map[common.ResourceType]string{
+	common.ResourceOrganization: `SELECT o.id, o.owner_id, NULL::int, NULL::int, NULL::int
+		FROM organizations o
+		WHERE o.id = %[1]s`,
+	common.ResourceTeam: `SELECT o.id, o.owner_id, t.id, t.owner_id, NULL::int
+		FROM teams t
+		JOIN organizations o ON o.id = t.organization_id
+		WHERE t.id = %[1]s`,
+	common.ResourceApplication: `SELECT o.id, o.owner_id, t.id, t.owner_id, a.id
+		FROM applications a
+		JOIN teams t ON t.id = a.team_id
+		JOIN organizations o ON o.id = t.organization_id
+		WHERE a.id = %[1]s`,
+	common.ResourceVersion: `SELECT o.id, o.owner_id, t.id, t.owner_id, a.id
+		FROM versions v
+		JOIN applications a ON a.id = v.application_id
+		JOIN teams t ON t.id = a.team_id
+		JOIN organizations o ON o.id = t.organization_id
+		WHERE v.id = %[1]s`,
+	common.ResourceScan: `SELECT o.id, o.owner_id, t.id, t.owner_id, a.id
+		FROM scans s
+		JOIN versions v ON v.id = s.version_id
+		JOIN applications a ON a.id = v.application_id
+		JOIN teams t ON t.id = a.team_id
+		JOIN organizations o ON o.id = t.organization_id
+		WHERE s.id = %[1]s`,
+	common.ResourceScanInfo: `SELECT o.id, o.owner_id, t.id, t.owner_id, a.id
+		FROM scan_info si
+		JOIN scans s ON s.id = si.scan_id
+		JOIN versions v ON v.id = s.version_id
+		JOIN applications a ON a.id = v.application_id
+		JOIN teams t ON t.id = a.team_id
+		JOIN organizations o ON o.id = t.organization_id
+		WHERE si.id = %[1]s`,
+	common.ResourceScanRule: `SELECT o.id, o.owner_id, t.id, t.owner_id, sr.application_id
+		FROM scan_rules sr
+		LEFT JOIN teams t ON t.id = sr.team_id
+		JOIN organizations o ON o.id = sr.organization_id
+		WHERE sr.id = %[1]s`,
+}
+
+// userGrantsQuery выбирает все гранты пользователя $1: флаг администратора, владение и разрешения активных ролей
+const userGrantsQuery = `
+	SELECT 'administrator' AS source, true AS read, true AS write, NULL::int AS role_id, NULL::varchar AS role_name,
+		NULL::int AS permission_id, NULL::varchar AS permission_name, NULL::int AS organization_id, NULL::int AS team_id,
+		NULL::varchar AS member_role
+	FROM users u
+	WHERE u.id = $1 AND u.is_admin
+	UNION ALL
+	SELECT 'organization_owner', true, true, NULL, NULL, NULL, NULL, o.id, NULL, NULL
+	FROM organizations o
+	WHERE o.owner_id = $1
+	UNION ALL
+	SELECT 'team_owner', true, true, NULL, NULL, NULL, NULL, NULL, t.id, NULL
+	FROM teams t
+	WHERE t.owner_id = $1
+	UNION ALL
+	SELECT 'organization_role', COALESCE(p.read, false), COALESCE(p.write, false), r.id, r.name, p.id, p.name, rpo.organisation_id, NULL, NULL
+	FROM users_roles ur
+	JOIN roles r ON r.id = ur.role_id
+	JOIN roles_permission_organisation rpo ON rpo.role_id = r.id
+	JOIN permissions p ON p.id = rpo.permission_id
+	WHERE ur.user_id = $1 AND r.is_active IS NOT FALSE AND (p.read OR p.write)
+	UNION ALL
+	SELECT 'team_role', COALESCE(p.read, false), COALESCE(p.write, false), r.id, r.name, p.id, p.name, NULL, rpt.team_id, NULL
+	FROM users_roles ur
+	JOIN roles r ON r.id = ur.role_id
+	JOIN roles_permission_team rpt ON rpt.role_id = r.id
+	JOIN permissions p ON p.id = rpt.permission_id
+	WHERE ur.user_id = $1 AND r.is_active IS NOT FALSE AND (p.read OR p.write)
+	UNION ALL
+	SELECT 'team_member', true, tm.role <> 'viewer', NULL, NULL, NULL, NULL, NULL, tm.team_id, tm.role
+	FROM team_members tm
+	WHERE tm.user_id = $1`
+
+// grantCoversResource — условие наследования: гранты организации покрывают всё внутри неё,
+// гранты команды — команду и её приложения, но не саму организацию
+const grantCoversResource = `(g.source = 'administrator'
+	OR g.organization_id = res.organization_id
+	OR (g.team_id = res.team_id AND res.resource_type <> 'organization'))`
+
+// grantWrite — разработчик команды пишет только в её приложения и ниже, саму команду он лишь читает
+const grantWrite = `CASE WHEN g.member_role = 'developer'
+	THEN res.resource_type NOT IN ('organization', 'team')
+	ELSE g.write END`
+
+// grantSourceOrder задаёт порядок грантов в ответе: от самых широких к самым узким
+var grantSourceOrder = map[common.GrantSource]int{
+	common.GrantSourceAdministrator:     0,
+	common.GrantSourceOrganizationOwner: 1,
+	common.GrantSourceOrganizationRole:  2,
+	common.GrantSourceTeamOwner:         3,
+	common.GrantSourceTeamRole:          4,
+	common.GrantSourceTeamMember:        5,
+}
+
+// ResolveResourceScope returns the organization and team a resource belongs to, or nil if it does not exist.
+func (r *PgxRepository) ResolveResourceScope(ctx context.Context, ref common.ResourceRef) (*common.ResourceScope, error) {
+	source, ok := resourceScopeSources[ref.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type %q", ref.Type)
+	}
+
+	var scope common.ResourceScope
+	err := r.pool.QueryRow(ctx, fmt.Sprintf(source, "$1"), ref.ID).Scan(
+		&scope.OrganizationID,
+		&scope.OrganizationOwnerID,
+		&scope.TeamID,
+		&scope.TeamOwnerID,
+		&scope.ApplicationID,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to resolve %s scope: %w", ref.Type, err)
+	}
+
+	return &scope, nil
+}
+
+// GetEffectivePermissions resolves the rights of a user on a resource, or returns nil if the resource does not exist.
+func (r *PgxRepository) GetEffectivePermissions(ctx context.Context, userID common.UserID, ref common.ResourceRef) (*common.EffectivePermissions, error) {
+	scope, err := r.ResolveResourceScope(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if scope == nil {
+		return nil, nil
+	}
+
+	query := `WITH g AS (` + userGrantsQuery + `),
+		res AS (SELECT $2::varchar AS resource_type, $3::int AS organization_id, $4::int AS team_id)
+		SELECT g.source, g.read, ` + grantWrite + `, g.role_id, g.role_name, g.permission_id, g.permission_name,
+			g.organization_id, g.team_id, g.member_role
+		FROM g, res
+		WHERE ` + grantCoversResource
+
+	rows, err := r.pool.Query(ctx, query, userID, string(ref.Type), scope.OrganizationID, scope.TeamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query effective permissions: %w", err)
+	}
+	grants, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (common.PermissionGrant, error) {
+		var g common.PermissionGrant
+		err := row.Scan(&g.Source, &g.Read, &g.Write, &g.RoleID, &g.RoleName, &g.PermissionID, &g.PermissionName, &g.OrganizationID, &g.TeamID, &g.MemberRole)
+		return g, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan permission grant: %w", err)
+	}
+
+	sort.SliceStable(grants, func(i, j int) bool {
+		if grantSourceOrder[grants[i].Source] != grantSourceOrder[grants[j].Source] {
+			return grantSourceOrder[grants[i].Source] < grantSourceOrder[grants[j].Source]
+		}
+		return derefInt(grants[i].PermissionID) < derefInt(grants[j].PermissionID)
+	})
+
+	eff := &common.EffectivePermissions{
+		UserID:   userID,
+		Resource: ref,
+		Scope:    *scope,
+		Grants:   grants,
+	}
+	for _, g := range grants {
+		eff.Read = eff.Read || g.Read || g.Write
+		eff.Write = eff.Write || g.Write
+	}
+
+	return eff, nil
+}
+
+// CheckPermissions resolves the rights of a user on several resources in a single query.
+// Results follow the order of refs; missing resources get no rights.
+func (r *PgxRepository) CheckPermissions(ctx context.Context, userID common.UserID, refs []common.ResourceRef) ([]common.PermissionReadWrite, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	types := make([]string, len(refs))
+	ids := make([]int, len(refs))
+	for i, ref := range refs {
+		if _, ok := resourceScopeSources[ref.Type]; !ok {
+			return nil, fmt.Errorf("unknown resource type %q", ref.Type)
+		}
+		types[i] = string(ref.Type)
+		ids[i] = ref.ID
+	}
+
+	// Для каждого запрошенного ресурса поднимаемся до организации и команды одним LATERAL-подзапросом
+	resourceTypes := make([]string, 0, len(resourceScopeSources))
+	for t := range resourceScopeSources {
+		resourceTypes = append(resourceTypes, string(t))
+	}
+	sort.Strings(resourceTypes)
+	scopes := make([]string, 0, len(resourceTypes))
+	for _, t := range resourceTypes {
+		scopes = append(scopes, fmt.Sprintf(resourceScopeSources[common.ResourceType(t)], "req.resource_id")+
+			fmt.Sprintf(" AND req.resource_type = '%s'", t))
+	}
+
+	query := `WITH g AS (` + userGrantsQuery + `),
+		req AS (
+			SELECT * FROM unnest($2::varchar[], $3::int[]) WITH ORDINALITY AS req(resource_type, resource_id, idx)
+		),
+		res AS (
+			SELECT req.idx, req.resource_type, sc.organization_id, sc.team_id
+			FROM req
+			LEFT JOIN LATERAL (` + strings.Join(scopes, "\n\t\t\t\tUNION ALL\n") + `
+			) AS sc(organization_id, organization_owner_id, team_id, team_owner_id, application_id) ON true
+		)
+		SELECT res.idx, COALESCE(bool_or(g.read OR g.write), false), COALESCE(bool_or(` + grantWrite + `), false)
+		FROM res
+		LEFT JOIN g ON res.organization_id IS NOT NULL AND ` + grantCoversResource + `
+		GROUP BY res.idx
+		ORDER BY res.idx`
+
+	rows, err := r.pool.Query(ctx, query, userID, types, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check permissions: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]common.PermissionReadWrite, len(refs))
+	for rows.Next() {
+		var idx int
+		var rights common.PermissionReadWrite
+		if err := rows.Scan(&idx, &rights.Read, &rights.Write); err != nil {
+			return nil, fmt.Errorf("failed to scan permission check: %w", err)
+		}
+		result[idx-1] = rights
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return result, nil
+}
+
+func derefInt(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"data_processor/internal/common"
 	"errors"
+	"fmt"
 	"github.com/jackc/pgx/v5"
 )
 
@@ -11,17 +12,17 @@ var _ IApplicationRepository = (*PgxRepository)(nil)
 
 func (r *PgxRepository) CreateApplication(ctx context.Context, app *common.Application) error {
 	query := `INSERT INTO applications (name, description, team_id) 
-	          VALUES ($1, $2, $3) RETURNING id`
-	return r.pool.QueryRow(ctx, query, app.Name, app.Description, app.TeamID).Scan(&app.ID)
+	          VALUES ($1, $2, $3) RETURNING id, revision`
+	return dbError("application", r.pool.QueryRow(ctx, query, app.Name, app.Description, app.TeamID).Scan(&app.ID, &app.Revision))
 }
 
 func (r *PgxRepository) GetApplicationByID(ctx context.Context, id int) (*common.Application, error) {
-	query := `SELECT id, name, description, team_id FROM applications WHERE id = $1`
+	query := `SELECT id, name, description, team_id, revision FROM applications WHERE id = $1`
 	app := &common.Application{}
-	err := r.pool.QueryRow(ctx, query, id).Scan(&app.ID, &app.Name, &app.Description, &app.TeamID)
+	err := r.pool.QueryRow(ctx, query, id).Scan(&app.ID, &app.Name, &app.Description, &app.TeamID, &app.Revision)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("application", id)
 		}
 		return nil, err
 	}
@@ -29,55 +30,79 @@ func (r *PgxRepository) GetApplicationByID(ctx context.Context, id int) (*common
 }
 
 func (r *PgxRepository) GetApplicationByName(ctx context.Context, name string) (*common.Application, error) {
-	query := `SELECT id, name, description, team_id FROM applications WHERE name = $1`
+	query := `SELECT id, name, description, team_id, revision FROM applications WHERE name = $1`
 	app := &common.Application{}
-	err := r.pool.QueryRow(ctx, query, name).Scan(&app.ID, &app.Name, &app.Description, &app.TeamID)
+	err := r.pool.QueryRow(ctx, query, name).Scan(&app.ID, &app.Name, &app.Description, &app.TeamID, &app.Revision)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("application", name)
 		}
 		return nil, err
 	}
 	return app, nil
 }
 
-func (r *PgxRepository) UpdateApplication(ctx context.Context, app *common.Application) error {
-	query := `UPDATE applications SET 
-		name = $1, 
-		description = $2, 
-		team_id = $3 
-		WHERE id = $4`
-	_, err := r.pool.Exec(ctx, query, app.Name, app.Description, app.TeamID, app.ID)
-	return err
+var applicationColumns = updateColumns[*common.Application]{
+	"name":        func(a *common.Application) any { return a.Name },
+	"description": func(a *common.Application) any { return a.Description },
+	"team_id":     func(a *common.Application) any { return a.TeamID },
 }
 
-func (r *PgxRepository) DeleteApplication(ctx context.Context, id int) error {
-	query := `DELETE FROM applications WHERE id = $1`
-	_, err := r.pool.Exec(ctx, query, id)
-	return err
+// UpdateApplication changes only the listed fields and returns the updated application,
+// or a common.ErrNotFound error if it does not exist.
+func (r *PgxRepository) UpdateApplication(ctx context.Context, app *common.Application, fields []string) (*common.Application, error) {
+	query, args, err := applicationColumns.updateRevision("applications", "id, name, description, team_id, revision", app, app.ID, app.Revision, fields)
+	if err != nil {
+		return nil, err
+	}
+	updated := &common.Application{}
+	err = r.pool.QueryRow(ctx, query, args...).Scan(&updated.ID, &updated.Name, &updated.Description, &updated.TeamID, &updated.Revision)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.notUpdated(ctx, "applications", app.ID, app.Revision)
+		}
+		return nil, dbError("application", fmt.Errorf("failed to update application: %w", err))
+	}
+	return updated, nil
+}
+
+func (r *PgxRepository) DeleteApplication(ctx context.Context, id, revision int) error {
+	return r.deleteRevision(ctx, "applications", id, revision)
 }
 
-func (r *PgxRepository) ListApplications(ctx context.Context) ([]*common.Application, error) {
-	query := `SELECT id, name, description, team_id FROM applications`
-	rows, err := r.pool.Query(ctx, query)
+// ListApplications returns a page of applications ordered by id and the total number of applications.
+func (r *PgxRepository) ListApplications(ctx context.Context, page common.Page) ([]*common.Application, int, error) {
+	q, err := newListQuery(applicationFields, page, "id")
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	total, err := r.count(ctx, `SELECT COUNT(*) FROM applications`+q.whereSQL(), q.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	q.after(page)
+	query := `SELECT id, name, description, team_id, revision FROM applications` + q.whereSQL() + q.tail(page)
+	rows, err := r.pool.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, 0, err
 	}
 	defer rows.Close()
 
 	apps, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.Application, error) {
 		var app common.Application
-		err := row.Scan(&app.ID, &app.Name, &app.Description, &app.TeamID)
+		err := row.Scan(&app.ID, &app.Name, &app.Description, &app.TeamID, &app.Revision)
 		return &app, err
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return apps, nil
+	return apps, total, nil
 }
 
 func (r *PgxRepository) ListApplicationsByTeam(ctx context.Context, teamID int) ([]*common.Application, error) {
-	query := `SELECT id, name, description, team_id FROM applications WHERE team_id = $1`
+	query := `SELECT id, name, description, team_id, revision FROM applications WHERE team_id = $1`
 	rows, err := r.pool.Query(ctx, query, teamID)
 	if err != nil {
 		return nil, err
@@ -86,7 +111,7 @@ func (r *PgxRepository) ListApplicationsByTeam(ctx context.Context, teamID int)
 
 	apps, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.Application, error) {
 		var app common.Application
-		err := row.Scan(&app.ID, &app.Name, &app.Description, &app.TeamID)
+		err := row.Scan(&app.ID, &app.Name, &app.Description, &app.TeamID, &app.Revision)
 		return &app, err
 	})
 	if err != nil {
@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var _ IComponentRepository = (*PgxRepository)(nil)
+
+// Зависимости компонента собираются подзапросом в порядке ссылок
+const componentColumnsSQL = `id, scan_id, ref, is_primary, name, version, purl, licenses, hashes,
+	ARRAY(SELECT d.depends_on FROM component_dependencies d
+		WHERE d.scan_id = components.scan_id AND d.ref = components.ref ORDER BY d.depends_on)`
+
+var componentCopyColumns = []string{"scan_id", "ref", "is_primary", "name", "version", "purl", "licenses", "hashes"}
+
+func scanComponent(row pgx.Row) (*common.Component, error) {
+	c := &common.Component{}
+	err := row.Scan(
+		&c.ID,
+		&c.ScanID,
+		&c.Ref,
+		&c.Primary,
+		&c.Name,
+		&c.Version,
+		&c.PURL,
+		&c.Licenses,
+		&c.Hashes,
+		&c.DependsOn,
+	)
+	return c, err
+}
+
+// ReplaceComponents replaces the components of the scan and the dependencies between them.
+// Only SCA scans have components, as only they are looked up by GetLatestScan. Uploads for the
+// same scan are serialized by lockScanForUpload.
+func (r *PgxRepository) ReplaceComponents(ctx context.Context, scanID int, components []*common.Component) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		scanType, err := lockScanForUpload(ctx, tx, "components", scanID)
+		if err != nil {
+			return err
+		}
+		if scanType != common.ScanTypeSCA {
+			return common.ConflictError("scan", fmt.Sprintf("scan %d is not an SCA scan and has no components", scanID))
+		}
+
+		// Зависимости удаляются каскадом
+		if _, err := tx.Exec(ctx, `DELETE FROM components WHERE scan_id = $1`, scanID); err != nil {
+			return fmt.Errorf("failed to delete components: %w", err)
+		}
+		_, err = tx.CopyFrom(ctx, pgx.Identifier{"components"}, componentCopyColumns, pgx.CopyFromSlice(len(components), func(i int) ([]any, error) {
+			c := components[i]
+			licenses, hashes := c.Licenses, c.Hashes
+			if licenses == nil {
+				licenses = []string{}
+			}
+			if hashes == nil {
+				hashes = map[string]string{}
+			}
+			return []any{scanID, c.Ref, c.Primary, c.Name, c.Version, c.PURL, licenses, hashes}, nil
+		}))
+		if err != nil {
+			return dbError("component", fmt.Errorf("failed to copy components: %w", err))
+		}
+
+		var edges [][]any
+		for _, c := range components {
+			for _, ref := range c.DependsOn {
+				edges = append(edges, []any{scanID, c.Ref, ref})
+			}
+		}
+		_, err = tx.CopyFrom(ctx, pgx.Identifier{"component_dependencies"}, []string{"scan_id", "ref", "depends_on"}, pgx.CopyFromRows(edges))
+		if err != nil {
+			return dbError("component", fmt.Errorf("failed to copy component dependencies: %w", err))
+		}
+		return nil
+	})
+}
+
+// ListComponents returns a page of the components of the scan and their total number.
+func (r *PgxRepository) ListComponents(ctx context.Context, scanID int, page common.Page) ([]*common.Component, int, error) {
+	q, err := newListQuery(componentFields, page, "id")
+	if err != nil {
+		return nil, 0, err
+	}
+	q.where("scan_id = " + q.arg(scanID))
+
+	total, err := r.count(ctx, `SELECT COUNT(*) FROM components`+q.whereSQL(), q.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	q.after(page)
+	rows, err := r.pool.Query(ctx, `SELECT `+componentColumnsSQL+` FROM components`+q.whereSQL()+q.tail(page), q.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	components, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.Component, error) {
+		return scanComponent(row)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return components, total, nil
+}
@@ -0,0 +1,264 @@
+package repo
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var _ IFindingRepository = (*PgxRepository)(nil)
+
+const findingColumnsSQL = `id, scan_id, tool, tool_version, rule_id, severity, cwe, file, start_line, end_line, message,
+	fingerprint, partial_fingerprints, suppression_kind, suppression_status, suppression_justification`
+
+// findingCopyColumns — столбцы COPY в порядке значений findingCopyRow
+var findingCopyColumns = []string{"scan_id", "tool", "tool_version", "rule_id", "severity", "cwe", "file", "start_line", "end_line",
+	"message", "fingerprint", "partial_fingerprints", "suppression_kind", "suppression_status", "suppression_justification"}
+
+func findingCopyRow(scanID int, f *common.Finding) []any {
+	partial := f.PartialFingerprints
+	if partial == nil {
+		partial = map[string]string{}
+	}
+	var kind, status *string
+	justification := ""
+	if sup := f.Suppression; sup != nil {
+		kind, status = (*string)(&sup.Kind), (*string)(&sup.Status)
+		justification = sup.Justification
+	}
+	return []any{scanID, f.Tool, f.ToolVersion, f.RuleID, string(f.Severity), f.CWE, f.File, f.StartLine, f.EndLine,
+		f.Message, f.Fingerprint, partial, kind, status, justification}
+}
+
+const findingRuleColumnsSQL = `scan_id, tool, rule_id, name, short_description, full_description, help, help_uri, tags`
+
+var findingRuleCopyColumns = []string{"scan_id", "tool", "rule_id", "name", "short_description", "full_description", "help", "help_uri", "tags"}
+
+// scanFinding читает столбцы findingColumnsSQL и следующие за ними столбцы в extra
+func scanFinding(row pgx.Row, extra ...any) (*common.Finding, error) {
+	f := &common.Finding{}
+	var kind, status *string
+	var justification string
+	dest := []any{
+		&f.ID,
+		&f.ScanID,
+		&f.Tool,
+		&f.ToolVersion,
+		&f.RuleID,
+		&f.Severity,
+		&f.CWE,
+		&f.File,
+		&f.StartLine,
+		&f.EndLine,
+		&f.Message,
+		&f.Fingerprint,
+		&f.PartialFingerprints,
+		&kind,
+		&status,
+		&justification,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	if kind != nil && status != nil {
+		f.Suppression = &common.Suppression{
+			Kind:          common.SuppressionKind(*kind),
+			Status:        common.SuppressionStatus(*status),
+			Justification: justification,
+		}
+	}
+	return f, nil
+}
+
+// ReplaceFindings replaces the findings of the scan with the ones returned by next, and its
+// finding rules with rules, and returns the number of findings. next returns io.EOF after the last finding; any other error aborts the upload,
+// leaves the previous findings in place and is returned as is. Findings are written with COPY,
+// so next is called while the rows are being sent, from another goroutine.
+//
+// Only SAST scans and scans without a type have findings. Uploads for the same scan are
+// serialized by lockScanForUpload.
+func (r *PgxRepository) ReplaceFindings(ctx context.Context, scanID int, rules []*common.FindingRule, next func() (*common.Finding, error)) (int, error) {
+	var count int
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		scanType, err := lockScanForUpload(ctx, tx, "findings", scanID)
+		if err != nil {
+			return err
+		}
+		if scanType != common.ScanTypeSAST && scanType != "" {
+			return common.ConflictError("scan", fmt.Sprintf("scan %d is not a SAST scan and has no findings", scanID))
+		}
+
+		if _, err := tx.Exec(ctx, `DELETE FROM findings WHERE scan_id = $1`, scanID); err != nil {
+			return fmt.Errorf("failed to delete findings: %w", err)
+		}
+		if _, err := tx.Exec(ctx, `DELETE FROM finding_rules WHERE scan_id = $1`, scanID); err != nil {
+			return fmt.Errorf("failed to delete finding rules: %w", err)
+		}
+		_, err = tx.CopyFrom(ctx, pgx.Identifier{"finding_rules"}, findingRuleCopyColumns, pgx.CopyFromSlice(len(rules), func(i int) ([]any, error) {
+			rule := rules[i]
+			tags := rule.Tags
+			if tags == nil {
+				tags = []string{}
+			}
+			return []any{scanID, rule.Tool, rule.RuleID, rule.Name, rule.ShortDescription, rule.FullDescription,
+				rule.Help, rule.HelpURI, tags}, nil
+		}))
+		if err != nil {
+			return dbError("finding_rule", fmt.Errorf("failed to copy finding rules: %w", err))
+		}
+
+		// pgx может обернуть ошибку источника, поэтому она запоминается отдельно
+		var sourceErr error
+		n, err := tx.CopyFrom(ctx, pgx.Identifier{"findings"}, findingCopyColumns, pgx.CopyFromFunc(func() ([]any, error) {
+			f, err := next()
+			if errors.Is(err, io.EOF) {
+				return nil, nil
+			}
+			if err != nil {
+				sourceErr = err
+				return nil, err
+			}
+			return findingCopyRow(scanID, f), nil
+		}))
+		if sourceErr != nil {
+			return sourceErr
+		}
+		if err != nil {
+			return dbError("finding", fmt.Errorf("failed to copy findings: %w", err))
+		}
+		count = int(n)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// ListFindings returns a page of the findings of the scan that are at least as severe as
+// minSeverity, or of all findings if it is empty, and their total number.
+func (r *PgxRepository) ListFindings(ctx context.Context, scanID int, minSeverity common.Severity, page common.Page) ([]*common.Finding, int, error) {
+	q, err := newListQuery(findingFields, page, "id")
+	if err != nil {
+		return nil, 0, err
+	}
+	q.where("scan_id = " + q.arg(scanID))
+	if minSeverity != "" {
+		severities := make([]string, 0, len(common.Severities))
+		for _, severity := range common.SeveritiesAtLeast(minSeverity) {
+			severities = append(severities, string(severity))
+		}
+		q.where("severity = ANY(" + q.arg(severities) + ")")
+	}
+
+	total, err := r.count(ctx, `SELECT COUNT(*) FROM findings`+q.whereSQL(), q.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	q.after(page)
+	rows, err := r.pool.Query(ctx, `SELECT `+findingColumnsSQL+` FROM findings`+q.whereSQL()+q.tail(page), q.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	findings, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.Finding, error) {
+		return scanFinding(row)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return findings, total, nil
+}
+
+// ListFindingRules returns the finding rules of the scan ordered by tool and rule id.
+func (r *PgxRepository) ListFindingRules(ctx context.Context, scanID int) ([]*common.FindingRule, error) {
+	rows, err := r.pool.Query(ctx, `SELECT `+findingRuleColumnsSQL+` FROM finding_rules
+		WHERE scan_id = $1 ORDER BY tool, rule_id`, scanID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list finding rules: %w", err)
+	}
+	defer rows.Close()
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.FindingRule, error) {
+		rule := &common.FindingRule{}
+		err := row.Scan(&rule.ScanID, &rule.Tool, &rule.RuleID, &rule.Name, &rule.ShortDescription,
+			&rule.FullDescription, &rule.Help, &rule.HelpURI, &rule.Tags)
+		return rule, err
+	})
+}
+
+// WalkFindingsWithBaseline calls fn for each finding of the scan and its state relative to the
+// baseline: the latest succeeded scan of the same type created before it in any version of the
+// application, so the first scan of a new version is compared with the previous one. Scans are
+// ordered by id rather than by the scan_date set by the client. Findings
+// match when their tools and fingerprints are equal. The findings of the baseline that the scan
+// no longer reports follow the findings of the scan, as absent. Without a baseline the state is
+// empty.
+//
+// Findings are ordered by tool, so the findings of a tool come together. An error of fn stops
+// the walk and is returned as is.
+func (r *PgxRepository) WalkFindingsWithBaseline(ctx context.Context, scanID int, fn func(*common.Finding, common.BaselineState) error) error {
+	rows, err := r.pool.Query(ctx, `WITH baseline AS (
+			SELECT b.id FROM scans s
+			JOIN versions sv ON sv.id = s.version_id
+			JOIN versions bv ON bv.application_id = sv.application_id
+			JOIN scans b ON b.version_id = bv.id AND b.scan_type IS NOT DISTINCT FROM s.scan_type
+				AND b.status = 'succeeded' AND b.id < s.id
+			WHERE s.id = $1
+			ORDER BY b.id DESC
+			LIMIT 1
+		), compared AS (
+			SELECT `+findingColumnsSQL+`,
+				CASE
+					WHEN NOT EXISTS (SELECT FROM baseline) THEN ''
+					WHEN EXISTS (SELECT FROM findings p WHERE p.scan_id = (SELECT id FROM baseline)
+						AND p.tool = f.tool AND p.fingerprint = f.fingerprint) THEN 'unchanged'
+					ELSE 'new'
+				END AS baseline_state
+			FROM findings f WHERE f.scan_id = $1
+			UNION ALL
+			SELECT `+findingColumnsSQL+`, 'absent'
+			FROM findings p WHERE p.scan_id = (SELECT id FROM baseline)
+				AND NOT EXISTS (SELECT FROM findings f WHERE f.scan_id = $1 AND f.tool = p.tool AND f.fingerprint = p.fingerprint)
+		)
+		SELECT * FROM compared ORDER BY tool, baseline_state = 'absent', id`, scanID)
+	if err != nil {
+		return fmt.Errorf("failed to compare findings: %w", err)
+	}
+	defer rows.Close()
+
+	walked := false
+	for rows.Next() {
+		var state common.BaselineState
+		f, err := scanFinding(rows, &state)
+		if err != nil {
+			return fmt.Errorf("failed to scan finding: %w", err)
+		}
+		walked = true
+		if err := fn(f, state); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to compare findings: %w", err)
+	}
+	if walked {
+		return nil
+	}
+
+	// Пустой результат — либо скан без находок, либо скана нет
+	var exists bool
+	if err := r.pool.QueryRow(ctx, `SELECT EXISTS (SELECT FROM scans WHERE id = $1)`, scanID).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to get scan: %w", err)
+	}
+	if !exists {
+		return common.NotFoundError("scan", scanID)
+	}
+	return nil
+}
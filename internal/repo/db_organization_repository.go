@@ -4,23 +4,24 @@ import (
 	"context"
 	"data_processor/internal/common"
 	"errors"
+	"fmt"
 	"github.com/jackc/pgx/v5"
 )
 
 var _ IOrganizationRepository = (*PgxRepository)(nil)
 
 func (r *PgxRepository) CreateOrganization(ctx context.Context, org *common.Organization) error {
-	query := `INSERT INTO organizations (project_name, owner_id) VALUES ($1, $2) RETURNING id`
-	return r.pool.QueryRow(ctx, query, org.ProjectName, org.OwnerID).Scan(&org.ID)
+	query := `INSERT INTO organizations (project_name, owner_id) VALUES ($1, $2) RETURNING id, revision`
+	return dbError("organization", r.pool.QueryRow(ctx, query, org.ProjectName, org.OwnerID).Scan(&org.ID, &org.Revision))
 }
 
 func (r *PgxRepository) GetOrganizationByID(ctx context.Context, id int) (*common.Organization, error) {
-	query := `SELECT id, project_name, owner_id FROM organizations WHERE id = $1`
+	query := `SELECT id, project_name, owner_id, revision FROM organizations WHERE id = $1`
 	org := &common.Organization{}
-	err := r.pool.QueryRow(ctx, query, id).Scan(&org.ID, &org.ProjectName, &org.OwnerID)
+	err := r.pool.QueryRow(ctx, query, id).Scan(&org.ID, &org.ProjectName, &org.OwnerID, &org.Revision)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("organization", id)
 		}
 		return nil, err
 	}
@@ -28,51 +29,78 @@ func (r *PgxRepository) GetOrganizationByID(ctx context.Context, id int) (*commo
 }
 
 func (r *PgxRepository) GetOrganizationByName(ctx context.Context, name string) (*common.Organization, error) {
-	query := `SELECT id, project_name, owner_id FROM organizations WHERE project_name = $1`
+	query := `SELECT id, project_name, owner_id, revision FROM organizations WHERE project_name = $1`
 	org := &common.Organization{}
-	err := r.pool.QueryRow(ctx, query, name).Scan(&org.ID, &org.ProjectName, &org.OwnerID)
+	err := r.pool.QueryRow(ctx, query, name).Scan(&org.ID, &org.ProjectName, &org.OwnerID, &org.Revision)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("organization", name)
 		}
 		return nil, err
 	}
 	return org, nil
 }
 
-func (r *PgxRepository) UpdateOrganization(ctx context.Context, org *common.Organization) error {
-	query := `UPDATE organizations SET project_name = $1, owner_id = $2 WHERE id = $3`
-	_, err := r.pool.Exec(ctx, query, org.ProjectName, org.OwnerID, org.ID)
-	return err
+var organizationColumns = updateColumns[*common.Organization]{
+	"project_name": func(o *common.Organization) any { return o.ProjectName },
+	"owner_id":     func(o *common.Organization) any { return o.OwnerID },
 }
 
-func (r *PgxRepository) DeleteOrganization(ctx context.Context, id int) error {
-	query := `DELETE FROM organizations WHERE id = $1`
-	_, err := r.pool.Exec(ctx, query, id)
-	return err
+// UpdateOrganization changes only the listed fields and returns the updated organization,
+// or a common.ErrNotFound error if it does not exist.
+func (r *PgxRepository) UpdateOrganization(ctx context.Context, org *common.Organization, fields []string) (*common.Organization, error) {
+	query, args, err := organizationColumns.updateRevision("organizations", "id, project_name, owner_id, revision", org, org.ID, org.Revision, fields)
+	if err != nil {
+		return nil, err
+	}
+	updated := &common.Organization{}
+	err = r.pool.QueryRow(ctx, query, args...).Scan(&updated.ID, &updated.ProjectName, &updated.OwnerID, &updated.Revision)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.notUpdated(ctx, "organizations", org.ID, org.Revision)
+		}
+		return nil, dbError("organization", fmt.Errorf("failed to update organization: %w", err))
+	}
+	return updated, nil
+}
+
+func (r *PgxRepository) DeleteOrganization(ctx context.Context, id, revision int) error {
+	return r.deleteRevision(ctx, "organizations", id, revision)
 }
 
-func (r *PgxRepository) ListOrganizations(ctx context.Context) ([]*common.Organization, error) {
-	query := `SELECT id, project_name, owner_id FROM organizations`
-	rows, err := r.pool.Query(ctx, query)
+// ListOrganizations returns a page of organizations ordered by id and the total number of organizations.
+func (r *PgxRepository) ListOrganizations(ctx context.Context, page common.Page) ([]*common.Organization, int, error) {
+	q, err := newListQuery(organizationFields, page, "id")
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	total, err := r.count(ctx, `SELECT COUNT(*) FROM organizations`+q.whereSQL(), q.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	q.after(page)
+	query := `SELECT id, project_name, owner_id, revision FROM organizations` + q.whereSQL() + q.tail(page)
+	rows, err := r.pool.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, 0, err
 	}
 	defer rows.Close()
 
 	orgs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.Organization, error) {
 		var org common.Organization
-		err := row.Scan(&org.ID, &org.ProjectName, &org.OwnerID)
+		err := row.Scan(&org.ID, &org.ProjectName, &org.OwnerID, &org.Revision)
 		return &org, err
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return orgs, nil
+	return orgs, total, nil
 }
 
 func (r *PgxRepository) ListOrganizationsByOwner(ctx context.Context, ownerID common.UserID) ([]*common.Organization, error) {
-	query := `SELECT id, project_name, owner_id FROM organizations WHERE owner_id = $1`
+	query := `SELECT id, project_name, owner_id, revision FROM organizations WHERE owner_id = $1`
 	rows, err := r.pool.Query(ctx, query, ownerID)
 	if err != nil {
 		return nil, err
@@ -81,7 +109,7 @@ func (r *PgxRepository) ListOrganizationsByOwner(ctx context.Context, ownerID co
 
 	orgs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.Organization, error) {
 		var org common.Organization
-		err := row.Scan(&org.ID, &org.ProjectName, &org.OwnerID)
+		err := row.Scan(&org.ID, &org.ProjectName, &org.OwnerID, &org.Revision)
 		return &org, err
 	})
 	if err != nil {
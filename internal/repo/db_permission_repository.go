@@ -13,7 +13,7 @@ var _ IPermissionRepository = (*PgxRepository)(nil)
 func (r *PgxRepository) GetPermissionByID(ctx context.Context, id int) (*common.Permission, error) {
 	query := `
         SELECT 
-            p.id, p.name, p.description, p.created_at, p.updated_at, p.read, p.write,
+            p.id, p.name, p.description, p.created_at, p.updated_at, p.read, p.write, p.revision,
             rpo.organisation_id, rpt.team_id
         FROM permissions p
         LEFT JOIN roles_permission_organisation rpo ON p.id = rpo.permission_id
@@ -26,11 +26,11 @@ func (r *PgxRepository) GetPermissionByID(ctx context.Context, id int) (*common.
 
 	err := r.pool.QueryRow(ctx, query, id).Scan(
 		&perm.ID, &perm.Name, &perm.Description, &perm.CreatedAt, &perm.UpdatedAt,
-		&perm.Read, &perm.Write, &orgID, &teamID)
+		&perm.Read, &perm.Write, &perm.Revision, &orgID, &teamID)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("permission", id)
 		}
 		return nil, err
 	}
@@ -61,12 +61,12 @@ func (r *PgxRepository) CreatePermission(ctx context.Context, perm *common.Permi
 	err = tx.QueryRow(ctx, `
         INSERT INTO permissions (name, description, read, write) 
         VALUES ($1, $2, $3, $4) 
-        RETURNING id, created_at, updated_at`,
+        RETURNING id, created_at, updated_at, revision`,
 		perm.Name, perm.Description, perm.Read, perm.Write,
-	).Scan(&perm.ID, &perm.CreatedAt, &perm.UpdatedAt)
+	).Scan(&perm.ID, &perm.CreatedAt, &perm.UpdatedAt, &perm.Revision)
 
 	if err != nil {
-		return fmt.Errorf("failed to create permission: %w", err)
+		return dbError("permission", fmt.Errorf("failed to create permission: %w", err))
 	}
 
 	// 2. Если permission привязан к организации
@@ -82,7 +82,7 @@ func (r *PgxRepository) CreatePermission(ctx context.Context, perm *common.Permi
 			return fmt.Errorf("failed to check organization existence: %w", err)
 		}
 		if !orgExists {
-			return fmt.Errorf("organization with ID %d does not exist", *perm.OrganizationID)
+			return common.InvalidReferenceError("organization_id", "organization", *perm.OrganizationID)
 		}
 
 		// НЕ создаем связь с организацией здесь, так как permission еще не привязан к роли
@@ -99,7 +99,7 @@ func (r *PgxRepository) CreatePermission(ctx context.Context, perm *common.Permi
 			return fmt.Errorf("failed to check team existence: %w", err)
 		}
 		if !teamExists {
-			return fmt.Errorf("team with ID %d does not exist", *perm.TeamID)
+			return common.InvalidReferenceError("team_id", "team", *perm.TeamID)
 		}
 
 		// НЕ создаем связь с командой здесь, так как permission еще не привязан к роли
@@ -109,45 +109,80 @@ func (r *PgxRepository) CreatePermission(ctx context.Context, perm *common.Permi
 	return tx.Commit(ctx)
 }
 func (r *PgxRepository) GetPermissionByName(ctx context.Context, name string) (*common.Permission, error) {
-	query := `SELECT id, name, description, created_at, updated_at, read, write 
+	query := `SELECT id, name, description, created_at, updated_at, read, write, revision 
 	          FROM permissions WHERE name = $1`
 
 	perm := &common.Permission{}
 	err := r.pool.QueryRow(ctx, query, name).
-		Scan(&perm.ID, &perm.Name, &perm.Description, &perm.CreatedAt, &perm.UpdatedAt, &perm.Read, &perm.Write)
+		Scan(&perm.ID, &perm.Name, &perm.Description, &perm.CreatedAt, &perm.UpdatedAt, &perm.Read, &perm.Write, &perm.Revision)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("permission", name)
 		}
 		return nil, err
 	}
 	return perm, nil
 }
 
-func (r *PgxRepository) UpdatePermission(ctx context.Context, permission *common.Permission) error {
-	query := `UPDATE permissions SET 
-		name = $1, 
-		description = $2, 
-		read = $3, 
-		write = $4,
-		updated_at = NOW()
-		WHERE id = $5`
-	_, err := r.pool.Exec(ctx, query, permission.Name, permission.Description,
-		permission.Read, permission.Write, permission.ID)
-	return err
+// permissionColumns — изменяемые поля разрешения. Область (организация или команда) задаётся
+// только при создании.
+var permissionColumns = updateColumns[*common.Permission]{
+	"name":        func(p *common.Permission) any { return p.Name },
+	"description": func(p *common.Permission) any { return p.Description },
+	"read":        func(p *common.Permission) any { return p.Read },
+	"write":       func(p *common.Permission) any { return p.Write },
 }
 
-func (r *PgxRepository) DeletePermission(ctx context.Context, id int) error {
-	query := `DELETE FROM permissions WHERE id = $1`
-	_, err := r.pool.Exec(ctx, query, id)
-	return err
+// UpdatePermission changes only the listed fields and returns the updated permission with its
+// scope, or a common.ErrNotFound error if it does not exist.
+func (r *PgxRepository) UpdatePermission(ctx context.Context, permission *common.Permission, fields []string) (*common.Permission, error) {
+	update, args, err := permissionColumns.updateRevision("permissions",
+		"id, name, description, created_at, updated_at, read, write, revision",
+		permission, permission.ID, permission.Revision, fields, "updated_at = NOW()")
+	if err != nil {
+		return nil, err
+	}
+
+	// Область хранится в таблицах связей: присоединяем её к обновлённой строке тем же запросом
+	query := `
+        WITH p AS (` + update + `)
+        SELECT p.id, p.name, p.description, p.created_at, p.updated_at, p.read, p.write, p.revision,
+            rpo.organisation_id, rpt.team_id
+        FROM p
+        LEFT JOIN roles_permission_organisation rpo ON p.id = rpo.permission_id
+        LEFT JOIN roles_permission_team rpt ON p.id = rpt.permission_id
+    `
+
+	perm := &common.Permission{}
+	var orgID, teamID *int
+	err = r.pool.QueryRow(ctx, query, args...).Scan(
+		&perm.ID, &perm.Name, &perm.Description, &perm.CreatedAt, &perm.UpdatedAt,
+		&perm.Read, &perm.Write, &perm.Revision, &orgID, &teamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.notUpdated(ctx, "permissions", permission.ID, permission.Revision)
+		}
+		return nil, dbError("permission", fmt.Errorf("failed to update permission: %w", err))
+	}
+
+	if orgID != nil {
+		perm.OrganizationID = orgID
+	} else if teamID != nil {
+		perm.TeamID = teamID
+	}
+	return perm, nil
+}
+
+func (r *PgxRepository) DeletePermission(ctx context.Context, id, revision int) error {
+	return r.deleteRevision(ctx, "permissions", id, revision)
 }
 
 func (r *PgxRepository) GetTeamPermissions(ctx context.Context, userID common.UserID, teamID common.TeamID) ([]common.PermissionReadWrite, error) {
 	const query = `SELECT p.read, p.write FROM users_roles ur
+		JOIN roles r ON ur.role_id = r.id
 		JOIN roles_permission_team rpt ON ur.role_id = rpt.role_id
 		JOIN permissions p ON rpt.permission_id = p.id
-		WHERE ur.user_id = $1 AND rpt.team_id = $2`
+		WHERE ur.user_id = $1 AND rpt.team_id = $2 AND r.is_active IS NOT FALSE`
 
 	rows, err := r.pool.Query(ctx, query, userID, teamID)
 	if err != nil {
@@ -169,9 +204,10 @@ func (r *PgxRepository) GetTeamPermissions(ctx context.Context, userID common.Us
 
 func (r *PgxRepository) GetOrganizationPermissions(ctx context.Context, userID common.UserID, orgID common.OrgID) ([]common.PermissionReadWrite, error) {
 	const query = `SELECT p.read, p.write FROM users_roles ur
+		JOIN roles r ON ur.role_id = r.id
 		JOIN roles_permission_organisation rpo ON ur.role_id = rpo.role_id
 		JOIN permissions p ON rpo.permission_id = p.id
-		WHERE ur.user_id = $1 AND rpo.organisation_id = $2`
+		WHERE ur.user_id = $1 AND rpo.organisation_id = $2 AND r.is_active IS NOT FALSE`
 
 	rows, err := r.pool.Query(ctx, query, userID, orgID)
 	if err != nil {
@@ -196,19 +232,33 @@ func (r *PgxRepository) GetOrganizationPermissions(ctx context.Context, userID c
 
 }
 
-func (r *PgxRepository) ListPermissions(ctx context.Context) ([]*common.Permission, error) {
-	query := `
-        SELECT 
-            p.id, p.name, p.description, p.created_at, p.updated_at, p.read, p.write,
-            rpo.organisation_id, rpt.team_id
+// ListPermissions returns a page of permissions ordered by id and the total number of permissions.
+// permission_id уникален в обеих таблицах связей, поэтому JOIN не размножает строки.
+func (r *PgxRepository) ListPermissions(ctx context.Context, page common.Page) ([]*common.Permission, int, error) {
+	q, err := newListQuery(permissionFields, page, "p.id")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	from := `
         FROM permissions p
         LEFT JOIN roles_permission_organisation rpo ON p.id = rpo.permission_id
-        LEFT JOIN roles_permission_team rpt ON p.id = rpt.permission_id
-    `
+        LEFT JOIN roles_permission_team rpt ON p.id = rpt.permission_id`
 
-	rows, err := r.pool.Query(ctx, query)
+	total, err := r.count(ctx, `SELECT COUNT(*)`+from+q.whereSQL(), q.args...)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	q.after(page)
+	query := `
+        SELECT 
+            p.id, p.name, p.description, p.created_at, p.updated_at, p.read, p.write, p.revision,
+            rpo.organisation_id, rpt.team_id` + from + q.whereSQL() + q.tail(page)
+
+	rows, err := r.pool.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, 0, err
 	}
 	defer rows.Close()
 
@@ -218,7 +268,7 @@ func (r *PgxRepository) ListPermissions(ctx context.Context) ([]*common.Permissi
 
 		err := row.Scan(
 			&perm.ID, &perm.Name, &perm.Description, &perm.CreatedAt, &perm.UpdatedAt,
-			&perm.Read, &perm.Write, &orgID, &teamID)
+			&perm.Read, &perm.Write, &perm.Revision, &orgID, &teamID)
 
 		if err != nil {
 			return nil, err
@@ -233,6 +283,9 @@ func (r *PgxRepository) ListPermissions(ctx context.Context) ([]*common.Permissi
 
 		return &perm, nil
 	})
+	if err != nil {
+		return nil, 0, err
+	}
 
-	return perms, err
+	return perms, total, nil
 }
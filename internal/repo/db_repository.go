@@ -1,13 +1,84 @@
 package repo
 
 import (
+	"context"
+	"data_processor/internal/auth"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type PgxRepository struct {
-	pool *pgxpool.Pool
+	pool           *pgxpool.Pool
+	passwordParams auth.PasswordParams
 }
 
 func NewPgxRepository(pool *pgxpool.Pool) *PgxRepository {
-	return &PgxRepository{pool: pool}
+	return &PgxRepository{
+		pool:           pool,
+		passwordParams: auth.DefaultPasswordParams,
+	}
+}
+
+// SetPasswordParams changes the argon2id parameters used for new hashes.
+// Existing hashes made with other parameters are rehashed on the next login.
+func (r *PgxRepository) SetPasswordParams(params auth.PasswordParams) {
+	r.passwordParams = params
+}
+
+// count выполняет запрос вида SELECT COUNT(*) ... и возвращает результат
+func (r *PgxRepository) count(ctx context.Context, query string, args ...any) (int, error) {
+	var total int
+	if err := r.pool.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("failed to count rows: %w", err)
+	}
+	return total, nil
+}
+
+// executor — методы, общие для пула и транзакции
+type executor interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// Параметры транзакции, из которых триггеры истории берут автора изменения и восстановленную ревизию
+const (
+	actorSetting        = "data_processor.actor_id"
+	restoredFromSetting = "data_processor.restored_from"
+)
+
+// inAuditedTx выполняет fn в транзакции, в которой триггеры истории видят автора изменения —
+// пользователя из контекста запроса
+func (r *PgxRepository) inAuditedTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		if err := setLocal(ctx, tx, actorSetting, strconv.Itoa(int(principal.UserID))); err != nil {
+			return err
+		}
+	}
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// setLocal задаёт параметр до конца транзакции
+func setLocal(ctx context.Context, tx pgx.Tx, name, value string) error {
+	if _, err := tx.Exec(ctx, `SELECT set_config($1, $2, true)`, name, value); err != nil {
+		return fmt.Errorf("failed to set %s: %w", name, err)
+	}
+	return nil
 }
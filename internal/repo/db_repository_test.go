@@ -3,6 +3,11 @@ package repo
 import (
 	"context"
 	"data_processor/internal/common"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -51,124 +56,31 @@ func setupTestDB(t *testing.T) (*pgxpool.Pool, func()) {
 	}
 }
 
+// applyMigrations применяет Up-часть goose-миграций из каталога sql в порядке имён файлов,
+// чтобы схема в тестах совпадала с продовой
 func applyMigrations(pool *pgxpool.Pool) error {
-	// Здесь должна быть реализация применения миграций
-	// В тестах можно использовать простой SQL для создания таблиц
-	_, err := pool.Exec(context.Background(), `CREATE TABLE users (
-                       id SERIAL PRIMARY KEY,
-                       name VARCHAR(255) NOT NULL,
-                       password VARCHAR(255) NOT NULL
-);
-
-CREATE TABLE roles (
-                       id SERIAL PRIMARY KEY,
-                       name VARCHAR(255),
-                       description VARCHAR(512),
-                       is_active BOOLEAN DEFAULT true,
-                       created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-                       updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
-                       owner_id INTEGER,
-                       FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE
-);
-
-CREATE TABLE permissions (
-                             id SERIAL PRIMARY KEY,
-                             name VARCHAR(255) NOT NULL,
-                             description VARCHAR(512),
-                             created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-                             updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
-                             read BOOLEAN DEFAULT false,
-                             write BOOLEAN DEFAULT false
-);
-
-CREATE TABLE organizations (
-                               id SERIAL PRIMARY KEY,
-                               project_name VARCHAR(255) NOT NULL,
-                               owner_id INTEGER NOT NULL,
-                               FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE
-);
-
-CREATE TABLE teams (
-                       id SERIAL PRIMARY KEY,
-                       team_name VARCHAR(255) NOT NULL,
-                       owner_id INTEGER NOT NULL,
-                       folder VARCHAR(255),
-                       organization_id INTEGER NOT NULL,
-                       FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
-                       FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE
-);
-
-
-CREATE TABLE users_roles (
-                             user_id INTEGER NOT NULL,
-                             role_id INTEGER NOT NULL,
-                             FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
-                             FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE
-);
-
-CREATE TABLE roles_permission_organisation (
-                                               role_id INTEGER,
-                                               organisation_id INTEGER NOT NULL,
-                                               permission_id INTEGER UNIQUE NOT NULL,
-                                               FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE,
-                                               FOREIGN KEY (organisation_id) REFERENCES organizations(id) ON DELETE CASCADE,
-                                               FOREIGN KEY (permission_id) REFERENCES permissions(id) ON DELETE CASCADE
-);
-CREATE TABLE roles_permission_team (
-                                       role_id INTEGER,
-                                       team_id INTEGER NOT NULL,
-                                       permission_id INTEGER UNIQUE NOT NULL,
-                                       FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE,
-                                       FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
-                                       FOREIGN KEY (permission_id) REFERENCES permissions(id) ON DELETE CASCADE
-);
-
-CREATE TABLE applications (
-                              id SERIAL PRIMARY KEY,
-                              name VARCHAR(255) NOT NULL,
-                              description VARCHAR(512),
-                              team_id INTEGER NOT NULL,
-                              FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE SET NULL
-
-);
-
-CREATE TABLE versions (
-                          id SERIAL PRIMARY KEY,
-                          application_id INTEGER NOT NULL,
-                          version VARCHAR(50) NOT NULL,
-                          FOREIGN KEY (application_id) REFERENCES applications(id) ON DELETE CASCADE
-);
-
-CREATE TABLE scans (
-                       id SERIAL PRIMARY KEY,
-                       scan_date DATE NOT NULL DEFAULT CURRENT_DATE,
-                       version_id INTEGER NOT NULL,
-                       FOREIGN KEY (version_id) REFERENCES versions(id) ON DELETE CASCADE
-);
-
-CREATE TABLE scan_info (
-                           id SERIAL PRIMARY KEY,
-                           scan_id INTEGER NOT NULL,
-                           FOREIGN KEY (scan_id) REFERENCES scans(id) ON DELETE CASCADE
-);
-
-CREATE TABLE scan_rules (
-                            id SERIAL PRIMARY KEY,
-                            application_id INTEGER NOT NULL,
-                            team_id INTEGER NOT NULL,
-                            organization_id INTEGER NOT NULL,
-                            sca_scan_enabled BOOLEAN,
-                            sast_scan_enabled BOOLEAN,
-                            allow_incremental_scans BOOLEAN,
-                            allow_sast_empty_code BOOLEAN,
-                            exclude_dir_regexp_queue VARCHAR(255) ARRAY,
-                            forced_do_own_sbom BOOLEAN,
-                            active_blocking_sca BOOLEAN,
-                            FOREIGN KEY (application_id) REFERENCES applications(id) ON DELETE CASCADE,
-                            FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
-                            FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
-);`)
-	return err
+	files, err := filepath.Glob(filepath.Join("..", "..", "sql", "*.sql"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		up := string(data)
+		if i := strings.Index(up, "-- +goose Down"); i >= 0 {
+			up = up[:i]
+		}
+
+		if _, err := pool.Exec(context.Background(), up); err != nil {
+			return fmt.Errorf("failed to apply %s: %w", filepath.Base(file), err)
+		}
+	}
+	return nil
 }
 
 func TestRoleRepository(t *testing.T) {
@@ -339,17 +251,17 @@ func TestRoleRepository(t *testing.T) {
 
 		// Обновляем роль
 		newDesc := "Updated description"
-		updatedRole, err := repo.UpdateRole(ctx, createdRole.Role.ID, nil, &newDesc, nil)
+		updatedRole, err := repo.UpdateRole(ctx, &common.Role{ID: createdRole.Role.ID, Description: &newDesc}, []string{"description"})
 		require.NoError(t, err)
 		assert.Equal(t, newDesc, *updatedRole.Description)
 
 		// Удаляем роль
-		err = repo.DeleteRole(ctx, createdRole.Role.ID)
+		err = repo.DeleteRole(ctx, createdRole.Role.ID, 0)
 		require.NoError(t, err)
 
 		// Проверяем что роль удалена
 		deletedRole, err := repo.GetRole(ctx, createdRole.Role.ID)
-		require.NoError(t, err)
+		assert.ErrorIs(t, err, common.ErrNotFound)
 		assert.Nil(t, deletedRole)
 	})
 }
@@ -364,7 +276,7 @@ func TestRoleRepositoryEdgeCases(t *testing.T) {
 
 	t.Run("Get Non-Existent Role", func(t *testing.T) {
 		role, err := repo.GetRole(ctx, 9999)
-		require.NoError(t, err)
+		assert.ErrorIs(t, err, common.ErrNotFound)
 		assert.Nil(t, role)
 	})
 
@@ -376,7 +288,7 @@ func TestRoleRepositoryEdgeCases(t *testing.T) {
 	})
 
 	t.Run("Delete Non-Existent Role", func(t *testing.T) {
-		err := repo.DeleteRole(ctx, 9999)
+		err := repo.DeleteRole(ctx, 9999, 0)
 		require.NoError(t, err)
 	})
 }
@@ -12,7 +12,7 @@ var _ IRoleRepository = (*PgxRepository)(nil)
 
 func (r *PgxRepository) CreateRole(ctx context.Context, role *common.Role) (*common.RoleWithPermissions, error) {
 	query := `INSERT INTO roles (name, description, is_active, owner_id) 
-              VALUES ($1, $2, $3, $4) RETURNING id, created_at, updated_at`
+              VALUES ($1, $2, $3, $4) RETURNING id, created_at, updated_at, revision`
 
 	roleWithPerms := &common.RoleWithPermissions{
 		Role:        role,
@@ -21,52 +21,59 @@ func (r *PgxRepository) CreateRole(ctx context.Context, role *common.Role) (*com
 
 	err := r.pool.QueryRow(ctx, query,
 		role.Name, role.Description, role.IsActive, role.OwnerID,
-	).Scan(&role.ID, &role.CreatedAt, &role.UpdatedAt)
+	).Scan(&role.ID, &role.CreatedAt, &role.UpdatedAt, &role.Revision)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to create role: %w", err)
+		return nil, dbError("role", fmt.Errorf("failed to create role: %w", err))
 	}
 
 	return roleWithPerms, nil
 }
-func (r *PgxRepository) UpdateRole(ctx context.Context, roleID int, name, description *string, isActive *bool) (*common.Role, error) {
-	query := `
-        UPDATE roles SET 
-            name = COALESCE($1, name),
-            description = COALESCE($2, description),
-            is_active = COALESCE($3, is_active),
-            updated_at = NOW()
-        WHERE id = $4
-        RETURNING id, name, description, is_active, created_at, updated_at, owner_id
-    `
 
-	role := &common.Role{}
-	err := r.pool.QueryRow(ctx, query, name, description, isActive, roleID).Scan(
-		&role.ID, &role.Name, &role.Description, &role.IsActive,
-		&role.CreatedAt, &role.UpdatedAt, &role.OwnerID,
-	)
+var roleColumns = updateColumns[*common.Role]{
+	"name":        func(r *common.Role) any { return r.Name },
+	"description": func(r *common.Role) any { return r.Description },
+	"is_active":   func(r *common.Role) any { return r.IsActive },
+}
 
+// UpdateRole changes only the listed fields and returns the updated role, or a common.ErrNotFound error if it does not exist.
+func (r *PgxRepository) UpdateRole(ctx context.Context, role *common.Role, fields []string) (*common.Role, error) {
+	query, args, err := roleColumns.updateRevision("roles",
+		"id, name, description, is_active, created_at, updated_at, owner_id, revision",
+		role, role.ID, role.Revision, fields, "updated_at = NOW()")
 	if err != nil {
-		return nil, fmt.Errorf("failed to update role: %w", err)
+		return nil, err
 	}
 
-	return role, nil
+	updated := &common.Role{}
+	err = r.pool.QueryRow(ctx, query, args...).Scan(
+		&updated.ID, &updated.Name, &updated.Description, &updated.IsActive,
+		&updated.CreatedAt, &updated.UpdatedAt, &updated.OwnerID, &updated.Revision,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.notUpdated(ctx, "roles", role.ID, role.Revision)
+		}
+		return nil, dbError("role", fmt.Errorf("failed to update role: %w", err))
+	}
+
+	return updated, nil
 }
 
 func (r *PgxRepository) GetRole(ctx context.Context, id int) (*common.RoleWithPermissions, error) {
 	// Получаем базовую информацию о роли
-	roleQuery := `SELECT id, name, description, is_active, created_at, updated_at, owner_id 
+	roleQuery := `SELECT id, name, description, is_active, created_at, updated_at, owner_id, revision 
                   FROM roles WHERE id = $1`
 
 	role := &common.Role{}
 	err := r.pool.QueryRow(ctx, roleQuery, id).Scan(
 		&role.ID, &role.Name, &role.Description, &role.IsActive,
-		&role.CreatedAt, &role.UpdatedAt, &role.OwnerID,
+		&role.CreatedAt, &role.UpdatedAt, &role.OwnerID, &role.Revision,
 	)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("role", id)
 		}
 		return nil, fmt.Errorf("failed to get role: %w", err)
 	}
@@ -75,7 +82,7 @@ func (r *PgxRepository) GetRole(ctx context.Context, id int) (*common.RoleWithPe
 	permQuery := `
         SELECT 
             p.id, p.name, p.description, p.created_at, p.updated_at, 
-            p.read, p.write,
+            p.read, p.write, p.revision,
             rpo.organisation_id as org_id,
             rpt.team_id as team_id
         FROM permissions p
@@ -97,7 +104,7 @@ func (r *PgxRepository) GetRole(ctx context.Context, id int) (*common.RoleWithPe
 
 		err := rows.Scan(
 			&perm.ID, &perm.Name, &perm.Description, &perm.CreatedAt, &perm.UpdatedAt,
-			&perm.Read, &perm.Write, &orgID, &teamID,
+			&perm.Read, &perm.Write, &perm.Revision, &orgID, &teamID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan permission: %w", err)
@@ -122,13 +129,28 @@ func (r *PgxRepository) GetRole(ctx context.Context, id int) (*common.RoleWithPe
 		Permissions: permissions,
 	}, nil
 }
-func (r *PgxRepository) DeleteRole(ctx context.Context, roleID int) error {
+
+// DeleteRole deletes the role with its permission links and user assignments. A non-zero
+// revision must match the stored one, otherwise common.ErrRevisionMismatch is returned.
+func (r *PgxRepository) DeleteRole(ctx context.Context, roleID, revision int) error {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
+	// Блокируем роль до конца транзакции, чтобы её не изменили между проверкой и удалением
+	if revision != 0 {
+		var current int
+		err = tx.QueryRow(ctx, `SELECT revision FROM roles WHERE id = $1 FOR UPDATE`, roleID).Scan(&current)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to lock role: %w", err)
+		}
+		if err == nil && current != revision {
+			return common.ErrRevisionMismatch
+		}
+	}
+
 	// Удаляем связи с permissions
 	_, err = tx.Exec(ctx, `DELETE FROM roles_permission_organisation WHERE role_id = $1`, roleID)
 	if err != nil {
@@ -156,18 +178,18 @@ func (r *PgxRepository) DeleteRole(ctx context.Context, roleID int) error {
 }
 
 func (r *PgxRepository) GetRoleByName(ctx context.Context, name string) (*common.Role, error) {
-	query := `SELECT id, name, description, is_active, created_at, updated_at, owner_id 
+	query := `SELECT id, name, description, is_active, created_at, updated_at, owner_id, revision 
               FROM roles WHERE name = $1`
 
 	role := &common.Role{}
 	err := r.pool.QueryRow(ctx, query, name).Scan(
 		&role.ID, &role.Name, &role.Description, &role.IsActive,
-		&role.CreatedAt, &role.UpdatedAt, &role.OwnerID,
+		&role.CreatedAt, &role.UpdatedAt, &role.OwnerID, &role.Revision,
 	)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("role", name)
 		}
 		return nil, fmt.Errorf("failed to get role by name: %w", err)
 	}
@@ -189,7 +211,7 @@ func (r *PgxRepository) AddPermission(ctx context.Context, roleID int, permissio
 		return fmt.Errorf("failed to check permission existence: %w", err)
 	}
 	if !exists {
-		return fmt.Errorf("permission with ID %d does not exist", permission.ID)
+		return common.InvalidReferenceError("permission_id", "permission", permission.ID)
 	}
 
 	// Добавляем связь в зависимости от типа permission
@@ -205,7 +227,7 @@ func (r *PgxRepository) AddPermission(ctx context.Context, roleID int, permissio
 			return fmt.Errorf("failed to check organization existence: %w", err)
 		}
 		if !orgExists {
-			return fmt.Errorf("organization with ID %d does not exist", *permission.OrganizationID)
+			return common.InvalidReferenceError("organization_id", "organization", *permission.OrganizationID)
 		}
 
 		_, err = tx.Exec(ctx, `
@@ -226,7 +248,7 @@ func (r *PgxRepository) AddPermission(ctx context.Context, roleID int, permissio
 			return fmt.Errorf("failed to check team existence: %w", err)
 		}
 		if !teamExists {
-			return fmt.Errorf("team with ID %d does not exist", *permission.TeamID)
+			return common.InvalidReferenceError("team_id", "team", *permission.TeamID)
 		}
 
 		_, err = tx.Exec(ctx, `
@@ -246,7 +268,7 @@ func (r *PgxRepository) AddPermission(ctx context.Context, roleID int, permissio
 	}
 
 	if err != nil {
-		return fmt.Errorf("failed to add permission: %w", err)
+		return dbError("role", fmt.Errorf("failed to add permission: %w", err))
 	}
 
 	return tx.Commit(ctx)
@@ -283,7 +305,7 @@ func (r *PgxRepository) ListRolesByScope(ctx context.Context, scope common.RoleS
 	if scope.OrganizationID != nil {
 		query = `
             SELECT DISTINCT r.id, r.name, r.description, r.is_active, 
-                   r.created_at, r.updated_at, r.owner_id
+                   r.created_at, r.updated_at, r.owner_id, r.revision
             FROM roles r
             JOIN roles_permission_organisation rpo ON r.id = rpo.role_id
             WHERE rpo.organisation_id = $1
@@ -292,14 +314,14 @@ func (r *PgxRepository) ListRolesByScope(ctx context.Context, scope common.RoleS
 	} else if scope.TeamID != nil {
 		query = `
             SELECT DISTINCT r.id, r.name, r.description, r.is_active, 
-                   r.created_at, r.updated_at, r.owner_id
+                   r.created_at, r.updated_at, r.owner_id, r.revision
             FROM roles r
             JOIN roles_permission_team rpt ON r.id = rpt.role_id
             WHERE rpt.team_id = $1
         `
 		args = []interface{}{*scope.TeamID}
 	} else {
-		query = `SELECT id, name, description, is_active, created_at, updated_at, owner_id FROM roles`
+		query = `SELECT id, name, description, is_active, created_at, updated_at, owner_id, revision FROM roles`
 	}
 
 	rows, err := r.pool.Query(ctx, query, args...)
@@ -312,7 +334,7 @@ func (r *PgxRepository) ListRolesByScope(ctx context.Context, scope common.RoleS
 		var role common.Role
 		err := row.Scan(
 			&role.ID, &role.Name, &role.Description, &role.IsActive,
-			&role.CreatedAt, &role.UpdatedAt, &role.OwnerID,
+			&role.CreatedAt, &role.UpdatedAt, &role.OwnerID, &role.Revision,
 		)
 		return &role, err
 	})
@@ -343,7 +365,7 @@ func (r *PgxRepository) AssignRoleToUser(ctx context.Context, userID common.User
 		return fmt.Errorf("failed to check user existence: %w", err)
 	}
 	if !userExists {
-		return fmt.Errorf("user with ID %d does not exist", userID)
+		return common.InvalidReferenceError("user_id", "user", userID)
 	}
 
 	err = r.pool.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM roles WHERE id = $1)`, roleID).Scan(&roleExists)
@@ -351,7 +373,7 @@ func (r *PgxRepository) AssignRoleToUser(ctx context.Context, userID common.User
 		return fmt.Errorf("failed to check role existence: %w", err)
 	}
 	if !roleExists {
-		return fmt.Errorf("role with ID %d does not exist", roleID)
+		return common.InvalidReferenceError("role_id", "role", roleID)
 	}
 
 	_, err = r.pool.Exec(ctx, `
@@ -384,7 +406,7 @@ func (r *PgxRepository) RemoveRoleFromUser(ctx context.Context, userID common.Us
 
 func (r *PgxRepository) GetUserRoles(ctx context.Context, userID common.UserID) ([]*common.Role, error) {
 	query := `
-        SELECT r.id, r.name, r.description, r.is_active, r.created_at, r.updated_at, r.owner_id
+        SELECT r.id, r.name, r.description, r.is_active, r.created_at, r.updated_at, r.owner_id, r.revision
         FROM roles r
         JOIN users_roles ur ON r.id = ur.role_id
         WHERE ur.user_id = $1
@@ -400,7 +422,7 @@ func (r *PgxRepository) GetUserRoles(ctx context.Context, userID common.UserID)
 		var role common.Role
 		err := row.Scan(
 			&role.ID, &role.Name, &role.Description, &role.IsActive,
-			&role.CreatedAt, &role.UpdatedAt, &role.OwnerID,
+			&role.CreatedAt, &role.UpdatedAt, &role.OwnerID, &role.Revision,
 		)
 		return &role, err
 	})
@@ -412,12 +434,23 @@ func (r *PgxRepository) GetUserRoles(ctx context.Context, userID common.UserID)
 	return roles, nil
 }
 
-func (r *PgxRepository) ListRoles(ctx context.Context) ([]*common.Role, error) {
-	query := `SELECT id, name, description, is_active, created_at, updated_at, owner_id FROM roles`
+// ListRoles returns a page of roles ordered by id and the total number of roles.
+func (r *PgxRepository) ListRoles(ctx context.Context, page common.Page) ([]*common.Role, int, error) {
+	q, err := newListQuery(roleFields, page, "id")
+	if err != nil {
+		return nil, 0, err
+	}
 
-	rows, err := r.pool.Query(ctx, query)
+	total, err := r.count(ctx, `SELECT COUNT(*) FROM roles`+q.whereSQL(), q.args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query roles: %w", err)
+		return nil, 0, err
+	}
+
+	q.after(page)
+	query := `SELECT id, name, description, is_active, created_at, updated_at, owner_id, revision FROM roles` + q.whereSQL() + q.tail(page)
+	rows, err := r.pool.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query roles: %w", err)
 	}
 	defer rows.Close()
 
@@ -425,14 +458,14 @@ func (r *PgxRepository) ListRoles(ctx context.Context) ([]*common.Role, error) {
 		var role common.Role
 		err := row.Scan(
 			&role.ID, &role.Name, &role.Description, &role.IsActive,
-			&role.CreatedAt, &role.UpdatedAt, &role.OwnerID,
+			&role.CreatedAt, &role.UpdatedAt, &role.OwnerID, &role.Revision,
 		)
 		return &role, err
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to scan roles: %w", err)
+		return nil, 0, fmt.Errorf("failed to scan roles: %w", err)
 	}
 
-	return roles, nil
+	return roles, total, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"data_processor/internal/common"
 	"errors"
+	"fmt"
 	"github.com/jackc/pgx/v5"
 )
 
@@ -11,7 +12,7 @@ var _ IScanInfoRepository = (*PgxRepository)(nil)
 
 func (r *PgxRepository) CreateScanInfo(ctx context.Context, scanInfo *common.ScanInfo) error {
 	query := `INSERT INTO scan_info (scan_id) VALUES ($1) RETURNING id`
-	return r.pool.QueryRow(ctx, query, scanInfo.ScanID).Scan(&scanInfo.ID)
+	return dbError("scan_info", r.pool.QueryRow(ctx, query, scanInfo.ScanID).Scan(&scanInfo.ID))
 }
 
 func (r *PgxRepository) GetScanInfoByID(ctx context.Context, id int) (*common.ScanInfo, error) {
@@ -20,7 +21,7 @@ func (r *PgxRepository) GetScanInfoByID(ctx context.Context, id int) (*common.Sc
 	err := r.pool.QueryRow(ctx, query, id).Scan(&scanInfo.ID, &scanInfo.ScanID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("scan_info", id)
 		}
 		return nil, err
 	}
@@ -33,21 +34,36 @@ func (r *PgxRepository) GetScanInfoByScanID(ctx context.Context, scanID int) (*c
 	err := r.pool.QueryRow(ctx, query, scanID).Scan(&scanInfo.ID, &scanInfo.ScanID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("scan_info", fmt.Sprintf("scan_id=%d", scanID))
 		}
 		return nil, err
 	}
 	return scanInfo, nil
 }
 
-func (r *PgxRepository) UpdateScanInfo(ctx context.Context, scanInfo *common.ScanInfo) error {
-	query := `UPDATE scan_info SET scan_id = $1 WHERE id = $2`
-	_, err := r.pool.Exec(ctx, query, scanInfo.ScanID, scanInfo.ID)
-	return err
+var scanInfoColumns = updateColumns[*common.ScanInfo]{
+	"scan_id": func(s *common.ScanInfo) any { return s.ScanID },
+}
+
+// UpdateScanInfo changes only the listed fields and returns the updated scan info, or a common.ErrNotFound error if it does not exist.
+func (r *PgxRepository) UpdateScanInfo(ctx context.Context, scanInfo *common.ScanInfo, fields []string) (*common.ScanInfo, error) {
+	query, args, err := scanInfoColumns.update("scan_info", "id, scan_id", scanInfo, scanInfo.ID, fields)
+	if err != nil {
+		return nil, err
+	}
+	updated := &common.ScanInfo{}
+	err = r.pool.QueryRow(ctx, query, args...).Scan(&updated.ID, &updated.ScanID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NotFoundError("scan_info", scanInfo.ID)
+		}
+		return nil, dbError("scan_info", fmt.Errorf("failed to update scan info: %w", err))
+	}
+	return updated, nil
 }
 
 func (r *PgxRepository) DeleteScanInfo(ctx context.Context, id int) error {
 	query := `DELETE FROM scan_info WHERE id = $1`
 	_, err := r.pool.Exec(ctx, query, id)
-	return err
+	return dbError("scan_info", err)
 }
@@ -0,0 +1,206 @@
+package repo
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var _ IScanQueueRepository = (*PgxRepository)(nil)
+
+// maxSkippedScans ограничивает число сканов, отменённых правилами за один вызов LeaseScan
+const maxSkippedScans = 100
+
+// LeaseScan hands the oldest available queued scan of one of the given types to the worker and
+// moves it to running. Scans whose type the scan rules of their application disable are
+// cancelled instead of being leased. Expired leases are returned to the queue first. A nil lease
+// means that no scan is waiting.
+//
+// Candidates are locked with FOR UPDATE SKIP LOCKED, so concurrent workers never get the same
+// scan and do not wait for each other.
+func (r *PgxRepository) LeaseScan(ctx context.Context, workerID string, types []common.ScanType, opts common.ScanQueueOptions) (*common.ScanLease, error) {
+	if _, _, err := r.RequeueExpiredScans(ctx, opts); err != nil {
+		return nil, err
+	}
+
+	typeNames := make([]string, 0, len(types))
+	for _, t := range types {
+		typeNames = append(typeNames, string(t))
+	}
+
+	for range maxSkippedScans {
+		var lease *common.ScanLease
+		skipped := false
+		err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+			var scanID, appID int
+			var scanType common.ScanType
+			err := tx.QueryRow(ctx, `SELECT s.id, s.scan_type, v.application_id
+				FROM scans s JOIN versions v ON v.id = s.version_id
+				WHERE s.status = 'queued' AND s.available_at <= NOW() AND s.scan_type = ANY($1)
+				ORDER BY s.available_at, s.id
+				LIMIT 1
+				FOR UPDATE OF s SKIP LOCKED`, typeNames).Scan(&scanID, &scanType, &appID)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to select queued scan: %w", err)
+			}
+
+			rule, err := effectiveScanRule(ctx, tx, appID)
+			if err != nil {
+				return err
+			}
+			if !rule.Settings.ScanEnabled(scanType) {
+				skipped = true
+				_, err := tx.Exec(ctx, `UPDATE scans SET status = 'cancelled', finished_at = NOW(), exit_reason = $2
+					WHERE id = $1`, scanID, fmt.Sprintf("%s scans are disabled by the scan rules of the application", scanType))
+				return dbError("scan", err)
+			}
+
+			scan, err := scanScan(tx.QueryRow(ctx, `UPDATE scans SET
+				status = 'running',
+				started_at = NOW(),
+				exit_reason = NULL,
+				attempts = attempts + 1,
+				lease_owner = $2,
+				lease_expires_at = NOW() + make_interval(secs => $3),
+				phase = NULL,
+				progress = 0,
+				progress_message = NULL
+			WHERE id = $1
+			RETURNING `+scanColumnsSQL, scanID, workerID, opts.LeaseTTL.Seconds()))
+			if err != nil {
+				return dbError("scan", fmt.Errorf("failed to lease scan: %w", err))
+			}
+			lease = &common.ScanLease{Scan: scan, Rule: rule}
+			return nil
+		})
+		if err != nil || !skipped {
+			return lease, err
+		}
+	}
+	return nil, nil
+}
+
+// HeartbeatScan extends the lease of a running scan held by the worker by ttl. A lease that has
+// expired but was not yet returned to the queue is extended as well. A scan that is no longer
+// leased by the worker is reported as common.ErrConflict: the worker must stop scanning it.
+func (r *PgxRepository) HeartbeatScan(ctx context.Context, id int, workerID string, ttl time.Duration) (*common.Scan, error) {
+	scan, err := scanScan(r.pool.QueryRow(ctx, `UPDATE scans SET lease_expires_at = NOW() + make_interval(secs => $3)
+		WHERE id = $1 AND status = 'running' AND lease_owner = $2
+		RETURNING `+scanColumnsSQL, id, workerID, ttl.Seconds()))
+	if err == nil {
+		return scan, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to extend scan lease: %w", err)
+	}
+	return nil, r.notLeasedError(ctx, id, workerID)
+}
+
+// ReportScanProgress records the progress of a running scan held by the worker and extends its
+// lease by ttl, so a worker that reports progress does not need separate heartbeats. Watchers of
+// the scan are notified by the scans_notify_update trigger. A scan that is no longer leased by
+// the worker is reported as common.ErrConflict.
+func (r *PgxRepository) ReportScanProgress(ctx context.Context, id int, workerID string, progress common.ScanProgress, ttl time.Duration) (*common.Scan, error) {
+	scan, err := scanScan(r.pool.QueryRow(ctx, `UPDATE scans SET
+		phase = NULLIF($4, ''),
+		progress = $5,
+		progress_message = NULLIF($6, ''),
+		lease_expires_at = NOW() + make_interval(secs => $3)
+	WHERE id = $1 AND status = 'running' AND lease_owner = $2
+	RETURNING `+scanColumnsSQL, id, workerID, ttl.Seconds(), progress.Phase, progress.Percent, progress.Message))
+	if err == nil {
+		return scan, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, dbError("scan", fmt.Errorf("failed to report scan progress: %w", err))
+	}
+	return nil, r.notLeasedError(ctx, id, workerID)
+}
+
+// notLeasedError объясняет, почему скан не удалось найти среди арендованных воркером
+func (r *PgxRepository) notLeasedError(ctx context.Context, id int, workerID string) error {
+	var status common.ScanStatus
+	err := r.pool.QueryRow(ctx, `SELECT status FROM scans WHERE id = $1`, id).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return common.NotFoundError("scan", id)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get scan status: %w", err)
+	}
+	return common.ConflictError("scan", fmt.Sprintf("scan %d is %s and not leased by worker %q", id, status, workerID))
+}
+
+// RequeueExpiredScans returns the scans whose lease expired to the queue, or fails them if they
+// have used up their attempts, and returns the number of requeued and failed scans. Both happen
+// in one statement, so a lease that expires meanwhile cannot be requeued past its last attempt.
+func (r *PgxRepository) RequeueExpiredScans(ctx context.Context, opts common.ScanQueueOptions) (requeued, failed int, err error) {
+	// Задержка перед повтором удваивается с каждой попыткой; в SET видны значения до обновления
+	rows, err := r.pool.Query(ctx, `UPDATE scans SET
+		status = CASE WHEN attempts >= $1 THEN 'failed' ELSE 'queued' END,
+		finished_at = CASE WHEN attempts >= $1 THEN NOW() END,
+		exit_reason = CASE WHEN attempts >= $1
+			THEN format('the lease of worker %s expired, %s attempts used', lease_owner, attempts)
+			ELSE exit_reason END,
+		started_at = CASE WHEN attempts >= $1 THEN started_at END,
+		phase = CASE WHEN attempts >= $1 THEN phase END,
+		progress = CASE WHEN attempts >= $1 THEN progress ELSE 0 END,
+		progress_message = CASE WHEN attempts >= $1 THEN progress_message END,
+		available_at = CASE WHEN attempts >= $1 THEN available_at
+			ELSE NOW() + make_interval(secs => LEAST($2 * power(2, attempts - 1), $3)) END,
+		lease_owner = NULL,
+		lease_expires_at = NULL
+	WHERE lease_expires_at < NOW()
+	RETURNING status`, opts.MaxAttempts, opts.RetryBackoff.Seconds(), opts.MaxRetryBackoff.Seconds())
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to requeue expired scans: %w", err)
+	}
+	statuses, err := pgx.CollectRows(rows, pgx.RowTo[common.ScanStatus])
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to requeue expired scans: %w", err)
+	}
+	for _, status := range statuses {
+		if status == common.ScanFailed {
+			failed++
+		} else {
+			requeued++
+		}
+	}
+	return requeued, failed, nil
+}
+
+// ListenScanChanges calls fn with the id of every scan whose status or progress changes, on any
+// replica, until ctx is cancelled or the connection is lost. Changes are delivered through
+// Postgres LISTEN/NOTIFY on the scan_changes channel; fn is called from the listening goroutine
+// and must not block.
+func (r *PgxRepository) ListenScanChanges(ctx context.Context, fn func(scanID int)) error {
+	pooled, err := r.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	// Соединение в режиме LISTEN нельзя возвращать в пул
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, `LISTEN scan_changes`); err != nil {
+		return fmt.Errorf("failed to listen for scan changes: %w", err)
+	}
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to wait for scan changes: %w", err)
+		}
+		id, err := strconv.Atoi(n.Payload)
+		if err != nil {
+			continue
+		}
+		fn(id)
+	}
+}
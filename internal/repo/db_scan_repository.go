@@ -4,56 +4,220 @@ import (
 	"context"
 	"data_processor/internal/common"
 	"errors"
+	"fmt"
 	"github.com/jackc/pgx/v5"
 )
 
 var _ IScanRepository = (*PgxRepository)(nil)
 
+// Тип скана, созданного до появления типов, — NULL; в модели это пустая строка
+const scanColumnsSQL = `id, scan_date, version_id, status, COALESCE(scan_type, ''),
+	initiator_id, started_at, finished_at, exit_reason,
+	attempts, available_at, lease_owner, lease_expires_at,
+	phase, progress, progress_message`
+
+func scanScan(row pgx.Row) (*common.Scan, error) {
+	scan := &common.Scan{}
+	err := row.Scan(
+		&scan.ID,
+		&scan.ScanDate,
+		&scan.VersionID,
+		&scan.Status,
+		&scan.Type,
+		&scan.InitiatorID,
+		&scan.StartedAt,
+		&scan.FinishedAt,
+		&scan.ExitReason,
+		&scan.Attempts,
+		&scan.AvailableAt,
+		&scan.LeaseOwner,
+		&scan.LeaseExpiresAt,
+		&scan.Phase,
+		&scan.Progress,
+		&scan.ProgressMessage,
+	)
+	return scan, err
+}
+
+// CreateScan creates a queued scan and fills in its id and status.
 func (r *PgxRepository) CreateScan(ctx context.Context, scan *common.Scan) error {
-	query := `INSERT INTO scans (scan_date, version_id) VALUES ($1, $2) RETURNING id`
-	return r.pool.QueryRow(ctx, query, scan.ScanDate, scan.VersionID).Scan(&scan.ID)
+	query := `INSERT INTO scans (scan_date, version_id, scan_type, initiator_id)
+		VALUES ($1, $2, NULLIF($3, ''), $4) RETURNING id, status`
+	return dbError("scan", r.pool.QueryRow(ctx, query,
+		scan.ScanDate,
+		scan.VersionID,
+		scan.Type,
+		scan.InitiatorID,
+	).Scan(&scan.ID, &scan.Status))
 }
 
 func (r *PgxRepository) GetScanByID(ctx context.Context, id int) (*common.Scan, error) {
-	query := `SELECT id, scan_date, version_id FROM scans WHERE id = $1`
-	scan := &common.Scan{}
-	err := r.pool.QueryRow(ctx, query, id).Scan(&scan.ID, &scan.ScanDate, &scan.VersionID)
+	query := `SELECT ` + scanColumnsSQL + ` FROM scans WHERE id = $1`
+	scan, err := scanScan(r.pool.QueryRow(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("scan", id)
 		}
 		return nil, err
 	}
 	return scan, nil
 }
 
-func (r *PgxRepository) UpdateScan(ctx context.Context, scan *common.Scan) error {
-	query := `UPDATE scans SET scan_date = $1, version_id = $2 WHERE id = $3`
-	_, err := r.pool.Exec(ctx, query, scan.ScanDate, scan.VersionID, scan.ID)
-	return err
+var scanColumns = updateColumns[*common.Scan]{
+	"scan_date":  func(s *common.Scan) any { return s.ScanDate },
+	"version_id": func(s *common.Scan) any { return s.VersionID },
+}
+
+// UpdateScan changes only the listed fields and returns the updated scan, or a common.ErrNotFound error if it does not exist.
+// The status is changed by TransitionScan.
+func (r *PgxRepository) UpdateScan(ctx context.Context, scan *common.Scan, fields []string) (*common.Scan, error) {
+	query, args, err := scanColumns.update("scans", scanColumnsSQL, scan, scan.ID, fields)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := scanScan(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NotFoundError("scan", scan.ID)
+		}
+		return nil, dbError("scan", fmt.Errorf("failed to update scan: %w", err))
+	}
+	return updated, nil
+}
+
+// TransitionScan moves the scan to status to and records the exit reason. Moving to running sets
+// the start time, moving to a final status the finish time and ends the lease; a succeeded scan
+// is at 100% progress. A transition that
+// common.ScanStatus.CanTransitionTo does not allow from the current status is reported as
+// common.ErrConflict; the check and the update are one statement, so concurrent transitions
+// cannot both succeed.
+//
+// Only the worker holding the lease of a scan can make it succeed or fail, so a worker whose
+// lease expired cannot finish a scan handed to another one; an empty workerID finishes only
+// scans without a lease, started by a client. Other transitions do not check the lease.
+func (r *PgxRepository) TransitionScan(ctx context.Context, id int, to common.ScanStatus, workerID string, reason *string) (*common.Scan, error) {
+	query := `UPDATE scans SET
+		status = $2,
+		exit_reason = $3,
+		started_at = CASE WHEN $4 THEN NOW() ELSE started_at END,
+		finished_at = CASE WHEN $5 THEN NOW() ELSE finished_at END,
+		lease_owner = CASE WHEN $5 THEN NULL ELSE lease_owner END,
+		lease_expires_at = CASE WHEN $5 THEN NULL ELSE lease_expires_at END,
+		progress = CASE WHEN $7 THEN 100 ELSE progress END
+	WHERE id = $1 AND status = ANY($6) AND (NOT $8 OR lease_owner IS NOT DISTINCT FROM NULLIF($9, ''))
+	RETURNING ` + scanColumnsSQL
+
+	checkLease := to == common.ScanSucceeded || to == common.ScanFailed
+	var from []string
+	for _, status := range common.ScanStatusesBefore(to) {
+		from = append(from, string(status))
+	}
+	scan, err := scanScan(r.pool.QueryRow(ctx, query,
+		id,
+		to,
+		reason,
+		to == common.ScanRunning,
+		to.Finished(),
+		from,
+		to == common.ScanSucceeded,
+		checkLease,
+		workerID,
+	))
+	if err == nil {
+		return scan, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, dbError("scan", fmt.Errorf("failed to update scan status: %w", err))
+	}
+
+	var current common.ScanStatus
+	var owner *string
+	err = r.pool.QueryRow(ctx, `SELECT status, lease_owner FROM scans WHERE id = $1`, id).Scan(&current, &owner)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, common.NotFoundError("scan", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan status: %w", err)
+	}
+	if !current.CanTransitionTo(to) {
+		return nil, common.ConflictError("scan", fmt.Sprintf("scan %d is %s and cannot become %s", id, current, to))
+	}
+	// Переход допустим, значит не совпал арендатор
+	if owner != nil && workerID == "" {
+		return nil, common.ConflictError("scan", fmt.Sprintf("scan %d is leased by worker %q and only it can finish the scan", id, *owner))
+	}
+	return nil, common.ConflictError("scan", fmt.Sprintf("scan %d is %s and not leased by worker %q", id, current, workerID))
 }
 
 func (r *PgxRepository) DeleteScan(ctx context.Context, id int) error {
 	query := `DELETE FROM scans WHERE id = $1`
 	_, err := r.pool.Exec(ctx, query, id)
-	return err
+	return dbError("scan", err)
 }
 
-func (r *PgxRepository) ListScans(ctx context.Context, versionID int) ([]*common.Scan, error) {
-	query := `SELECT id, scan_date, version_id FROM scans WHERE version_id = $1`
-	rows, err := r.pool.Query(ctx, query, versionID)
+// ListScans returns a page of the version's scans ordered by scan date and id, and their total number.
+func (r *PgxRepository) ListScans(ctx context.Context, versionID int, page common.Page) ([]*common.Scan, int, error) {
+	q, err := newListQuery(scanFields, page, "scan_date", "id")
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+	q.where("version_id = " + q.arg(versionID))
+
+	total, err := r.count(ctx, `SELECT COUNT(*) FROM scans`+q.whereSQL(), q.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	q.after(page)
+	query := `SELECT ` + scanColumnsSQL + ` FROM scans` + q.whereSQL() + q.tail(page)
+	rows, err := r.pool.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, 0, err
 	}
 	defer rows.Close()
 
 	scans, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.Scan, error) {
-		var scan common.Scan
-		err := row.Scan(&scan.ID, &scan.ScanDate, &scan.VersionID)
-		return &scan, err
+		return scanScan(row)
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+	return scans, total, nil
+}
+
+// GetLatestScan returns the latest scan of the version with the given type and status by scan
+// date.
+func (r *PgxRepository) GetLatestScan(ctx context.Context, versionID int, scanType common.ScanType, status common.ScanStatus) (*common.Scan, error) {
+	query := `SELECT ` + scanColumnsSQL + ` FROM scans
+		WHERE version_id = $1 AND scan_type = $2 AND status = $3
+		ORDER BY scan_date DESC, id DESC
+		LIMIT 1`
+	scan, err := scanScan(r.pool.QueryRow(ctx, query, versionID, scanType, status))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &common.Error{Kind: common.KindNotFound, Resource: "scan",
+			Msg: fmt.Sprintf("not found: version %d has no %s %s scan", versionID, status, scanType)}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest scan: %w", err)
+	}
+	return scan, nil
+}
+
+// lockScanForUpload serializes uploads of the given kind ("findings", "components") for the
+// scan within tx, so a retried upload never mixes with the previous one, and returns the scan
+// type. The scan row is locked FOR KEY SHARE: it cannot be deleted until tx ends, while workers
+// can still update its status and progress.
+func lockScanForUpload(ctx context.Context, tx pgx.Tx, kind string, scanID int) (common.ScanType, error) {
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1), $2)`, kind, scanID); err != nil {
+		return "", fmt.Errorf("failed to lock %s: %w", kind, err)
+	}
+	var scanType common.ScanType
+	err := tx.QueryRow(ctx, `SELECT COALESCE(scan_type, '') FROM scans WHERE id = $1 FOR KEY SHARE`, scanID).Scan(&scanType)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", common.NotFoundError("scan", scanID)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get scan: %w", err)
 	}
-	return scans, nil
+	return scanType, nil
 }
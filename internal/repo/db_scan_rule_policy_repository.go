@@ -0,0 +1,168 @@
+package repo
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"data_processor/internal/policy"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var _ IScanRulePolicyRepository = (*PgxRepository)(nil)
+
+// GetScanRulePolicyState returns the organizations with the given ids, or all organizations if
+// ids is nil, together with their teams, applications and scan rules.
+func (r *PgxRepository) GetScanRulePolicyState(ctx context.Context, orgIDs []int) (*policy.State, error) {
+	if orgIDs == nil {
+		return loadScanRulePolicyState(ctx, r.pool, "", nil, false)
+	}
+	return loadScanRulePolicyState(ctx, r.pool, "id = ANY($1)", orgIDs, false)
+}
+
+// ApplyScanRulePolicy brings the scan rules of the organizations listed in the document in line
+// with it and returns the executed plan. With dryRun the plan is only computed. The changes are
+// made in one transaction and recorded in the rule history; concurrent applies to the same
+// organizations are serialized. Names the document cannot resolve are reported as *policy.Error.
+func (r *PgxRepository) ApplyScanRulePolicy(ctx context.Context, doc *policy.Document, dryRun bool) ([]policy.Change, error) {
+	names := make([]string, 0, len(doc.Organizations))
+	for _, org := range doc.Organizations {
+		names = append(names, org.Name)
+	}
+
+	if dryRun {
+		state, err := loadScanRulePolicyState(ctx, r.pool, "project_name = ANY($1)", names, false)
+		if err != nil {
+			return nil, err
+		}
+		return policy.Plan(doc, state)
+	}
+
+	var changes []policy.Change
+	err := r.inAuditedTx(ctx, func(tx pgx.Tx) error {
+		state, err := loadScanRulePolicyState(ctx, tx, "project_name = ANY($1)", names, true)
+		if err != nil {
+			return err
+		}
+		if changes, err = policy.Plan(doc, state); err != nil {
+			return err
+		}
+		for _, change := range changes {
+			if err := applyScanRuleChange(ctx, tx, change); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func applyScanRuleChange(ctx context.Context, tx pgx.Tx, change policy.Change) error {
+	rule := change.Rule
+	switch change.Action {
+	case policy.Create:
+		return insertScanRule(ctx, tx, rule)
+	case policy.Update:
+		fields := make([]string, 0, len(change.Fields))
+		for _, field := range change.Fields {
+			fields = append(fields, field.Field)
+		}
+		query, args, err := scanRuleColumns.updateRevision("scan_rules", "revision", rule, rule.ID, 0, fields)
+		if err != nil {
+			return err
+		}
+		return dbError("scan_rule", tx.QueryRow(ctx, query, args...).Scan(&rule.Revision))
+	case policy.Delete:
+		if _, err := tx.Exec(ctx, `DELETE FROM scan_rules WHERE id = $1`, rule.ID); err != nil {
+			return fmt.Errorf("failed to delete scan rule: %w", err)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown plan action %q", change.Action)
+}
+
+// loadScanRulePolicyState читает организации, подходящие под условие orgCondition с параметром
+// arg, и всё, что к ним относится. С lock строки организаций и их правил блокируются до конца
+// транзакции: FOR NO KEY UPDATE на организациях упорядочивает параллельные применения, не мешая
+// вставкам, которые на них ссылаются.
+func loadScanRulePolicyState(ctx context.Context, db executor, orgCondition string, arg any, lock bool) (*policy.State, error) {
+	state := &policy.State{}
+
+	query := `SELECT id, project_name FROM organizations`
+	var args []any
+	if orgCondition != "" {
+		query += ` WHERE ` + orgCondition
+		args = append(args, arg)
+	}
+	query += ` ORDER BY id`
+	if lock {
+		query += ` FOR NO KEY UPDATE`
+	}
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load organizations: %w", err)
+	}
+	state.Organizations, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.Organization, error) {
+		org := &common.Organization{}
+		return org, row.Scan(&org.ID, &org.ProjectName)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load organizations: %w", err)
+	}
+
+	orgIDs := make([]int, 0, len(state.Organizations))
+	for _, org := range state.Organizations {
+		orgIDs = append(orgIDs, org.ID)
+	}
+
+	rows, err = db.Query(ctx, `SELECT id, team_name, organization_id FROM teams WHERE organization_id = ANY($1) ORDER BY id`, orgIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load teams: %w", err)
+	}
+	state.Teams, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.Team, error) {
+		team := &common.Team{}
+		return team, row.Scan(&team.ID, &team.TeamName, &team.OrganizationID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load teams: %w", err)
+	}
+
+	rows, err = db.Query(ctx, `SELECT a.id, a.name, a.team_id
+		FROM applications a JOIN teams t ON t.id = a.team_id
+		WHERE t.organization_id = ANY($1) ORDER BY a.id`, orgIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load applications: %w", err)
+	}
+	state.Applications, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.Application, error) {
+		app := &common.Application{}
+		return app, row.Scan(&app.ID, &app.Name, &app.TeamID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load applications: %w", err)
+	}
+
+	query = `SELECT
+		id, application_id, team_id, organization_id,
+		sca_scan_enabled, sast_scan_enabled, allow_incremental_scans,
+		allow_sast_empty_code, exclude_dir_regexp_queue, forced_do_own_sbom,
+		active_blocking_sca, revision
+	FROM scan_rules WHERE organization_id = ANY($1) ORDER BY id`
+	if lock {
+		query += ` FOR UPDATE`
+	}
+	rows, err = db.Query(ctx, query, orgIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load scan rules: %w", err)
+	}
+	state.Rules, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.ScanRule, error) {
+		rule := &common.ScanRule{}
+		return rule, scanScanRule(row, rule)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load scan rules: %w", err)
+	}
+	return state, nil
+}
@@ -4,20 +4,28 @@ import (
 	"context"
 	"data_processor/internal/common"
 	"errors"
+	"fmt"
 	"github.com/jackc/pgx/v5"
+	"strconv"
 )
 
 var _ IScanRuleRepository = (*PgxRepository)(nil)
 
 func (r *PgxRepository) CreateScanRule(ctx context.Context, rule *common.ScanRule) error {
+	return r.inAuditedTx(ctx, func(tx pgx.Tx) error {
+		return insertScanRule(ctx, tx, rule)
+	})
+}
+
+func insertScanRule(ctx context.Context, db executor, rule *common.ScanRule) error {
 	query := `INSERT INTO scan_rules (
 		application_id, team_id, organization_id,
 		sca_scan_enabled, sast_scan_enabled, allow_incremental_scans,
 		allow_sast_empty_code, exclude_dir_regexp_queue, forced_do_own_sbom,
 		active_blocking_sca
-	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id`
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id, revision`
 
-	return r.pool.QueryRow(ctx, query,
+	return dbError("scan_rule", db.QueryRow(ctx, query,
 		rule.ApplicationID,
 		rule.TeamID,
 		rule.OrganizationID,
@@ -28,7 +36,7 @@ func (r *PgxRepository) CreateScanRule(ctx context.Context, rule *common.ScanRul
 		rule.ExcludeDirRegexpQueue,
 		rule.ForcedDoOwnSBOM,
 		rule.ActiveBlockingSCA,
-	).Scan(&rule.ID)
+	).Scan(&rule.ID, &rule.Revision))
 }
 
 func (r *PgxRepository) GetScanRuleByID(ctx context.Context, id int) (*common.ScanRule, error) {
@@ -36,7 +44,7 @@ func (r *PgxRepository) GetScanRuleByID(ctx context.Context, id int) (*common.Sc
 		id, application_id, team_id, organization_id,
 		sca_scan_enabled, sast_scan_enabled, allow_incremental_scans,
 		allow_sast_empty_code, exclude_dir_regexp_queue, forced_do_own_sbom,
-		active_blocking_sca
+		active_blocking_sca, revision
 	FROM scan_rules WHERE id = $1`
 
 	rule := &common.ScanRule{}
@@ -52,63 +60,100 @@ func (r *PgxRepository) GetScanRuleByID(ctx context.Context, id int) (*common.Sc
 		&rule.ExcludeDirRegexpQueue,
 		&rule.ForcedDoOwnSBOM,
 		&rule.ActiveBlockingSCA,
+		&rule.Revision,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("scan_rule", id)
 		}
 		return nil, err
 	}
 	return rule, nil
 }
 
-func (r *PgxRepository) UpdateScanRule(ctx context.Context, rule *common.ScanRule) error {
-	query := `UPDATE scan_rules SET 
-		application_id = $1, 
-		team_id = $2, 
-		organization_id = $3,
-		sca_scan_enabled = $4,
-		sast_scan_enabled = $5,
-		allow_incremental_scans = $6,
-		allow_sast_empty_code = $7,
-		exclude_dir_regexp_queue = $8,
-		forced_do_own_sbom = $9,
-		active_blocking_sca = $10
-	WHERE id = $11`
-
-	_, err := r.pool.Exec(ctx, query,
-		rule.ApplicationID,
-		rule.TeamID,
-		rule.OrganizationID,
-		rule.SCAScanEnabled,
-		rule.SASTScanEnabled,
-		rule.AllowIncrementalScans,
-		rule.AllowSASTEmptyCode,
-		rule.ExcludeDirRegexpQueue,
-		rule.ForcedDoOwnSBOM,
-		rule.ActiveBlockingSCA,
-		rule.ID,
-	)
-	return err
+var scanRuleColumns = updateColumns[*common.ScanRule]{
+	"application_id":           func(r *common.ScanRule) any { return r.ApplicationID },
+	"team_id":                  func(r *common.ScanRule) any { return r.TeamID },
+	"organization_id":          func(r *common.ScanRule) any { return r.OrganizationID },
+	"sca_scan_enabled":         func(r *common.ScanRule) any { return r.SCAScanEnabled },
+	"sast_scan_enabled":        func(r *common.ScanRule) any { return r.SASTScanEnabled },
+	"allow_incremental_scans":  func(r *common.ScanRule) any { return r.AllowIncrementalScans },
+	"allow_sast_empty_code":    func(r *common.ScanRule) any { return r.AllowSASTEmptyCode },
+	"exclude_dir_regexp_queue": func(r *common.ScanRule) any { return r.ExcludeDirRegexpQueue },
+	"forced_do_own_sbom":       func(r *common.ScanRule) any { return r.ForcedDoOwnSBOM },
+	"active_blocking_sca":      func(r *common.ScanRule) any { return r.ActiveBlockingSCA },
+}
+
+// UpdateScanRule changes only the listed fields and returns the updated rule, or a common.ErrNotFound error if it does not exist.
+func (r *PgxRepository) UpdateScanRule(ctx context.Context, rule *common.ScanRule, fields []string) (*common.ScanRule, error) {
+	query, args, err := scanRuleColumns.updateRevision("scan_rules", `
+		id, application_id, team_id, organization_id,
+		sca_scan_enabled, sast_scan_enabled, allow_incremental_scans,
+		allow_sast_empty_code, exclude_dir_regexp_queue, forced_do_own_sbom,
+		active_blocking_sca, revision`, rule, rule.ID, rule.Revision, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := &common.ScanRule{}
+	err = r.inAuditedTx(ctx, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, query, args...).Scan(
+			&updated.ID,
+			&updated.ApplicationID,
+			&updated.TeamID,
+			&updated.OrganizationID,
+			&updated.SCAScanEnabled,
+			&updated.SASTScanEnabled,
+			&updated.AllowIncrementalScans,
+			&updated.AllowSASTEmptyCode,
+			&updated.ExcludeDirRegexpQueue,
+			&updated.ForcedDoOwnSBOM,
+			&updated.ActiveBlockingSCA,
+			&updated.Revision,
+		)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return r.notUpdated(ctx, "scan_rules", rule.ID, rule.Revision)
+			}
+			return dbError("scan_rule", fmt.Errorf("failed to update scan rule: %w", err))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
 }
 
-func (r *PgxRepository) DeleteScanRule(ctx context.Context, id int) error {
-	query := `DELETE FROM scan_rules WHERE id = $1`
-	_, err := r.pool.Exec(ctx, query, id)
-	return err
+// DeleteScanRule deletes the rule; its history is kept.
+func (r *PgxRepository) DeleteScanRule(ctx context.Context, id, revision int) error {
+	return r.inAuditedTx(ctx, func(tx pgx.Tx) error {
+		return r.deleteRevisionIn(ctx, tx, "scan_rules", id, revision)
+	})
 }
 
-func (r *PgxRepository) ListScanRules(ctx context.Context) ([]*common.ScanRule, error) {
+// ListScanRules returns a page of scan rules ordered by id and the total number of rules.
+func (r *PgxRepository) ListScanRules(ctx context.Context, page common.Page) ([]*common.ScanRule, int, error) {
+	q, err := newListQuery(scanRuleFields, page, "id")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := r.count(ctx, `SELECT COUNT(*) FROM scan_rules`+q.whereSQL(), q.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	q.after(page)
 	query := `SELECT 
 		id, application_id, team_id, organization_id,
 		sca_scan_enabled, sast_scan_enabled, allow_incremental_scans,
 		allow_sast_empty_code, exclude_dir_regexp_queue, forced_do_own_sbom,
-		active_blocking_sca
-	FROM scan_rules`
-
-	rows, err := r.pool.Query(ctx, query)
+		active_blocking_sca, revision
+	FROM scan_rules` + q.whereSQL() + q.tail(page)
+	rows, err := r.pool.Query(ctx, query, q.args...)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	defer rows.Close()
 
@@ -126,23 +171,27 @@ func (r *PgxRepository) ListScanRules(ctx context.Context) ([]*common.ScanRule,
 			&rule.ExcludeDirRegexpQueue,
 			&rule.ForcedDoOwnSBOM,
 			&rule.ActiveBlockingSCA,
+			&rule.Revision,
 		)
 		return &rule, err
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return rules, nil
+	return rules, total, nil
 }
 
-func (r *PgxRepository) GetScanRuleByComposite(ctx context.Context, appID, teamID, orgID int) (*common.ScanRule, error) {
+// GetScanRuleByComposite returns the rule defined exactly at the given scope; a nil team or
+// application selects a rule of a wider level. A scope has at most one rule: a second one is
+// rejected by a unique index with common.ErrAlreadyExists.
+func (r *PgxRepository) GetScanRuleByComposite(ctx context.Context, appID, teamID *int, orgID int) (*common.ScanRule, error) {
 	query := `SELECT 
 		id, application_id, team_id, organization_id,
 		sca_scan_enabled, sast_scan_enabled, allow_incremental_scans,
 		allow_sast_empty_code, exclude_dir_regexp_queue, forced_do_own_sbom,
-		active_blocking_sca
+		active_blocking_sca, revision
 	FROM scan_rules 
-	WHERE application_id = $1 AND team_id = $2 AND organization_id = $3`
+	WHERE application_id IS NOT DISTINCT FROM $1 AND team_id IS NOT DISTINCT FROM $2 AND organization_id = $3`
 
 	rule := &common.ScanRule{}
 	err := r.pool.QueryRow(ctx, query, appID, teamID, orgID).Scan(
@@ -157,12 +206,125 @@ func (r *PgxRepository) GetScanRuleByComposite(ctx context.Context, appID, teamI
 		&rule.ExcludeDirRegexpQueue,
 		&rule.ForcedDoOwnSBOM,
 		&rule.ActiveBlockingSCA,
+		&rule.Revision,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("scan_rule", fmt.Sprintf("application_id=%s,team_id=%s,organization_id=%d", nullableID(appID), nullableID(teamID), orgID))
 		}
 		return nil, err
 	}
 	return rule, nil
 }
+
+// GetEffectiveScanRule merges the organization, team and application rules of an application.
+// An application without any rule gets empty settings; a missing application is a common.ErrNotFound error.
+func (r *PgxRepository) GetEffectiveScanRule(ctx context.Context, appID int) (*common.EffectiveScanRule, error) {
+	return effectiveScanRule(ctx, r.pool, appID)
+}
+
+func effectiveScanRule(ctx context.Context, db executor, appID int) (*common.EffectiveScanRule, error) {
+	eff := &common.EffectiveScanRule{ApplicationID: appID}
+	err := db.QueryRow(ctx, `SELECT a.team_id, t.organization_id
+		FROM applications a JOIN teams t ON t.id = a.team_id
+		WHERE a.id = $1`, appID).Scan(&eff.TeamID, &eff.OrganizationID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NotFoundError("application", appID)
+		}
+		return nil, fmt.Errorf("failed to get application scope: %w", err)
+	}
+
+	// На каждом уровне берётся одно правило — с наименьшим id; уровни идут от организации к приложению
+	query := `SELECT DISTINCT ON (team_id IS NOT NULL, application_id IS NOT NULL)
+		id, application_id, team_id, organization_id,
+		sca_scan_enabled, sast_scan_enabled, allow_incremental_scans,
+		allow_sast_empty_code, exclude_dir_regexp_queue, forced_do_own_sbom,
+		active_blocking_sca, revision
+	FROM scan_rules
+	WHERE organization_id = $1
+	  AND (team_id IS NULL OR team_id = $2)
+	  AND (application_id IS NULL OR application_id = $3)
+	ORDER BY team_id IS NOT NULL, application_id IS NOT NULL, id`
+	rows, err := db.Query(ctx, query, eff.OrganizationID, eff.TeamID, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scan rule chain: %w", err)
+	}
+	defer rows.Close()
+
+	eff.Chain, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.ScanRule, error) {
+		var rule common.ScanRule
+		err := row.Scan(
+			&rule.ID,
+			&rule.ApplicationID,
+			&rule.TeamID,
+			&rule.OrganizationID,
+			&rule.SCAScanEnabled,
+			&rule.SASTScanEnabled,
+			&rule.AllowIncrementalScans,
+			&rule.AllowSASTEmptyCode,
+			&rule.ExcludeDirRegexpQueue,
+			&rule.ForcedDoOwnSBOM,
+			&rule.ActiveBlockingSCA,
+			&rule.Revision,
+		)
+		return &rule, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan scan rule chain: %w", err)
+	}
+
+	eff.Settings, eff.Sources = common.MergeScanRules(eff.Chain)
+	return eff, nil
+}
+
+// CheckScanRuleScope verifies that the application belongs to the team and the team belongs to
+// the organization; nil application or team denote a rule of a wider level. A mismatch is
+// reported as a common.ErrValidation error on the offending field.
+func (r *PgxRepository) CheckScanRuleScope(ctx context.Context, appID, teamID *int, orgID int) error {
+	if appID == nil && teamID == nil {
+		// Существование организации проверяет внешний ключ
+		return nil
+	}
+	if teamID == nil {
+		return common.ValidationError("team_id", "is required for an application rule")
+	}
+
+	var appTeamID, teamOrgID int
+	if appID != nil {
+		query := `SELECT a.team_id, t.organization_id
+		          FROM applications a JOIN teams t ON t.id = a.team_id
+		          WHERE a.id = $1`
+		err := r.pool.QueryRow(ctx, query, *appID).Scan(&appTeamID, &teamOrgID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return common.InvalidReferenceError("application_id", "application", *appID)
+			}
+			return fmt.Errorf("failed to check scan rule scope: %w", err)
+		}
+		if appTeamID != *teamID {
+			return common.ValidationError("team_id", fmt.Sprintf("application %d belongs to team %d, not %d", *appID, appTeamID, *teamID))
+		}
+	} else {
+		err := r.pool.QueryRow(ctx, `SELECT organization_id FROM teams WHERE id = $1`, *teamID).Scan(&teamOrgID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return common.InvalidReferenceError("team_id", "team", *teamID)
+			}
+			return fmt.Errorf("failed to check scan rule scope: %w", err)
+		}
+	}
+
+	if teamOrgID != orgID {
+		return common.ValidationError("organization_id", fmt.Sprintf("team %d belongs to organization %d, not %d", *teamID, teamOrgID, orgID))
+	}
+	return nil
+}
+
+// nullableID форматирует необязательный id для сообщений об ошибках
+func nullableID(id *int) string {
+	if id == nil {
+		return "null"
+	}
+	return strconv.Itoa(*id)
+}
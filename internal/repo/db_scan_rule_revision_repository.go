@@ -0,0 +1,201 @@
+package repo
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var _ IScanRuleRevisionRepository = (*PgxRepository)(nil)
+
+// scanRuleSnapshot — строка scan_rules в виде, который сохраняет триггер истории (to_jsonb)
+type scanRuleSnapshot struct {
+	ID                    int      `json:"id"`
+	ApplicationID         *int     `json:"application_id"`
+	TeamID                *int     `json:"team_id"`
+	OrganizationID        int      `json:"organization_id"`
+	SCAScanEnabled        *bool    `json:"sca_scan_enabled"`
+	SASTScanEnabled       *bool    `json:"sast_scan_enabled"`
+	AllowIncrementalScans *bool    `json:"allow_incremental_scans"`
+	AllowSASTEmptyCode    *bool    `json:"allow_sast_empty_code"`
+	ExcludeDirRegexpQueue []string `json:"exclude_dir_regexp_queue"`
+	ForcedDoOwnSBOM       *bool    `json:"forced_do_own_sbom"`
+	ActiveBlockingSCA     *bool    `json:"active_blocking_sca"`
+	Revision              int      `json:"revision"`
+}
+
+func (s *scanRuleSnapshot) rule() *common.ScanRule {
+	if s == nil {
+		return nil
+	}
+	return &common.ScanRule{
+		ID:                    s.ID,
+		ApplicationID:         s.ApplicationID,
+		TeamID:                s.TeamID,
+		OrganizationID:        s.OrganizationID,
+		SCAScanEnabled:        s.SCAScanEnabled,
+		SASTScanEnabled:       s.SASTScanEnabled,
+		AllowIncrementalScans: s.AllowIncrementalScans,
+		AllowSASTEmptyCode:    s.AllowSASTEmptyCode,
+		ExcludeDirRegexpQueue: s.ExcludeDirRegexpQueue,
+		ForcedDoOwnSBOM:       s.ForcedDoOwnSBOM,
+		ActiveBlockingSCA:     s.ActiveBlockingSCA,
+		Revision:              s.Revision,
+	}
+}
+
+const scanRuleRevisionColumns = `id, rule_id, operation, actor_id, restored_from, changed_at, before, after`
+
+func scanScanRuleRevision(row pgx.Row) (*common.ScanRuleRevision, error) {
+	var revision common.ScanRuleRevision
+	var before, after *scanRuleSnapshot
+	err := row.Scan(
+		&revision.ID,
+		&revision.RuleID,
+		&revision.Operation,
+		&revision.ActorID,
+		&revision.RestoredFrom,
+		&revision.ChangedAt,
+		&before,
+		&after,
+	)
+	if err != nil {
+		return nil, err
+	}
+	revision.Before = before.rule()
+	revision.After = after.rule()
+	return &revision, nil
+}
+
+// GetScanRuleRevision returns an entry of the scan rule history, or a common.ErrNotFound error.
+func (r *PgxRepository) GetScanRuleRevision(ctx context.Context, id int) (*common.ScanRuleRevision, error) {
+	query := `SELECT ` + scanRuleRevisionColumns + ` FROM scan_rule_revisions WHERE id = $1`
+	revision, err := scanScanRuleRevision(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NotFoundError("scan_rule_revision", id)
+		}
+		return nil, fmt.Errorf("failed to get scan rule revision: %w", err)
+	}
+	return revision, nil
+}
+
+// ListScanRuleRevisions returns a page of the history of a rule, oldest first, and the total
+// number of entries. The history of a deleted rule is kept.
+func (r *PgxRepository) ListScanRuleRevisions(ctx context.Context, ruleID int, page common.Page) ([]*common.ScanRuleRevision, int, error) {
+	q, err := newListQuery(scanRuleRevisionFields, page, "id")
+	if err != nil {
+		return nil, 0, err
+	}
+	q.where("rule_id = " + q.arg(ruleID))
+
+	total, err := r.count(ctx, `SELECT COUNT(*) FROM scan_rule_revisions`+q.whereSQL(), q.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	q.after(page)
+	query := `SELECT ` + scanRuleRevisionColumns + ` FROM scan_rule_revisions` + q.whereSQL() + q.tail(page)
+	rows, err := r.pool.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	revisions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.ScanRuleRevision, error) {
+		return scanScanRuleRevision(row)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return revisions, total, nil
+}
+
+// RestoreScanRuleRevision returns a rule to the state recorded after the given revision. A rule
+// that was deleted since is recreated with its former id. A non-zero revision must match the
+// current revision of the rule, otherwise common.ErrRevisionMismatch is returned.
+func (r *PgxRepository) RestoreScanRuleRevision(ctx context.Context, from *common.ScanRuleRevision, revision int) (*common.ScanRule, error) {
+	state := from.After
+	if state == nil {
+		return nil, common.ValidationError("revision_id", "the revision deletes the rule, restore an earlier revision")
+	}
+
+	restored := &common.ScanRule{}
+	err := r.inAuditedTx(ctx, func(tx pgx.Tx) error {
+		if err := setLocal(ctx, tx, restoredFromSetting, strconv.Itoa(from.ID)); err != nil {
+			return err
+		}
+
+		args := []any{
+			state.ApplicationID,
+			state.TeamID,
+			state.OrganizationID,
+			state.SCAScanEnabled,
+			state.SASTScanEnabled,
+			state.AllowIncrementalScans,
+			state.AllowSASTEmptyCode,
+			state.ExcludeDirRegexpQueue,
+			state.ForcedDoOwnSBOM,
+			state.ActiveBlockingSCA,
+			from.RuleID,
+		}
+		update := `UPDATE scan_rules SET
+			application_id = $1, team_id = $2, organization_id = $3,
+			sca_scan_enabled = $4, sast_scan_enabled = $5, allow_incremental_scans = $6,
+			allow_sast_empty_code = $7, exclude_dir_regexp_queue = $8, forced_do_own_sbom = $9,
+			active_blocking_sca = $10, revision = revision + 1
+		WHERE id = $11`
+		if revision != 0 {
+			args = append(args, revision)
+			update += ` AND revision = $12`
+		}
+		returning := ` RETURNING
+			id, application_id, team_id, organization_id,
+			sca_scan_enabled, sast_scan_enabled, allow_incremental_scans,
+			allow_sast_empty_code, exclude_dir_regexp_queue, forced_do_own_sbom,
+			active_blocking_sca, revision`
+
+		err := scanScanRule(tx.QueryRow(ctx, update+returning, args...), restored)
+		if err == nil || !errors.Is(err, pgx.ErrNoRows) {
+			return dbError("scan_rule", err)
+		}
+		if err := r.revisionConflict(ctx, "scan_rules", from.RuleID, revision); err != nil {
+			return err
+		}
+
+		// Правило удалено — создаём его заново с прежним id и продолжаем его ревизии
+		insert := `INSERT INTO scan_rules (
+			application_id, team_id, organization_id,
+			sca_scan_enabled, sast_scan_enabled, allow_incremental_scans,
+			allow_sast_empty_code, exclude_dir_regexp_queue, forced_do_own_sbom,
+			active_blocking_sca, id, revision
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+		args = append(args[:11], state.Revision+1)
+		return dbError("scan_rule", scanScanRule(tx.QueryRow(ctx, insert+returning, args...), restored))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+func scanScanRule(row pgx.Row, rule *common.ScanRule) error {
+	return row.Scan(
+		&rule.ID,
+		&rule.ApplicationID,
+		&rule.TeamID,
+		&rule.OrganizationID,
+		&rule.SCAScanEnabled,
+		&rule.SASTScanEnabled,
+		&rule.AllowIncrementalScans,
+		&rule.AllowSASTEmptyCode,
+		&rule.ExcludeDirRegexpQueue,
+		&rule.ForcedDoOwnSBOM,
+		&rule.ActiveBlockingSCA,
+		&rule.Revision,
+	)
+}
@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+var _ ISessionRepository = (*PgxRepository)(nil)
+
+const sessionColumns = `id, user_id, refresh_token_hash, user_agent, created_at, last_used_at, expires_at, revoked_at`
+
+func scanSession(row pgx.Row) (*common.Session, error) {
+	session := &common.Session{}
+	err := row.Scan(
+		&session.ID, &session.UserID, &session.RefreshTokenHash, &session.UserAgent,
+		&session.CreatedAt, &session.LastUsedAt, &session.ExpiresAt, &session.RevokedAt,
+	)
+	return session, err
+}
+
+// CreateSession stores a new session that expires after ttl.
+func (r *PgxRepository) CreateSession(ctx context.Context, session *common.Session, ttl time.Duration) error {
+	query := `INSERT INTO sessions (user_id, refresh_token_hash, user_agent, expires_at)
+	          VALUES ($1, $2, $3, NOW() + $4::interval)
+	          RETURNING id, created_at, last_used_at, expires_at`
+	return r.pool.QueryRow(ctx, query, session.UserID, session.RefreshTokenHash, session.UserAgent, ttl).
+		Scan(&session.ID, &session.CreatedAt, &session.LastUsedAt, &session.ExpiresAt)
+}
+
+func (r *PgxRepository) GetSessionByID(ctx context.Context, id int) (*common.Session, error) {
+	query := `SELECT ` + sessionColumns + ` FROM sessions WHERE id = $1`
+	session, err := scanSession(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return session, nil
+}
+
+func (r *PgxRepository) GetSessionByTokenHash(ctx context.Context, hash string) (*common.Session, error) {
+	query := `SELECT ` + sessionColumns + ` FROM sessions WHERE refresh_token_hash = $1`
+	session, err := scanSession(r.pool.QueryRow(ctx, query, hash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return session, nil
+}
+
+// RotateSessionToken replaces the refresh token of an active session and extends
+// it by ttl. It returns nil if the old token was already rotated, or the session
+// was revoked or has expired.
+func (r *PgxRepository) RotateSessionToken(ctx context.Context, oldHash, newHash string, ttl time.Duration) (*common.Session, error) {
+	query := `UPDATE sessions SET
+		refresh_token_hash = $2,
+		last_used_at = NOW(),
+		expires_at = NOW() + $3::interval
+		WHERE refresh_token_hash = $1 AND revoked_at IS NULL AND expires_at > NOW()
+		RETURNING ` + sessionColumns
+	session, err := scanSession(r.pool.QueryRow(ctx, query, oldHash, newHash, ttl))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to rotate session token: %w", err)
+	}
+	return session, nil
+}
+
+func (r *PgxRepository) RevokeSession(ctx context.Context, id int) error {
+	query := `UPDATE sessions SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL`
+	_, err := r.pool.Exec(ctx, query, id)
+	return err
+}
+
+func (r *PgxRepository) ListSessions(ctx context.Context, userID common.UserID, includeInactive bool) ([]*common.Session, error) {
+	query := `SELECT ` + sessionColumns + ` FROM sessions
+	          WHERE user_id = $1 AND ($2 OR (revoked_at IS NULL AND expires_at > NOW()))
+	          ORDER BY last_used_at DESC`
+	rows, err := r.pool.Query(ctx, query, userID, includeInactive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sessions: %w", err)
+	}
+	defer rows.Close()
+
+	sessions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.Session, error) {
+		return scanSession(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan sessions: %w", err)
+	}
+	return sessions, nil
+}
@@ -0,0 +1,79 @@
+package repo
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ ITeamMemberRepository = (*PgxRepository)(nil)
+
+// AddTeamMember adds a user to a team or changes the role of an existing member.
+func (r *PgxRepository) AddTeamMember(ctx context.Context, member *common.TeamMember) error {
+	if err := member.Role.Validate(); err != nil {
+		return err
+	}
+
+	query := `INSERT INTO team_members (team_id, user_id, role)
+	          VALUES ($1, $2, $3)
+	          ON CONFLICT (team_id, user_id) DO UPDATE SET role = EXCLUDED.role
+	          RETURNING created_at`
+	err := r.pool.QueryRow(ctx, query, member.TeamID, member.UserID, member.Role).Scan(&member.CreatedAt)
+	if err != nil {
+		return dbError("team_member", fmt.Errorf("failed to add team member: %w", err))
+	}
+	return nil
+}
+
+func (r *PgxRepository) RemoveTeamMember(ctx context.Context, teamID int, userID common.UserID) error {
+	query := `DELETE FROM team_members WHERE team_id = $1 AND user_id = $2`
+	_, err := r.pool.Exec(ctx, query, teamID, userID)
+	return err
+}
+
+func (r *PgxRepository) ListTeamMembers(ctx context.Context, teamID int) ([]*common.TeamMember, error) {
+	query := `SELECT team_id, user_id, role, created_at
+	          FROM team_members WHERE team_id = $1
+	          ORDER BY user_id`
+	rows, err := r.pool.Query(ctx, query, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.TeamMember, error) {
+		var member common.TeamMember
+		err := row.Scan(&member.TeamID, &member.UserID, &member.Role, &member.CreatedAt)
+		return &member, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// ListTeamsForUser returns the teams a user is a member of together with the member role.
+func (r *PgxRepository) ListTeamsForUser(ctx context.Context, userID common.UserID) ([]*common.TeamMembership, error) {
+	query := `SELECT t.id, t.team_name, t.owner_id, t.folder, t.organization_id, t.revision, tm.role
+	          FROM team_members tm
+	          JOIN teams t ON t.id = tm.team_id
+	          WHERE tm.user_id = $1
+	          ORDER BY t.id`
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	memberships, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.TeamMembership, error) {
+		membership := common.TeamMembership{Team: &common.Team{}}
+		err := row.Scan(&membership.Team.ID, &membership.Team.TeamName, &membership.Team.OwnerID,
+			&membership.Team.Folder, &membership.Team.OrganizationID, &membership.Team.Revision, &membership.Role)
+		return &membership, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
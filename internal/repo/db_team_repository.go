@@ -4,6 +4,7 @@ import (
 	"context"
 	"data_processor/internal/common"
 	"errors"
+	"fmt"
 	"github.com/jackc/pgx/v5"
 )
 
@@ -11,18 +12,18 @@ var _ ITeamRepository = (*PgxRepository)(nil)
 
 func (r *PgxRepository) CreateTeam(ctx context.Context, team *common.Team) error {
 	query := `INSERT INTO teams (team_name, owner_id, folder, organization_id) 
-	          VALUES ($1, $2, $3, $4) RETURNING id`
-	return r.pool.QueryRow(ctx, query, team.TeamName, team.OwnerID, team.Folder, team.OrganizationID).Scan(&team.ID)
+	          VALUES ($1, $2, $3, $4) RETURNING id, revision`
+	return dbError("team", r.pool.QueryRow(ctx, query, team.TeamName, team.OwnerID, team.Folder, team.OrganizationID).Scan(&team.ID, &team.Revision))
 }
 
 func (r *PgxRepository) GetTeamByID(ctx context.Context, id int) (*common.Team, error) {
-	query := `SELECT id, team_name, owner_id, folder, organization_id FROM teams WHERE id = $1`
+	query := `SELECT id, team_name, owner_id, folder, organization_id, revision FROM teams WHERE id = $1`
 	team := &common.Team{}
 	err := r.pool.QueryRow(ctx, query, id).Scan(
-		&team.ID, &team.TeamName, &team.OwnerID, &team.Folder, &team.OrganizationID)
+		&team.ID, &team.TeamName, &team.OwnerID, &team.Folder, &team.OrganizationID, &team.Revision)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("team", id)
 		}
 		return nil, err
 	}
@@ -30,58 +31,81 @@ func (r *PgxRepository) GetTeamByID(ctx context.Context, id int) (*common.Team,
 }
 
 func (r *PgxRepository) GetTeamByName(ctx context.Context, name string) (*common.Team, error) {
-	query := `SELECT id, team_name, owner_id, folder, organization_id FROM teams WHERE team_name = $1`
+	query := `SELECT id, team_name, owner_id, folder, organization_id, revision FROM teams WHERE team_name = $1`
 	team := &common.Team{}
 	err := r.pool.QueryRow(ctx, query, name).Scan(
-		&team.ID, &team.TeamName, &team.OwnerID, &team.Folder, &team.OrganizationID)
+		&team.ID, &team.TeamName, &team.OwnerID, &team.Folder, &team.OrganizationID, &team.Revision)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("team", name)
 		}
 		return nil, err
 	}
 	return team, nil
 }
 
-func (r *PgxRepository) UpdateTeam(ctx context.Context, team *common.Team) error {
-	query := `UPDATE teams SET 
-		team_name = $1, 
-		owner_id = $2, 
-		folder = $3, 
-		organization_id = $4 
-		WHERE id = $5`
-	_, err := r.pool.Exec(ctx, query,
-		team.TeamName, team.OwnerID, team.Folder, team.OrganizationID, team.ID)
-	return err
+var teamColumns = updateColumns[*common.Team]{
+	"team_name":       func(t *common.Team) any { return t.TeamName },
+	"owner_id":        func(t *common.Team) any { return t.OwnerID },
+	"folder":          func(t *common.Team) any { return t.Folder },
+	"organization_id": func(t *common.Team) any { return t.OrganizationID },
 }
 
-func (r *PgxRepository) DeleteTeam(ctx context.Context, id int) error {
-	query := `DELETE FROM teams WHERE id = $1`
-	_, err := r.pool.Exec(ctx, query, id)
-	return err
+// UpdateTeam changes only the listed fields and returns the updated team, or a common.ErrNotFound error if it does not exist.
+func (r *PgxRepository) UpdateTeam(ctx context.Context, team *common.Team, fields []string) (*common.Team, error) {
+	query, args, err := teamColumns.updateRevision("teams", "id, team_name, owner_id, folder, organization_id, revision", team, team.ID, team.Revision, fields)
+	if err != nil {
+		return nil, err
+	}
+	updated := &common.Team{}
+	err = r.pool.QueryRow(ctx, query, args...).Scan(
+		&updated.ID, &updated.TeamName, &updated.OwnerID, &updated.Folder, &updated.OrganizationID, &updated.Revision)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.notUpdated(ctx, "teams", team.ID, team.Revision)
+		}
+		return nil, dbError("team", fmt.Errorf("failed to update team: %w", err))
+	}
+	return updated, nil
+}
+
+func (r *PgxRepository) DeleteTeam(ctx context.Context, id, revision int) error {
+	return r.deleteRevision(ctx, "teams", id, revision)
 }
 
-func (r *PgxRepository) ListTeams(ctx context.Context) ([]*common.Team, error) {
-	query := `SELECT id, team_name, owner_id, folder, organization_id FROM teams`
-	rows, err := r.pool.Query(ctx, query)
+// ListTeams returns a page of teams ordered by id and the total number of teams.
+func (r *PgxRepository) ListTeams(ctx context.Context, page common.Page) ([]*common.Team, int, error) {
+	q, err := newListQuery(teamFields, page, "id")
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	total, err := r.count(ctx, `SELECT COUNT(*) FROM teams`+q.whereSQL(), q.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	q.after(page)
+	query := `SELECT id, team_name, owner_id, folder, organization_id, revision FROM teams` + q.whereSQL() + q.tail(page)
+	rows, err := r.pool.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, 0, err
 	}
 	defer rows.Close()
 
 	teams, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.Team, error) {
 		var team common.Team
-		err := row.Scan(&team.ID, &team.TeamName, &team.OwnerID, &team.Folder, &team.OrganizationID)
+		err := row.Scan(&team.ID, &team.TeamName, &team.OwnerID, &team.Folder, &team.OrganizationID, &team.Revision)
 		return &team, err
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return teams, nil
+	return teams, total, nil
 }
 
 func (r *PgxRepository) ListTeamsByOrganization(ctx context.Context, orgID int) ([]*common.Team, error) {
-	query := `SELECT id, team_name, owner_id, folder, organization_id 
+	query := `SELECT id, team_name, owner_id, folder, organization_id, revision 
 	          FROM teams WHERE organization_id = $1`
 	rows, err := r.pool.Query(ctx, query, orgID)
 	if err != nil {
@@ -91,7 +115,7 @@ func (r *PgxRepository) ListTeamsByOrganization(ctx context.Context, orgID int)
 
 	teams, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.Team, error) {
 		var team common.Team
-		err := row.Scan(&team.ID, &team.TeamName, &team.OwnerID, &team.Folder, &team.OrganizationID)
+		err := row.Scan(&team.ID, &team.TeamName, &team.OwnerID, &team.Folder, &team.OrganizationID, &team.Revision)
 		return &team, err
 	})
 	if err != nil {
@@ -101,7 +125,7 @@ func (r *PgxRepository) ListTeamsByOrganization(ctx context.Context, orgID int)
 }
 
 func (r *PgxRepository) ListTeamsByOwner(ctx context.Context, ownerID int) ([]*common.Team, error) {
-	query := `SELECT id, team_name, owner_id, folder, organization_id 
+	query := `SELECT id, team_name, owner_id, folder, organization_id, revision 
 	          FROM teams WHERE owner_id = $1`
 	rows, err := r.pool.Query(ctx, query, ownerID)
 	if err != nil {
@@ -111,7 +135,7 @@ func (r *PgxRepository) ListTeamsByOwner(ctx context.Context, ownerID int) ([]*c
 
 	teams, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.Team, error) {
 		var team common.Team
-		err := row.Scan(&team.ID, &team.TeamName, &team.OwnerID, &team.Folder, &team.OrganizationID)
+		err := row.Scan(&team.ID, &team.TeamName, &team.OwnerID, &team.Folder, &team.OrganizationID, &team.Revision)
 		return &team, err
 	})
 	if err != nil {
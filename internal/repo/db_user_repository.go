@@ -2,9 +2,12 @@ package repo
 
 import (
 	"context"
+	"data_processor/internal/auth"
 	"data_processor/internal/common"
 	"errors"
+	"fmt"
 	"github.com/jackc/pgx/v5"
+	"slices"
 )
 
 var _ IUserRepository = (*PgxRepository)(nil)
@@ -15,24 +18,33 @@ func (r *PgxRepository) GetUserID(ctx context.Context, user *common.User) (*comm
 	err := r.pool.QueryRow(ctx, query, user.Name).Scan(&userId)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("user", user.Name)
 		}
 		return nil, err
 	}
 	return &userId, nil
 }
 func (r *PgxRepository) CreateUser(ctx context.Context, user *common.User) error {
+	hash, err := auth.HashPassword(user.Password, r.passwordParams)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
 	query := `INSERT INTO users (name, password) VALUES ($1, $2) RETURNING id`
-	return r.pool.QueryRow(ctx, query, user.Name, user.Password).Scan(&user.ID)
+	if err := r.pool.QueryRow(ctx, query, user.Name, hash).Scan(&user.ID); err != nil {
+		return dbError("user", err)
+	}
+	user.PasswordHash = hash
+	return nil
 }
 
 func (r *PgxRepository) GetUserByID(ctx context.Context, id common.UserID) (*common.User, error) {
 	user := &common.User{}
-	query := `SELECT id, name, password FROM users WHERE id = $1`
-	err := r.pool.QueryRow(ctx, query, id).Scan(&user.ID, &user.Name, &user.Password)
+	query := `SELECT id, name, password, is_admin, is_worker FROM users WHERE id = $1`
+	err := r.pool.QueryRow(ctx, query, id).Scan(&user.ID, &user.Name, &user.PasswordHash, &user.IsAdmin, &user.IsWorker)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("user", id)
 		}
 		return nil, err
 	}
@@ -41,44 +53,168 @@ func (r *PgxRepository) GetUserByID(ctx context.Context, id common.UserID) (*com
 
 func (r *PgxRepository) GetUserByName(ctx context.Context, name string) (*common.User, error) {
 	user := &common.User{}
-	query := `SELECT id, name, password FROM users WHERE name = $1`
-	err := r.pool.QueryRow(ctx, query, name).Scan(&user.ID, &user.Name, &user.Password)
+	query := `SELECT id, name, password, is_admin, is_worker FROM users WHERE name = $1`
+	err := r.pool.QueryRow(ctx, query, name).Scan(&user.ID, &user.Name, &user.PasswordHash, &user.IsAdmin, &user.IsWorker)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("user", name)
 		}
 		return nil, err
 	}
 	return user, nil
 }
 
-func (r *PgxRepository) UpdateUser(ctx context.Context, user *common.User) error {
-	query := `UPDATE users SET name = $1, password = $2 WHERE id = $3`
-	_, err := r.pool.Exec(ctx, query, user.Name, user.Password, user.ID)
+// userColumns — поля пользователя, которые можно изменить через маску обновления
+var userColumns = updateColumns[*common.User]{
+	"name":      func(u *common.User) any { return u.Name },
+	"password":  func(u *common.User) any { return u.PasswordHash },
+	"is_admin":  func(u *common.User) any { return u.IsAdmin },
+	"is_worker": func(u *common.User) any { return u.IsWorker },
+}
+
+// UpdateUser changes only the listed fields in a single statement and returns the updated user,
+// or a common.ErrNotFound error if it does not exist. A listed password is hashed before it is stored.
+func (r *PgxRepository) UpdateUser(ctx context.Context, user *common.User, fields []string) (*common.User, error) {
+	if slices.Contains(fields, "password") {
+		hash, err := auth.HashPassword(user.Password, r.passwordParams)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash password: %w", err)
+		}
+		user.PasswordHash = hash
+	}
+
+	query, args, err := userColumns.update("users", "id, name, password, is_admin, is_worker", user, user.ID, fields)
+	if err != nil {
+		return nil, err
+	}
+	updated := &common.User{}
+	err = r.pool.QueryRow(ctx, query, args...).Scan(&updated.ID, &updated.Name, &updated.PasswordHash, &updated.IsAdmin, &updated.IsWorker)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NotFoundError("user", user.ID)
+		}
+		return nil, dbError("user", fmt.Errorf("failed to update user: %w", err))
+	}
+	return updated, nil
+}
+
+func (r *PgxRepository) SetUserAdmin(ctx context.Context, id common.UserID, isAdmin bool) error {
+	query := `UPDATE users SET is_admin = $1 WHERE id = $2`
+	_, err := r.pool.Exec(ctx, query, isAdmin, id)
 	return err
 }
 
 func (r *PgxRepository) DeleteUser(ctx context.Context, id common.UserID) error {
 	query := `DELETE FROM users WHERE id = $1`
 	_, err := r.pool.Exec(ctx, query, id)
-	return err
+	return dbError("user", err)
 }
 
-func (r *PgxRepository) ListUsers(ctx context.Context) ([]*common.User, error) {
-	query := `SELECT id, name, password FROM users`
-	rows, err := r.pool.Query(ctx, query)
+// ListUsers returns a page of users ordered by id and the total number of users.
+func (r *PgxRepository) ListUsers(ctx context.Context, page common.Page) ([]*common.User, int, error) {
+	q, err := newListQuery(userFields, page, "id")
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	total, err := r.count(ctx, `SELECT COUNT(*) FROM users`+q.whereSQL(), q.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	q.after(page)
+	query := `SELECT id, name, password, is_admin, is_worker FROM users` + q.whereSQL() + q.tail(page)
+	rows, err := r.pool.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, 0, err
 	}
 	defer rows.Close()
 
 	users, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*common.User, error) {
 		var user common.User
-		err := row.Scan(&user.ID, &user.Name, &user.Password)
+		err := row.Scan(&user.ID, &user.Name, &user.PasswordHash, &user.IsAdmin, &user.IsWorker)
 		return &user, err
 	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+// VerifyCredentials returns the user if the password matches, or nil if the user
+// does not exist or the password is wrong. Legacy plaintext rows and hashes made
+// with outdated parameters are rehashed transparently on success.
+func (r *PgxRepository) VerifyCredentials(ctx context.Context, name, password string) (*common.User, error) {
+	user, err := r.GetUserByName(ctx, name)
+	if common.IsNotFound(err) {
+		// Хешируем впустую, чтобы по времени ответа нельзя было понять, существует ли пользователь
+		_, _ = auth.HashPassword(password, r.passwordParams)
+		return nil, nil
+	}
 	if err != nil {
 		return nil, err
 	}
-	return users, nil
+
+	ok, needsRehash, err := auth.VerifyPassword(password, user.PasswordHash, r.passwordParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify password: %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	if needsRehash {
+		hash, err := auth.HashPassword(password, r.passwordParams)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash password: %w", err)
+		}
+		// Не перетираем пароль, если его успели сменить параллельно
+		_, err = r.pool.Exec(ctx, `UPDATE users SET password = $1 WHERE id = $2 AND password = $3`,
+			hash, user.ID, user.PasswordHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rehash password: %w", err)
+		}
+		user.PasswordHash = hash
+	}
+
+	return user, nil
+}
+
+// MigrateLegacyPasswords hashes every password still stored in plaintext and
+// returns the number of migrated rows. A value counts as plaintext unless it decodes
+// as an argon2id hash. It is safe to run repeatedly.
+func (r *PgxRepository) MigrateLegacyPasswords(ctx context.Context) (int, error) {
+	rows, err := r.pool.Query(ctx, `SELECT id, password FROM users`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query legacy passwords: %w", err)
+	}
+	legacy, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (common.User, error) {
+		var user common.User
+		err := row.Scan(&user.ID, &user.PasswordHash)
+		return user, err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to scan legacy passwords: %w", err)
+	}
+
+	migrated := 0
+	for _, user := range legacy {
+		// Пароль в открытом виде может начинаться с префикса argon2id, поэтому по префиксу
+		// в запросе отбирать нельзя
+		if auth.IsPasswordHash(user.PasswordHash) {
+			continue
+		}
+		hash, err := auth.HashPassword(user.PasswordHash, r.passwordParams)
+		if err != nil {
+			return migrated, fmt.Errorf("failed to hash password: %w", err)
+		}
+		tag, err := r.pool.Exec(ctx, `UPDATE users SET password = $1 WHERE id = $2 AND password = $3`,
+			hash, user.ID, user.PasswordHash)
+		if err != nil {
+			return migrated, fmt.Errorf("failed to migrate password of user %d: %w", user.ID, err)
+		}
+		migrated += int(tag.RowsAffected())
+	}
+
+	return migrated, nil
 }
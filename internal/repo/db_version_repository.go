@@ -4,6 +4,7 @@ import (
 	"context"
 	"data_processor/internal/common"
 	"errors"
+	"fmt"
 	"github.com/jackc/pgx/v5"
 )
 
@@ -11,7 +12,7 @@ var _ IVersionRepository = (*PgxRepository)(nil)
 
 func (r *PgxRepository) CreateVersion(ctx context.Context, version *common.Version) error {
 	query := `INSERT INTO versions (application_id, version) VALUES ($1, $2) RETURNING id`
-	return r.pool.QueryRow(ctx, query, version.ApplicationID, version.Version).Scan(&version.ID)
+	return dbError("version", r.pool.QueryRow(ctx, query, version.ApplicationID, version.Version).Scan(&version.ID))
 }
 
 func (r *PgxRepository) GetVersionByID(ctx context.Context, id int) (*common.Version, error) {
@@ -20,7 +21,7 @@ func (r *PgxRepository) GetVersionByID(ctx context.Context, id int) (*common.Ver
 	err := r.pool.QueryRow(ctx, query, id).Scan(&version.ID, &version.ApplicationID, &version.Version)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("version", id)
 		}
 		return nil, err
 	}
@@ -33,30 +34,59 @@ func (r *PgxRepository) GetVersionByNumber(ctx context.Context, appID int, versi
 	err := r.pool.QueryRow(ctx, query, appID, version).Scan(&ver.ID, &ver.ApplicationID, &ver.Version)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, common.NotFoundError("version", fmt.Sprintf("application_id=%d,version=%s", appID, version))
 		}
 		return nil, err
 	}
 	return ver, nil
 }
 
-func (r *PgxRepository) UpdateVersion(ctx context.Context, version *common.Version) error {
-	query := `UPDATE versions SET application_id = $1, version = $2 WHERE id = $3`
-	_, err := r.pool.Exec(ctx, query, version.ApplicationID, version.Version, version.ID)
-	return err
+var versionColumns = updateColumns[*common.Version]{
+	"application_id": func(v *common.Version) any { return v.ApplicationID },
+	"version":        func(v *common.Version) any { return v.Version },
+}
+
+// UpdateVersion changes only the listed fields and returns the updated version, or a common.ErrNotFound error if it does not exist.
+func (r *PgxRepository) UpdateVersion(ctx context.Context, version *common.Version, fields []string) (*common.Version, error) {
+	query, args, err := versionColumns.update("versions", "id, application_id, version", version, version.ID, fields)
+	if err != nil {
+		return nil, err
+	}
+	updated := &common.Version{}
+	err = r.pool.QueryRow(ctx, query, args...).Scan(&updated.ID, &updated.ApplicationID, &updated.Version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, common.NotFoundError("version", version.ID)
+		}
+		return nil, dbError("version", fmt.Errorf("failed to update version: %w", err))
+	}
+	return updated, nil
 }
 
 func (r *PgxRepository) DeleteVersion(ctx context.Context, id int) error {
 	query := `DELETE FROM versions WHERE id = $1`
 	_, err := r.pool.Exec(ctx, query, id)
-	return err
+	return dbError("version", err)
 }
 
-func (r *PgxRepository) ListVersions(ctx context.Context, appID int) ([]*common.Version, error) {
-	query := `SELECT id, application_id, version FROM versions WHERE application_id = $1`
-	rows, err := r.pool.Query(ctx, query, appID)
+// ListVersions returns a page of the application's versions ordered by id and their total number.
+func (r *PgxRepository) ListVersions(ctx context.Context, appID int, page common.Page) ([]*common.Version, int, error) {
+	q, err := newListQuery(versionFields, page, "id")
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+	q.where("application_id = " + q.arg(appID))
+
+	total, err := r.count(ctx, `SELECT COUNT(*) FROM versions`+q.whereSQL(), q.args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	q.after(page)
+	query := `SELECT id, application_id, version FROM versions` + q.whereSQL() + q.tail(page)
+	rows, err := r.pool.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, 0, err
 	}
 	defer rows.Close()
 
@@ -66,7 +96,7 @@ func (r *PgxRepository) ListVersions(ctx context.Context, appID int) ([]*common.
 		return &version, err
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return versions, nil
+	return versions, total, nil
 }
@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"data_processor/internal/common"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Коды ошибок PostgreSQL, которые переводятся в доменные ошибки
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+	pgCheckViolation      = "23514"
+)
+
+// resourceByTable — имя сущности для таблицы, на которую ссылается внешний ключ
+var resourceByTable = map[string]string{
+	"users":         "user",
+	"roles":         "role",
+	"permissions":   "permission",
+	"organizations": "organization",
+	"teams":         "team",
+	"applications":  "application",
+	"versions":      "version",
+	"scans":         "scan",
+	"scan_info":     "scan_info",
+	"findings":      "finding",
+	"components":    "component",
+	"scan_rules":    "scan_rule",
+}
+
+var (
+	// Key (organization_id)=(42) is not present in table "organizations".
+	pgKeyDetail = regexp.MustCompile(`^Key \((.+?)\)=\((.*?)\)`)
+	// ... is not present in table "organizations" / is still referenced from table "teams"
+	pgTableDetail = regexp.MustCompile(`table "([^"]+)"`)
+)
+
+// dbError переводит нарушения ограничений PostgreSQL в доменные ошибки common.Error;
+// resource — сущность, которую изменял запрос. Остальные ошибки (и nil) возвращаются как есть.
+func dbError(resource string, err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	column, value := pgKey(pgErr.Detail)
+	switch pgErr.Code {
+	case pgUniqueViolation:
+		msg := "already exists"
+		if column != "" {
+			msg = "with " + column + " " + value + " already exists"
+		}
+		return &common.Error{Kind: common.KindAlreadyExists, Resource: resource, Field: singleColumn(column), Msg: msg, Err: err}
+
+	case pgForeignKeyViolation:
+		table := pgTable(pgErr.Detail)
+		if strings.Contains(pgErr.Detail, "is still referenced") {
+			// Удаляемую строку ещё используют другие сущности
+			msg := "is still referenced"
+			if ref, ok := resourceByTable[table]; ok {
+				msg += " by " + ref
+			}
+			return &common.Error{Kind: common.KindConflict, Resource: resource, Msg: msg, Err: err}
+		}
+		target, ok := resourceByTable[table]
+		if !ok {
+			target = table
+		}
+		return &common.Error{Kind: common.KindInvalidReference, Field: singleColumn(column), Resource: target, ID: value,
+			Msg: "with id " + value + " does not exist", Err: err}
+
+	case pgCheckViolation:
+		field := pgErr.ColumnName
+		if field == "" {
+			// Имя ограничения по умолчанию — <table>_<column>_check
+			field = strings.TrimSuffix(strings.TrimPrefix(pgErr.ConstraintName, pgErr.TableName+"_"), "_check")
+		}
+		return &common.Error{Kind: common.KindValidation, Field: field, Msg: "value violates constraint " + pgErr.ConstraintName, Err: err}
+	}
+	return err
+}
+
+// pgKey разбирает столбцы и значения ключа из Detail ошибки ограничения
+func pgKey(detail string) (column, value string) {
+	m := pgKeyDetail.FindStringSubmatch(detail)
+	if m == nil {
+		return "", ""
+	}
+	return m[1], m[2]
+}
+
+func pgTable(detail string) string {
+	m := pgTableDetail.FindStringSubmatch(detail)
+	if m == nil {
+		return ""
+	}
+	return m[1]
+}
+
+// singleColumn: составной ключ не указывает на одно поле запроса
+func singleColumn(column string) string {
+	if strings.Contains(column, ",") {
+		return ""
+	}
+	return column
+}
@@ -3,6 +3,8 @@ package repo
 import (
 	"context"
 	"data_processor/internal/common"
+	"data_processor/internal/policy"
+	"time"
 )
 
 // UserRepository handles user operations
@@ -11,17 +13,20 @@ type IUserRepository interface {
 	CreateUser(ctx context.Context, user *common.User) error
 	GetUserByID(ctx context.Context, id common.UserID) (*common.User, error)
 	GetUserByName(ctx context.Context, name string) (*common.User, error)
-	UpdateUser(ctx context.Context, user *common.User) error
+	UpdateUser(ctx context.Context, user *common.User, fields []string) (*common.User, error)
+	SetUserAdmin(ctx context.Context, id common.UserID, isAdmin bool) error
 	DeleteUser(ctx context.Context, id common.UserID) error
-	ListUsers(ctx context.Context) ([]*common.User, error)
+	ListUsers(ctx context.Context, page common.Page) ([]*common.User, int, error)
+	VerifyCredentials(ctx context.Context, name, password string) (*common.User, error)
+	MigrateLegacyPasswords(ctx context.Context) (int, error)
 }
 
 // RoleRepository handles role operations
 type IRoleRepository interface {
 	CreateRole(ctx context.Context, role *common.Role) (*common.RoleWithPermissions, error)
-	UpdateRole(ctx context.Context, roleID int, name, description *string, isActive *bool) (*common.Role, error)
+	UpdateRole(ctx context.Context, role *common.Role, fields []string) (*common.Role, error)
 	GetRole(ctx context.Context, roleID int) (*common.RoleWithPermissions, error)
-	DeleteRole(ctx context.Context, roleID int) error
+	DeleteRole(ctx context.Context, roleID, revision int) error
 	GetRoleByName(ctx context.Context, name string) (*common.Role, error)
 	AddPermission(ctx context.Context, roleID int, permission *common.Permission) error
 	RemovePermission(ctx context.Context, roleID int, permissionID int) error
@@ -29,7 +34,7 @@ type IRoleRepository interface {
 	AssignRoleToUser(ctx context.Context, userID common.UserID, roleID int) error
 	RemoveRoleFromUser(ctx context.Context, userID common.UserID, roleID int) error
 	GetUserRoles(ctx context.Context, userID common.UserID) ([]*common.Role, error)
-	ListRoles(ctx context.Context) ([]*common.Role, error)
+	ListRoles(ctx context.Context, page common.Page) ([]*common.Role, int, error)
 }
 
 // PermissionRepository handles permission operations
@@ -37,11 +42,14 @@ type IPermissionRepository interface {
 	CreatePermission(ctx context.Context, permission *common.Permission) error
 	GetPermissionByID(ctx context.Context, id int) (*common.Permission, error)
 	GetPermissionByName(ctx context.Context, name string) (*common.Permission, error)
-	UpdatePermission(ctx context.Context, permission *common.Permission) error
-	DeletePermission(ctx context.Context, id int) error
-	ListPermissions(ctx context.Context) ([]*common.Permission, error)
+	UpdatePermission(ctx context.Context, permission *common.Permission, fields []string) (*common.Permission, error)
+	DeletePermission(ctx context.Context, id, revision int) error
+	ListPermissions(ctx context.Context, page common.Page) ([]*common.Permission, int, error)
 	GetTeamPermissions(ctx context.Context, userID common.UserID, teamID common.TeamID) ([]common.PermissionReadWrite, error)
 	GetOrganizationPermissions(ctx context.Context, userID common.UserID, orgID common.OrgID) ([]common.PermissionReadWrite, error)
+	ResolveResourceScope(ctx context.Context, ref common.ResourceRef) (*common.ResourceScope, error)
+	GetEffectivePermissions(ctx context.Context, userID common.UserID, ref common.ResourceRef) (*common.EffectivePermissions, error)
+	CheckPermissions(ctx context.Context, userID common.UserID, refs []common.ResourceRef) ([]common.PermissionReadWrite, error)
 }
 
 // OrganizationRepository handles organization operations
@@ -49,9 +57,9 @@ type IOrganizationRepository interface {
 	CreateOrganization(ctx context.Context, org *common.Organization) error
 	GetOrganizationByID(ctx context.Context, id int) (*common.Organization, error)
 	GetOrganizationByName(ctx context.Context, name string) (*common.Organization, error)
-	UpdateOrganization(ctx context.Context, org *common.Organization) error
-	DeleteOrganization(ctx context.Context, id int) error
-	ListOrganizations(ctx context.Context) ([]*common.Organization, error)
+	UpdateOrganization(ctx context.Context, org *common.Organization, fields []string) (*common.Organization, error)
+	DeleteOrganization(ctx context.Context, id, revision int) error
+	ListOrganizations(ctx context.Context, page common.Page) ([]*common.Organization, int, error)
 	ListOrganizationsByOwner(ctx context.Context, ownerID common.UserID) ([]*common.Organization, error)
 }
 
@@ -60,21 +68,29 @@ type ITeamRepository interface {
 	CreateTeam(ctx context.Context, team *common.Team) error
 	GetTeamByID(ctx context.Context, id int) (*common.Team, error)
 	GetTeamByName(ctx context.Context, name string) (*common.Team, error)
-	UpdateTeam(ctx context.Context, team *common.Team) error
-	DeleteTeam(ctx context.Context, id int) error
-	ListTeams(ctx context.Context) ([]*common.Team, error)
+	UpdateTeam(ctx context.Context, team *common.Team, fields []string) (*common.Team, error)
+	DeleteTeam(ctx context.Context, id, revision int) error
+	ListTeams(ctx context.Context, page common.Page) ([]*common.Team, int, error)
 	ListTeamsByOrganization(ctx context.Context, orgID int) ([]*common.Team, error)
 	ListTeamsByOwner(ctx context.Context, ownerID int) ([]*common.Team, error)
 }
 
+// TeamMemberRepository handles team membership
+type ITeamMemberRepository interface {
+	AddTeamMember(ctx context.Context, member *common.TeamMember) error
+	RemoveTeamMember(ctx context.Context, teamID int, userID common.UserID) error
+	ListTeamMembers(ctx context.Context, teamID int) ([]*common.TeamMember, error)
+	ListTeamsForUser(ctx context.Context, userID common.UserID) ([]*common.TeamMembership, error)
+}
+
 // ApplicationRepository handles application operations
 type IApplicationRepository interface {
 	CreateApplication(ctx context.Context, app *common.Application) error
 	GetApplicationByID(ctx context.Context, id int) (*common.Application, error)
 	GetApplicationByName(ctx context.Context, name string) (*common.Application, error)
-	UpdateApplication(ctx context.Context, app *common.Application) error
-	DeleteApplication(ctx context.Context, id int) error
-	ListApplications(ctx context.Context) ([]*common.Application, error)
+	UpdateApplication(ctx context.Context, app *common.Application, fields []string) (*common.Application, error)
+	DeleteApplication(ctx context.Context, id, revision int) error
+	ListApplications(ctx context.Context, page common.Page) ([]*common.Application, int, error)
 	ListApplicationsByTeam(ctx context.Context, teamID int) ([]*common.Application, error)
 }
 
@@ -83,18 +99,43 @@ type IVersionRepository interface {
 	CreateVersion(ctx context.Context, version *common.Version) error
 	GetVersionByID(ctx context.Context, id int) (*common.Version, error)
 	GetVersionByNumber(ctx context.Context, appID int, version string) (*common.Version, error)
-	UpdateVersion(ctx context.Context, version *common.Version) error
+	UpdateVersion(ctx context.Context, version *common.Version, fields []string) (*common.Version, error)
 	DeleteVersion(ctx context.Context, id int) error
-	ListVersions(ctx context.Context, appID int) ([]*common.Version, error)
+	ListVersions(ctx context.Context, appID int, page common.Page) ([]*common.Version, int, error)
 }
 
 // ScanRepository handles scan operations
 type IScanRepository interface {
 	CreateScan(ctx context.Context, scan *common.Scan) error
 	GetScanByID(ctx context.Context, id int) (*common.Scan, error)
-	UpdateScan(ctx context.Context, scan *common.Scan) error
+	UpdateScan(ctx context.Context, scan *common.Scan, fields []string) (*common.Scan, error)
+	TransitionScan(ctx context.Context, id int, to common.ScanStatus, workerID string, reason *string) (*common.Scan, error)
 	DeleteScan(ctx context.Context, id int) error
-	ListScans(ctx context.Context, versionID int) ([]*common.Scan, error)
+	ListScans(ctx context.Context, versionID int, page common.Page) ([]*common.Scan, int, error)
+	GetLatestScan(ctx context.Context, versionID int, scanType common.ScanType, status common.ScanStatus) (*common.Scan, error)
+}
+
+// ScanQueueRepository hands queued scans to workers
+type IScanQueueRepository interface {
+	LeaseScan(ctx context.Context, workerID string, types []common.ScanType, opts common.ScanQueueOptions) (*common.ScanLease, error)
+	HeartbeatScan(ctx context.Context, id int, workerID string, ttl time.Duration) (*common.Scan, error)
+	RequeueExpiredScans(ctx context.Context, opts common.ScanQueueOptions) (requeued, failed int, err error)
+	ReportScanProgress(ctx context.Context, id int, workerID string, progress common.ScanProgress, ttl time.Duration) (*common.Scan, error)
+	ListenScanChanges(ctx context.Context, fn func(scanID int)) error
+}
+
+// FindingRepository handles the findings of scans
+type IFindingRepository interface {
+	ReplaceFindings(ctx context.Context, scanID int, rules []*common.FindingRule, next func() (*common.Finding, error)) (int, error)
+	ListFindings(ctx context.Context, scanID int, minSeverity common.Severity, page common.Page) ([]*common.Finding, int, error)
+	ListFindingRules(ctx context.Context, scanID int) ([]*common.FindingRule, error)
+	WalkFindingsWithBaseline(ctx context.Context, scanID int, fn func(*common.Finding, common.BaselineState) error) error
+}
+
+// ComponentRepository handles the SBOM components of scans
+type IComponentRepository interface {
+	ReplaceComponents(ctx context.Context, scanID int, components []*common.Component) error
+	ListComponents(ctx context.Context, scanID int, page common.Page) ([]*common.Component, int, error)
 }
 
 // ScanInfoRepository handles scan info operations
@@ -102,7 +143,7 @@ type IScanInfoRepository interface {
 	CreateScanInfo(ctx context.Context, scanInfo *common.ScanInfo) error
 	GetScanInfoByID(ctx context.Context, id int) (*common.ScanInfo, error)
 	GetScanInfoByScanID(ctx context.Context, scanID int) (*common.ScanInfo, error)
-	UpdateScanInfo(ctx context.Context, scanInfo *common.ScanInfo) error
+	UpdateScanInfo(ctx context.Context, scanInfo *common.ScanInfo, fields []string) (*common.ScanInfo, error)
 	DeleteScanInfo(ctx context.Context, id int) error
 }
 
@@ -110,8 +151,33 @@ type IScanInfoRepository interface {
 type IScanRuleRepository interface {
 	CreateScanRule(ctx context.Context, rule *common.ScanRule) error
 	GetScanRuleByID(ctx context.Context, id int) (*common.ScanRule, error)
-	UpdateScanRule(ctx context.Context, rule *common.ScanRule) error
-	DeleteScanRule(ctx context.Context, id int) error
-	ListScanRules(ctx context.Context) ([]*common.ScanRule, error)
-	GetScanRuleByComposite(ctx context.Context, appID, teamID, orgID int) (*common.ScanRule, error)
+	UpdateScanRule(ctx context.Context, rule *common.ScanRule, fields []string) (*common.ScanRule, error)
+	DeleteScanRule(ctx context.Context, id, revision int) error
+	ListScanRules(ctx context.Context, page common.Page) ([]*common.ScanRule, int, error)
+	GetScanRuleByComposite(ctx context.Context, appID, teamID *int, orgID int) (*common.ScanRule, error)
+	GetEffectiveScanRule(ctx context.Context, appID int) (*common.EffectiveScanRule, error)
+	CheckScanRuleScope(ctx context.Context, appID, teamID *int, orgID int) error
+}
+
+// ScanRuleRevisionRepository handles the scan rule history
+type IScanRuleRevisionRepository interface {
+	GetScanRuleRevision(ctx context.Context, id int) (*common.ScanRuleRevision, error)
+	ListScanRuleRevisions(ctx context.Context, ruleID int, page common.Page) ([]*common.ScanRuleRevision, int, error)
+	RestoreScanRuleRevision(ctx context.Context, from *common.ScanRuleRevision, revision int) (*common.ScanRule, error)
+}
+
+// ScanRulePolicyRepository exports and applies scan rule documents
+type IScanRulePolicyRepository interface {
+	GetScanRulePolicyState(ctx context.Context, orgIDs []int) (*policy.State, error)
+	ApplyScanRulePolicy(ctx context.Context, doc *policy.Document, dryRun bool) ([]policy.Change, error)
+}
+
+// SessionRepository handles login sessions and their refresh tokens
+type ISessionRepository interface {
+	CreateSession(ctx context.Context, session *common.Session, ttl time.Duration) error
+	GetSessionByID(ctx context.Context, id int) (*common.Session, error)
+	GetSessionByTokenHash(ctx context.Context, hash string) (*common.Session, error)
+	RotateSessionToken(ctx context.Context, oldHash, newHash string, ttl time.Duration) (*common.Session, error)
+	RevokeSession(ctx context.Context, id int) error
+	ListSessions(ctx context.Context, userID common.UserID, includeInactive bool) ([]*common.Session, error)
 }
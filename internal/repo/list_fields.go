@@ -0,0 +1,117 @@
+package repo
+
+import "data_processor/internal/filter"
+
+// Поля, по которым списки можно фильтровать и сортировать. Чего нет в схеме (например,
+// users.password), в filter/order_by недоступно.
+
+var userFields = filter.Schema{
+	"id":        {Column: "id", Type: filter.Int},
+	"name":      {Column: "name", Type: filter.String},
+	"is_admin":  {Column: "is_admin", Type: filter.Bool},
+	"is_worker": {Column: "is_worker", Type: filter.Bool},
+}
+
+var organizationFields = filter.Schema{
+	"id":           {Column: "id", Type: filter.Int},
+	"project_name": {Column: "project_name", Type: filter.String},
+	"owner_id":     {Column: "owner_id", Type: filter.Int},
+}
+
+var teamFields = filter.Schema{
+	"id":              {Column: "id", Type: filter.Int},
+	"team_name":       {Column: "team_name", Type: filter.String},
+	"owner_id":        {Column: "owner_id", Type: filter.Int},
+	"folder":          {Column: "folder", Type: filter.String},
+	"organization_id": {Column: "organization_id", Type: filter.Int},
+}
+
+var applicationFields = filter.Schema{
+	"id":          {Column: "id", Type: filter.Int},
+	"name":        {Column: "name", Type: filter.String},
+	"description": {Column: "description", Type: filter.String},
+	"team_id":     {Column: "team_id", Type: filter.Int},
+}
+
+var versionFields = filter.Schema{
+	"id":             {Column: "id", Type: filter.Int},
+	"application_id": {Column: "application_id", Type: filter.Int},
+	"version":        {Column: "version", Type: filter.String},
+}
+
+var scanFields = filter.Schema{
+	"id":           {Column: "id", Type: filter.Int},
+	"scan_date":    {Column: "scan_date", Type: filter.Timestamp},
+	"version_id":   {Column: "version_id", Type: filter.Int},
+	"status":       {Column: "status", Type: filter.String},
+	"scan_type":    {Column: "scan_type", Type: filter.String},
+	"initiator_id": {Column: "initiator_id", Type: filter.Int},
+	"started_at":   {Column: "started_at", Type: filter.Timestamp},
+	"finished_at":  {Column: "finished_at", Type: filter.Timestamp},
+}
+
+var findingFields = filter.Schema{
+	"id":          {Column: "id", Type: filter.Int},
+	"tool":        {Column: "tool", Type: filter.String},
+	"rule_id":     {Column: "rule_id", Type: filter.String},
+	"severity":    {Column: "severity", Type: filter.String},
+	"cwe":         {Column: "cwe", Type: filter.Int},
+	"file":        {Column: "file", Type: filter.String},
+	"start_line":  {Column: "start_line", Type: filter.Int},
+	"end_line":    {Column: "end_line", Type: filter.Int},
+	"fingerprint": {Column: "fingerprint", Type: filter.String},
+}
+
+// Лицензии компонента сравниваются одной строкой, так что licenses: "GPL" находит любую из них
+var componentFields = filter.Schema{
+	"id":         {Column: "id", Type: filter.Int},
+	"ref":        {Column: "ref", Type: filter.String},
+	"is_primary": {Column: "is_primary", Type: filter.Bool},
+	"name":       {Column: "name", Type: filter.String},
+	"version":    {Column: "version", Type: filter.String},
+	"purl":       {Column: "purl", Type: filter.String},
+	"licenses":   {Column: "array_to_string(licenses, ' ')", Type: filter.String},
+}
+
+var permissionFields = filter.Schema{
+	"id":              {Column: "p.id", Type: filter.Int},
+	"name":            {Column: "p.name", Type: filter.String},
+	"description":     {Column: "p.description", Type: filter.String},
+	"read":            {Column: "p.read", Type: filter.Bool},
+	"write":           {Column: "p.write", Type: filter.Bool},
+	"created_at":      {Column: "p.created_at", Type: filter.Timestamp},
+	"updated_at":      {Column: "p.updated_at", Type: filter.Timestamp},
+	"organization_id": {Column: "rpo.organisation_id", Type: filter.Int},
+	"team_id":         {Column: "rpt.team_id", Type: filter.Int},
+}
+
+var roleFields = filter.Schema{
+	"id":          {Column: "id", Type: filter.Int},
+	"name":        {Column: "name", Type: filter.String},
+	"description": {Column: "description", Type: filter.String},
+	"is_active":   {Column: "is_active", Type: filter.Bool},
+	"owner_id":    {Column: "owner_id", Type: filter.Int},
+	"created_at":  {Column: "created_at", Type: filter.Timestamp},
+	"updated_at":  {Column: "updated_at", Type: filter.Timestamp},
+}
+
+var scanRuleFields = filter.Schema{
+	"id":                      {Column: "id", Type: filter.Int},
+	"application_id":          {Column: "application_id", Type: filter.Int},
+	"team_id":                 {Column: "team_id", Type: filter.Int},
+	"organization_id":         {Column: "organization_id", Type: filter.Int},
+	"sca_scan_enabled":        {Column: "sca_scan_enabled", Type: filter.Bool},
+	"sast_scan_enabled":       {Column: "sast_scan_enabled", Type: filter.Bool},
+	"allow_incremental_scans": {Column: "allow_incremental_scans", Type: filter.Bool},
+	"allow_sast_empty_code":   {Column: "allow_sast_empty_code", Type: filter.Bool},
+	"forced_do_own_sbom":      {Column: "forced_do_own_sbom", Type: filter.Bool},
+	"active_blocking_sca":     {Column: "active_blocking_sca", Type: filter.Bool},
+}
+
+var scanRuleRevisionFields = filter.Schema{
+	"id":            {Column: "id", Type: filter.Int},
+	"operation":     {Column: "operation", Type: filter.String},
+	"actor_id":      {Column: "actor_id", Type: filter.Int},
+	"restored_from": {Column: "restored_from", Type: filter.Int},
+	"changed_at":    {Column: "changed_at", Type: filter.Timestamp},
+}
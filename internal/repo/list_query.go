@@ -0,0 +1,81 @@
+package repo
+
+import (
+	"data_processor/internal/common"
+	"data_processor/internal/filter"
+	"fmt"
+	"strings"
+)
+
+// listQuery собирает WHERE, ORDER BY и LIMIT/OFFSET постраничного списка.
+// Значения из запроса никогда не подставляются в текст SQL, только параметрами.
+type listQuery struct {
+	conds []string
+	args  []any
+	order string
+	// key — столбцы порядка по умолчанию, по ним же работает keyset-пагинация
+	key []string
+}
+
+// newListQuery компилирует фильтр и сортировку страницы. key — порядок по умолчанию,
+// его последний столбец (уникальный id) дополняет пользовательскую сортировку.
+func newListQuery(fields filter.Schema, page common.Page, key ...string) (*listQuery, error) {
+	q := &listQuery{key: key, order: strings.Join(key, ", ")}
+
+	cond, err := filter.Compile(page.Filter, fields, q.arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	if cond != "" {
+		q.where(cond)
+	}
+
+	if page.OrderBy != "" {
+		q.order, err = filter.OrderBy(page.OrderBy, fields, key[len(key)-1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid order_by: %w", err)
+		}
+	}
+	return q, nil
+}
+
+func (q *listQuery) arg(value any) string {
+	q.args = append(q.args, value)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+func (q *listQuery) where(cond string) {
+	q.conds = append(q.conds, cond)
+}
+
+func (q *listQuery) whereSQL() string {
+	if len(q.conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(q.conds, " AND ")
+}
+
+// after добавляет условие keyset-пагинации. Вызывается после подсчёта total_count,
+// чтобы количество не зависело от позиции страницы.
+func (q *listQuery) after(page common.Page) {
+	if page.After == nil || page.OrderBy != "" {
+		return
+	}
+	if len(q.key) == 1 {
+		q.where(q.key[0] + " > " + q.arg(page.After.ID))
+		return
+	}
+	q.where("(" + strings.Join(q.key, ", ") + ") > (" + q.arg(page.After.Time) + ", " + q.arg(page.After.ID) + ")")
+}
+
+// tail возвращает ORDER BY и LIMIT/OFFSET; нулевой Limit — без ограничения
+func (q *listQuery) tail(page common.Page) string {
+	tail := " ORDER BY " + q.order
+	if page.Limit > 0 {
+		tail += " LIMIT " + q.arg(page.Limit)
+	}
+	if page.Offset > 0 {
+		tail += " OFFSET " + q.arg(page.Offset)
+	}
+	return tail
+}
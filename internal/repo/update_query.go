@@ -0,0 +1,113 @@
+package repo
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// updateColumns — столбцы сущности, которые можно изменить через маску обновления, и значения
+// этих столбцов в модели. Имя поля маски совпадает с именем столбца.
+type updateColumns[T any] map[string]func(T) any
+
+// update строит один запрос UPDATE table SET ... WHERE id = $n RETURNING returning, который
+// меняет только перечисленные поля. always — присваивания, добавляемые к любому обновлению
+// (например, updated_at = NOW()).
+func (c updateColumns[T]) update(table, returning string, item T, id any, fields []string, always ...string) (string, []any, error) {
+	return c.build(table, returning, item, id, 0, fields, always)
+}
+
+// updateRevision — update для таблиц со столбцом revision: каждое обновление увеличивает ревизию,
+// а ненулевая revision добавляет условие, что строка с тех пор не менялась.
+func (c updateColumns[T]) updateRevision(table, returning string, item T, id any, revision int, fields []string, always ...string) (string, []any, error) {
+	return c.build(table, returning, item, id, revision, fields, append(always, "revision = revision + 1"))
+}
+
+func (c updateColumns[T]) build(table, returning string, item T, id any, revision int, fields []string, always []string) (string, []any, error) {
+	if len(fields) == 0 {
+		return "", nil, errors.New("no fields to update")
+	}
+
+	// Порядок столбцов не зависит от порядка полей в маске: одинаковые маски дают одинаковый SQL
+	sorted := append([]string(nil), fields...)
+	sort.Strings(sorted)
+
+	var assignments []string
+	var args []any
+	for i, field := range sorted {
+		if i > 0 && field == sorted[i-1] {
+			continue
+		}
+		value, ok := c[field]
+		if !ok {
+			return "", nil, fmt.Errorf("field %q cannot be updated", field)
+		}
+		args = append(args, value(item))
+		assignments = append(assignments, fmt.Sprintf("%s = $%d", field, len(args)))
+	}
+	assignments = append(assignments, always...)
+
+	args = append(args, id)
+	where := fmt.Sprintf("id = $%d", len(args))
+	if revision != 0 {
+		args = append(args, revision)
+		where += fmt.Sprintf(" AND revision = $%d", len(args))
+	}
+
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE %s RETURNING %s",
+		table, strings.Join(assignments, ", "), where, returning)
+	return query, args, nil
+}
+
+// revisionConflict вызывается, когда условное изменение не затронуло ни одной строки: если строка
+// существует, значит, её ревизия уже другая. Для отсутствующей строки возвращает nil.
+func (r *PgxRepository) revisionConflict(ctx context.Context, table string, id, revision int) error {
+	if revision == 0 {
+		return nil
+	}
+	var exists bool
+	query := `SELECT EXISTS(SELECT 1 FROM ` + table + ` WHERE id = $1)`
+	if err := r.pool.QueryRow(ctx, query, id).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check %s revision: %w", table, err)
+	}
+	if exists {
+		return common.ErrRevisionMismatch
+	}
+	return nil
+}
+
+// notUpdated объясняет, почему UPDATE ... RETURNING не вернул строку: строки нет или её ревизия
+// уже другая
+func (r *PgxRepository) notUpdated(ctx context.Context, table string, id, revision int) error {
+	if err := r.revisionConflict(ctx, table, id, revision); err != nil {
+		return err
+	}
+	return common.NotFoundError(resourceByTable[table], id)
+}
+
+// deleteRevision удаляет строку; ненулевая revision удаляет её, только если строка не менялась
+func (r *PgxRepository) deleteRevision(ctx context.Context, table string, id, revision int) error {
+	return r.deleteRevisionIn(ctx, r.pool, table, id, revision)
+}
+
+// deleteRevisionIn — deleteRevision через заданный пул или транзакцию
+func (r *PgxRepository) deleteRevisionIn(ctx context.Context, db executor, table string, id, revision int) error {
+	query := `DELETE FROM ` + table + ` WHERE id = $1`
+	args := []any{id}
+	if revision != 0 {
+		query += ` AND revision = $2`
+		args = append(args, revision)
+	}
+
+	tag, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return dbError(resourceByTable[table], err)
+	}
+	if tag.RowsAffected() == 0 {
+		return r.revisionConflict(ctx, table, id, revision)
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package sarif
+
+import (
+	"data_processor/internal/common"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// Exporter writes findings as a log with a run per tool. Results are written as findings come,
+// so a log of any size is exported in constant memory; the findings of a tool must come
+// together. Close completes the log.
+type Exporter struct {
+	w     io.Writer
+	rules map[string][]*common.FindingRule
+	// Правила текущего запуска и их индексы по идентификатору
+	runRules map[string]int
+	tool     string
+	inRun    bool
+	results  int
+	started  bool
+	err      error
+}
+
+// NewExporter returns an exporter writing to w. rules describe the rules of the findings; a
+// finding of a rule missing from them refers to the rule by its id only.
+func NewExporter(w io.Writer, rules []*common.FindingRule) *Exporter {
+	e := &Exporter{w: w, rules: map[string][]*common.FindingRule{}}
+	for _, rule := range rules {
+		e.rules[rule.Tool] = append(e.rules[rule.Tool], rule)
+	}
+	return e
+}
+
+// Write adds the finding to the run of its tool. state is the baseline state of the result and
+// is left out when empty.
+func (e *Exporter) Write(f *common.Finding, state common.BaselineState) error {
+	if !e.inRun || f.Tool != e.tool {
+		e.startRun(f)
+	}
+	if e.results > 0 {
+		e.write([]byte(","))
+	}
+	e.results++
+	e.writeJSON(e.result(f, state))
+	return e.err
+}
+
+// Close writes the end of the log. It does not close the underlying writer.
+func (e *Exporter) Close() error {
+	e.start()
+	e.endRun()
+	e.write([]byte("]}\n"))
+	return e.err
+}
+
+func (e *Exporter) start() {
+	if e.started {
+		return
+	}
+	e.started = true
+	e.write([]byte(`{"$schema":"` + Schema + `","version":"` + Version + `","runs":[`))
+}
+
+func (e *Exporter) startRun(f *common.Finding) {
+	e.start()
+	if e.inRun {
+		e.endRun()
+		e.write([]byte(","))
+	}
+	e.inRun, e.tool, e.results = true, f.Tool, 0
+
+	// Версия инструмента берётся из первой находки: находки прошлого скана идут последними
+	driver := ToolComponent{Name: f.Tool}
+	if f.ToolVersion != nil {
+		driver.Version = *f.ToolVersion
+	}
+	e.runRules = map[string]int{}
+	for i, rule := range e.rules[f.Tool] {
+		e.runRules[rule.RuleID] = i
+		driver.Rules = append(driver.Rules, exportRule(rule))
+	}
+
+	e.write([]byte(`{"tool":`))
+	e.writeJSON(Tool{Driver: driver})
+	e.write([]byte(`,"results":[`))
+}
+
+func (e *Exporter) endRun() {
+	if e.inRun {
+		e.write([]byte("]}"))
+		e.inRun = false
+	}
+}
+
+func (e *Exporter) write(p []byte) {
+	if e.err == nil {
+		_, e.err = e.w.Write(p)
+	}
+}
+
+func (e *Exporter) writeJSON(v any) {
+	data, err := json.Marshal(v)
+	if err != nil && e.err == nil {
+		e.err = fmt.Errorf("failed to encode SARIF: %w", err)
+	}
+	e.write(data)
+}
+
+func exportRule(rule *common.FindingRule) ReportingDescriptor {
+	descriptor := ReportingDescriptor{
+		ID:               rule.RuleID,
+		Name:             rule.Name,
+		ShortDescription: exportMessageString(rule.ShortDescription),
+		FullDescription:  exportMessageString(rule.FullDescription),
+		Help:             exportMessageString(rule.Help),
+		HelpURI:          rule.HelpURI,
+	}
+	if len(rule.Tags) > 0 {
+		descriptor.Properties = &PropertyBag{Tags: rule.Tags}
+	}
+	return descriptor
+}
+
+func exportMessageString(text string) *MultiformatMessageString {
+	if text == "" {
+		return nil
+	}
+	return &MultiformatMessageString{Text: text}
+}
+
+func (e *Exporter) result(f *common.Finding, state common.BaselineState) *Result {
+	result := &Result{
+		RuleID:              f.RuleID,
+		Level:               exportLevel[f.Severity],
+		Message:             Message{Text: f.Message},
+		Fingerprints:        map[string]string{FingerprintKey: f.Fingerprint},
+		PartialFingerprints: f.PartialFingerprints,
+		BaselineState:       string(state),
+		Properties:          &PropertyBag{SecuritySeverity: exportScore[f.Severity]},
+	}
+	if i, ok := e.runRules[f.RuleID]; ok {
+		result.RuleIndex = &i
+	}
+	if f.CWE != nil {
+		result.Properties.Tags = []string{fmt.Sprintf("external/cwe/cwe-%03d", *f.CWE)}
+	}
+	if f.File != nil {
+		location := &PhysicalLocation{ArtifactLocation: &ArtifactLocation{URI: fileURI(*f.File)}}
+		if f.StartLine != nil {
+			location.Region = &Region{StartLine: f.StartLine, EndLine: f.EndLine}
+		}
+		result.Locations = []Location{{PhysicalLocation: location}}
+	}
+	if s := f.Suppression; s != nil {
+		result.Suppressions = []Suppression{{
+			Kind:          exportSuppressionKind[s.Kind],
+			Status:        exportSuppressionStatus[s.Status],
+			Justification: s.Justification,
+		}}
+	}
+	return result
+}
+
+// exportLevel и exportScore выбраны так, чтобы при импорте получалась та же важность
+var exportLevel = map[common.Severity]string{
+	common.SeverityCritical: LevelError,
+	common.SeverityHigh:     LevelError,
+	common.SeverityMedium:   LevelWarning,
+	common.SeverityLow:      LevelNote,
+	common.SeverityInfo:     LevelNone,
+}
+
+var exportScore = map[common.Severity]Score{
+	common.SeverityCritical: "9.0",
+	common.SeverityHigh:     "7.0",
+	common.SeverityMedium:   "4.0",
+	common.SeverityLow:      "0.1",
+	common.SeverityInfo:     "0.0",
+}
+
+var exportSuppressionKind = map[common.SuppressionKind]string{
+	common.SuppressionInSource: SuppressionInSource,
+	common.SuppressionExternal: SuppressionExternal,
+}
+
+var exportSuppressionStatus = map[common.SuppressionStatus]string{
+	common.SuppressionAccepted:    SuppressionAccepted,
+	common.SuppressionUnderReview: SuppressionUnderReview,
+	common.SuppressionRejected:    SuppressionRejected,
+}
+
+// fileURI переводит путь в URI: относительный путь остаётся относительной ссылкой, абсолютный
+// получает схему file
+func fileURI(file string) string {
+	if windowsDrive(file) {
+		file = "/" + file
+	}
+	uri := &url.URL{Path: file}
+	if strings.HasPrefix(file, "/") {
+		uri.Scheme = "file"
+	}
+	return uri.String()
+}
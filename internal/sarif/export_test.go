@@ -0,0 +1,81 @@
+package sarif
+
+import (
+	"bytes"
+	"data_processor/internal/common"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportRoundTrip(t *testing.T) {
+	var findings []*common.Finding
+	var rules []*common.FindingRule
+	for _, name := range []string{"codeql.sarif", "semgrep.sarif", "gosec.sarif", "eslint.sarif"} {
+		f, r := readFixture(t, name)
+		findings, rules = append(findings, f...), append(rules, r...)
+	}
+
+	var buf bytes.Buffer
+	exporter := NewExporter(&buf, rules)
+	states := []common.BaselineState{common.BaselineNew, common.BaselineUnchanged, common.BaselineAbsent}
+	for i, f := range findings {
+		require.NoError(t, exporter.Write(f, states[i%len(states)]))
+	}
+	require.NoError(t, exporter.Close())
+
+	log, err := Parse(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, Schema, log.Schema)
+	require.Len(t, log.Runs, 4)
+	assert.Equal(t, "gosec", log.Runs[2].Tool.Driver.Name)
+	assert.Equal(t, "2.18.2", log.Runs[2].Tool.Driver.Version)
+	assert.Len(t, log.Runs[2].Tool.Driver.Rules, 3)
+	assert.Equal(t, SuppressionAccepted, log.Runs[2].Results[2].Suppressions[0].Status)
+
+	imported, importedRules, err := log.Findings()
+	require.NoError(t, err)
+	assert.Equal(t, findings, imported)
+	assert.Equal(t, rules, importedRules)
+	var i int
+	for _, run := range log.Runs {
+		for _, result := range run.Results {
+			assert.Equal(t, string(states[i%len(states)]), result.BaselineState)
+			i++
+		}
+	}
+}
+
+func TestExportUnknownRule(t *testing.T) {
+	finding := &common.Finding{Tool: "scanner", RuleID: "R1", Severity: common.SeverityCritical, Message: "m", Fingerprint: "fp"}
+
+	var buf bytes.Buffer
+	exporter := NewExporter(&buf, []*common.FindingRule{{Tool: "other", RuleID: "R1"}})
+	require.NoError(t, exporter.Write(finding, ""))
+	require.NoError(t, exporter.Close())
+
+	log, err := Parse(&buf)
+	require.NoError(t, err)
+	run := log.Runs[0]
+	assert.Empty(t, run.Tool.Driver.Rules)
+	assert.Empty(t, run.Tool.Driver.Version)
+	assert.Nil(t, run.Results[0].RuleIndex)
+	assert.Empty(t, run.Results[0].BaselineState)
+	assert.Empty(t, run.Results[0].Locations)
+
+	imported, _, err := log.Findings()
+	require.NoError(t, err)
+	assert.Equal(t, []*common.Finding{finding}, imported)
+}
+
+func TestExportEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, NewExporter(&buf, nil).Close())
+	assert.True(t, json.Valid(buf.Bytes()))
+
+	log, err := Parse(&buf)
+	require.NoError(t, err)
+	assert.Empty(t, log.Runs)
+}
@@ -0,0 +1,546 @@
+package sarif
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"data_processor/internal/common"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Parse decodes a log and checks its version. Properties the package does not model are
+// ignored, so logs with tool-specific extensions are accepted. An invalid log is reported as
+// *Error; errors of r are returned as is.
+func Parse(r io.Reader) (*Log, error) {
+	decoder := json.NewDecoder(r)
+	var log Log
+	err := decoder.Decode(&log)
+	if errors.Is(err, io.EOF) {
+		return nil, errorf("", "log is empty")
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, errorf("", "log is truncated")
+	}
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return nil, errorf("", "invalid JSON at offset %d: %v", syntaxErr.Offset, err)
+	}
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return nil, errorf(jsonIndex.ReplaceAllString(typeErr.Field, "[$1]"), "must be %s, not %s", jsonType(typeErr.Type.Kind().String()), typeErr.Value)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errorf("", "unexpected data after the log")
+	}
+
+	if log.Version != Version {
+		return nil, errorf("version", "unsupported version %q, expected %q", log.Version, Version)
+	}
+	if log.Runs == nil {
+		return nil, errorf("runs", "is required")
+	}
+	return &log, nil
+}
+
+// jsonIndex — индексы массивов в пути encoding/json ("runs.0.results"), они пишутся как runs[0]
+var jsonIndex = regexp.MustCompile(`\.(\d+)`)
+
+// jsonType называет тип Go так, как он выглядит в JSON
+func jsonType(kind string) string {
+	switch kind {
+	case "struct", "map":
+		return "an object"
+	case "slice", "array":
+		return "an array"
+	case "string":
+		return "a string"
+	case "bool":
+		return "a boolean"
+	}
+	return "a number"
+}
+
+// Findings maps the results of every run to findings. The tool of a finding is the driver of
+// its run; only the rules that findings refer to are returned. Results of kind pass,
+// notApplicable and informational are not findings and are skipped.
+//
+// The severity of a finding comes from the security-severity property of the result or its
+// rule when present, otherwise from its level: error is high, warning medium, note low and none
+// info. The fingerprint is the one under FingerprintKey or else the first of the result's
+// fingerprints, or is derived from its partial fingerprints, or is
+// common.Finding.DefaultFingerprint. Of several suppressions of a result the accepted one is
+// kept.
+func (l *Log) Findings() ([]*common.Finding, []*common.FindingRule, error) {
+	var findings []*common.Finding
+	var rules []*common.FindingRule
+	seenRules := map[[2]string]bool{}
+
+	for i := range l.Runs {
+		run := &l.Runs[i]
+		path := fmt.Sprintf("runs[%d]", i)
+		if strings.TrimSpace(run.Tool.Driver.Name) == "" {
+			return nil, nil, errorf(path+".tool.driver.name", "must not be empty")
+		}
+		index, err := newRuleIndex(run, path)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for j := range run.Results {
+			result := &run.Results[j]
+			path := fmt.Sprintf("%s.results[%d]", path, j)
+			switch result.Kind {
+			case "", "fail", "open", "review":
+			case "pass", "notApplicable", "informational":
+				continue
+			default:
+				return nil, nil, errorf(path+".kind", "unknown kind %q", result.Kind)
+			}
+
+			rule, err := index.resolve(result, path)
+			if err != nil {
+				return nil, nil, err
+			}
+			finding, err := convertResult(run, result, rule, path)
+			if err != nil {
+				return nil, nil, err
+			}
+			findings = append(findings, finding)
+
+			key := [2]string{finding.Tool, finding.RuleID}
+			if !seenRules[key] {
+				seenRules[key] = true
+				rules = append(rules, convertRule(finding.Tool, finding.RuleID, rule))
+			}
+		}
+	}
+	return findings, rules, nil
+}
+
+// ruleIndex находит правило результата по ruleId, ruleIndex или rule среди правил драйвера и расширений
+type ruleIndex struct {
+	components []*ToolComponent
+	paths      []string
+	byID       []map[string]*ReportingDescriptor
+}
+
+func newRuleIndex(run *Run, path string) (*ruleIndex, error) {
+	index := &ruleIndex{}
+	index.add(&run.Tool.Driver, path+".tool.driver")
+	for i := range run.Tool.Extensions {
+		index.add(&run.Tool.Extensions[i], fmt.Sprintf("%s.tool.extensions[%d]", path, i))
+	}
+
+	for c, component := range index.components {
+		for r := range component.Rules {
+			rule := &component.Rules[r]
+			rulePath := fmt.Sprintf("%s.rules[%d]", index.paths[c], r)
+			if rule.ID == "" {
+				return nil, errorf(rulePath+".id", "must not be empty")
+			}
+			if _, err := score(rule.Properties, rulePath); err != nil {
+				return nil, err
+			}
+			if rule.DefaultConfiguration != nil && !validLevel(rule.DefaultConfiguration.Level) {
+				return nil, errorf(rulePath+".defaultConfiguration.level", "unknown level %q", rule.DefaultConfiguration.Level)
+			}
+			if _, ok := index.byID[c][rule.ID]; !ok {
+				index.byID[c][rule.ID] = rule
+			}
+		}
+	}
+	return index, nil
+}
+
+func (x *ruleIndex) add(component *ToolComponent, path string) {
+	x.components = append(x.components, component)
+	x.paths = append(x.paths, path)
+	x.byID = append(x.byID, map[string]*ReportingDescriptor{})
+}
+
+// resolve returns the rule of the result. A rule the tool does not describe is returned with
+// its id only.
+func (x *ruleIndex) resolve(result *Result, path string) (*ReportingDescriptor, error) {
+	id, ruleIndex := result.RuleID, result.RuleIndex
+	component := 0
+	if ref := result.Rule; ref != nil {
+		if id == "" {
+			id = ref.ID
+		}
+		if ruleIndex == nil {
+			ruleIndex = ref.Index
+		}
+		if ref.ToolComponent != nil {
+			c, err := x.component(ref.ToolComponent, path+".rule.toolComponent")
+			if err != nil {
+				return nil, err
+			}
+			component = c
+		}
+	}
+
+	rules := x.components[component].Rules
+	if ruleIndex != nil {
+		if *ruleIndex < 0 || *ruleIndex >= len(rules) {
+			return nil, errorf(path+".ruleIndex", "%d is out of range, %s has %d rules", *ruleIndex, x.paths[component], len(rules))
+		}
+		rule := &rules[*ruleIndex]
+		if id != "" && id != rule.ID && !strings.HasPrefix(id, rule.ID+"/") {
+			return nil, errorf(path+".ruleId", "%q does not match rule %q at ruleIndex %d", id, rule.ID, *ruleIndex)
+		}
+		return rule, nil
+	}
+	if id == "" {
+		return nil, errorf(path+".ruleId", "is required when neither ruleIndex nor rule is set")
+	}
+
+	// ruleId вида "CA2000/1" ссылается на подправило правила CA2000
+	if rule, ok := x.byID[component][id]; ok {
+		return rule, nil
+	}
+	if parent, _, ok := strings.Cut(id, "/"); ok {
+		if rule, ok := x.byID[component][parent]; ok {
+			sub := *rule
+			sub.ID = id
+			return &sub, nil
+		}
+	}
+	return &ReportingDescriptor{ID: id}, nil
+}
+
+func (x *ruleIndex) component(ref *ToolComponentReference, path string) (int, error) {
+	if ref.Index != nil {
+		// Индекс toolComponent указывает в tool.extensions
+		if *ref.Index < 0 || *ref.Index >= len(x.components)-1 {
+			return 0, errorf(path+".index", "%d is out of range, the tool has %d extensions", *ref.Index, len(x.components)-1)
+		}
+		return *ref.Index + 1, nil
+	}
+	for c, component := range x.components {
+		if component.Name == ref.Name {
+			return c, nil
+		}
+	}
+	return 0, errorf(path+".name", "the tool has no component %q", ref.Name)
+}
+
+func convertResult(run *Run, result *Result, rule *ReportingDescriptor, path string) (*common.Finding, error) {
+	finding := &common.Finding{
+		Tool:                run.Tool.Driver.Name,
+		RuleID:              rule.ID,
+		PartialFingerprints: result.PartialFingerprints,
+	}
+	if result.RuleID != "" {
+		finding.RuleID = result.RuleID
+	}
+	if version := cmp.Or(run.Tool.Driver.Version, run.Tool.Driver.SemanticVersion); version != "" {
+		finding.ToolVersion = &version
+	}
+
+	finding.Message = messageText(result.Message, rule)
+	if strings.TrimSpace(finding.Message) == "" {
+		return nil, errorf(path+".message", "text is required")
+	}
+
+	severity, err := resultSeverity(result, rule, path)
+	if err != nil {
+		return nil, err
+	}
+	finding.Severity = severity
+	finding.CWE = resultCWE(result, rule)
+
+	if err := setLocation(finding, run, result, path); err != nil {
+		return nil, err
+	}
+
+	suppression, err := resultSuppression(result, path)
+	if err != nil {
+		return nil, err
+	}
+	finding.Suppression = suppression
+
+	finding.Fingerprint = fingerprint(finding, result)
+	return finding, nil
+}
+
+func convertRule(tool, id string, rule *ReportingDescriptor) *common.FindingRule {
+	converted := &common.FindingRule{
+		Tool:             tool,
+		RuleID:           id,
+		Name:             rule.Name,
+		ShortDescription: messageString(rule.ShortDescription),
+		FullDescription:  messageString(rule.FullDescription),
+		Help:             messageString(rule.Help),
+		HelpURI:          rule.HelpURI,
+	}
+	if rule.Properties != nil {
+		converted.Tags = rule.Properties.Tags
+	}
+	return converted
+}
+
+func messageString(m *MultiformatMessageString) string {
+	if m == nil {
+		return ""
+	}
+	return cmp.Or(m.Text, m.Markdown)
+}
+
+// messageText возвращает текст сообщения, подставляя аргументы в шаблон из messageStrings правила
+func messageText(message Message, rule *ReportingDescriptor) string {
+	text := cmp.Or(message.Text, message.Markdown)
+	if text == "" && message.ID != "" {
+		if template, ok := rule.MessageStrings[message.ID]; ok {
+			text = cmp.Or(template.Text, template.Markdown)
+		}
+	}
+	if len(message.Arguments) == 0 {
+		return text
+	}
+	replacements := make([]string, 0, 2*len(message.Arguments))
+	for i, arg := range message.Arguments {
+		replacements = append(replacements, "{"+strconv.Itoa(i)+"}", arg)
+	}
+	return strings.NewReplacer(replacements...).Replace(text)
+}
+
+func validLevel(level string) bool {
+	switch level {
+	case "", LevelError, LevelWarning, LevelNote, LevelNone:
+		return true
+	}
+	return false
+}
+
+func resultSeverity(result *Result, rule *ReportingDescriptor, path string) (common.Severity, error) {
+	if !validLevel(result.Level) {
+		return "", errorf(path+".level", "unknown level %q", result.Level)
+	}
+	s, err := score(result.Properties, path)
+	if err != nil {
+		return "", err
+	}
+	if s == nil {
+		// Правила уже проверены в newRuleIndex
+		s, _ = score(rule.Properties, "")
+	}
+	if s != nil {
+		switch {
+		case *s >= 9:
+			return common.SeverityCritical, nil
+		case *s >= 7:
+			return common.SeverityHigh, nil
+		case *s >= 4:
+			return common.SeverityMedium, nil
+		case *s > 0:
+			return common.SeverityLow, nil
+		}
+		return common.SeverityInfo, nil
+	}
+
+	level := result.Level
+	if level == "" && rule.DefaultConfiguration != nil {
+		level = rule.DefaultConfiguration.Level
+	}
+	switch level {
+	case LevelError:
+		return common.SeverityHigh, nil
+	case LevelNote:
+		return common.SeverityLow, nil
+	case LevelNone:
+		return common.SeverityInfo, nil
+	}
+	return common.SeverityMedium, nil
+}
+
+// score возвращает security-severity или nil, если его нет
+func score(properties *PropertyBag, path string) (*float64, error) {
+	if properties == nil || properties.SecuritySeverity == "" {
+		return nil, nil
+	}
+	s, err := strconv.ParseFloat(string(properties.SecuritySeverity), 64)
+	if err != nil || s < 0 || s > 10 {
+		return nil, errorf(path+".properties.security-severity", "must be a number from 0 to 10, not %q", properties.SecuritySeverity)
+	}
+	return &s, nil
+}
+
+// cweTag находит CWE в тегах вида "CWE-79", "CWE-79: Cross-site Scripting" и "external/cwe/cwe-079"
+var cweTag = regexp.MustCompile(`(?i)^(?:external/cwe/)?cwe-0*([1-9][0-9]*)\b`)
+
+func resultCWE(result *Result, rule *ReportingDescriptor) *int {
+	var references []ReportingDescriptorReference
+	references = append(references, result.Taxa...)
+	for _, relationship := range rule.Relationships {
+		references = append(references, relationship.Target)
+	}
+	for _, ref := range references {
+		if ref.ToolComponent == nil || !strings.EqualFold(ref.ToolComponent.Name, "CWE") {
+			continue
+		}
+		if cwe, err := strconv.Atoi(strings.TrimPrefix(strings.ToUpper(ref.ID), "CWE-")); err == nil && cwe > 0 {
+			return &cwe
+		}
+	}
+
+	for _, properties := range []*PropertyBag{result.Properties, rule.Properties} {
+		if properties == nil {
+			continue
+		}
+		for _, tag := range properties.Tags {
+			if m := cweTag.FindStringSubmatch(tag); m != nil {
+				if cwe, err := strconv.Atoi(m[1]); err == nil {
+					return &cwe
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// setLocation берёт файл и строки из первого физического расположения результата
+func setLocation(finding *common.Finding, run *Run, result *Result, path string) error {
+	for i, location := range result.Locations {
+		physical := location.PhysicalLocation
+		if physical == nil {
+			continue
+		}
+		path := fmt.Sprintf("%s.locations[%d].physicalLocation", path, i)
+
+		if artifact := physical.ArtifactLocation; artifact != nil {
+			uri := artifact.URI
+			if uri == "" && artifact.Index != nil {
+				if *artifact.Index < 0 || *artifact.Index >= len(run.Artifacts) {
+					return errorf(path+".artifactLocation.index", "%d is out of range, the run has %d artifacts", *artifact.Index, len(run.Artifacts))
+				}
+				if location := run.Artifacts[*artifact.Index].Location; location != nil {
+					uri = location.URI
+				}
+			}
+			file, err := filePath(uri)
+			if err != nil {
+				return errorf(path+".artifactLocation.uri", "%v", err)
+			}
+			if file != "" {
+				finding.File = &file
+			}
+		}
+
+		if region := physical.Region; region != nil {
+			if region.StartLine != nil && *region.StartLine < 1 {
+				return errorf(path+".region.startLine", "must be positive")
+			}
+			if region.EndLine != nil {
+				if region.StartLine == nil {
+					return errorf(path+".region.endLine", "requires startLine")
+				}
+				if *region.EndLine < *region.StartLine {
+					return errorf(path+".region.endLine", "must not be before startLine")
+				}
+			}
+			finding.StartLine, finding.EndLine = region.StartLine, region.EndLine
+		}
+		return nil
+	}
+	return nil
+}
+
+// filePath переводит URI артефакта в путь с прямыми слешами: file:// и ./ отбрасываются,
+// %-кодирование раскрывается
+func filePath(uri string) (string, error) {
+	if uri == "" {
+		return "", nil
+	}
+	// Пути Windows вида C:\src\a.go инструменты иногда пишут без схемы
+	if windowsDrive(uri) {
+		return strings.ReplaceAll(uri, "\\", "/"), nil
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid URI %q", uri)
+	}
+	file := parsed.Path
+	if parsed.Scheme != "" && parsed.Scheme != "file" {
+		return "", fmt.Errorf("unsupported scheme %q", parsed.Scheme)
+	}
+	if parsed.Opaque != "" {
+		file = parsed.Opaque
+	}
+	// file:///C:/src/a.go
+	if strings.HasPrefix(file, "/") && windowsDrive(file[1:]) {
+		file = file[1:]
+	}
+	return strings.TrimPrefix(file, "./"), nil
+}
+
+// windowsDrive сообщает, начинается ли путь с буквы диска: C:/ или C:\
+func windowsDrive(path string) bool {
+	return len(path) > 2 && path[1] == ':' && (path[2] == '/' || path[2] == '\\') &&
+		('a' <= path[0] && path[0] <= 'z' || 'A' <= path[0] && path[0] <= 'Z')
+}
+
+// resultSuppression возвращает принятое подавление результата, а если такого нет — первое
+func resultSuppression(result *Result, path string) (*common.Suppression, error) {
+	var found *common.Suppression
+	for i, s := range result.Suppressions {
+		path := fmt.Sprintf("%s.suppressions[%d]", path, i)
+		suppression := &common.Suppression{Status: common.SuppressionAccepted, Justification: s.Justification}
+		switch s.Kind {
+		case SuppressionInSource:
+			suppression.Kind = common.SuppressionInSource
+		case SuppressionExternal:
+			suppression.Kind = common.SuppressionExternal
+		default:
+			return nil, errorf(path+".kind", "unknown kind %q", s.Kind)
+		}
+		switch s.Status {
+		case "", SuppressionAccepted:
+		case SuppressionUnderReview:
+			suppression.Status = common.SuppressionUnderReview
+		case SuppressionRejected:
+			suppression.Status = common.SuppressionRejected
+		default:
+			return nil, errorf(path+".status", "unknown status %q", s.Status)
+		}
+		if found == nil || found.Status != common.SuppressionAccepted && suppression.Status == common.SuppressionAccepted {
+			found = suppression
+		}
+	}
+	return found, nil
+}
+
+func fingerprint(finding *common.Finding, result *Result) string {
+	if fp, ok := result.Fingerprints[FingerprintKey]; ok {
+		return fp
+	}
+	if len(result.Fingerprints) > 0 {
+		keys := slices.Sorted(maps.Keys(result.Fingerprints))
+		return keys[0] + ":" + result.Fingerprints[keys[0]]
+	}
+	if len(result.PartialFingerprints) == 0 {
+		return finding.DefaultFingerprint()
+	}
+
+	file := ""
+	if finding.File != nil {
+		file = *finding.File
+	}
+	hash := sha256.New()
+	hash.Write([]byte(finding.Tool + "\x00" + finding.RuleID + "\x00" + file))
+	for _, key := range slices.Sorted(maps.Keys(result.PartialFingerprints)) {
+		hash.Write([]byte("\x00" + key + "=" + result.PartialFingerprints[key]))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,206 @@
+package sarif
+
+import (
+	"data_processor/internal/common"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readFixture(t *testing.T, name string) ([]*common.Finding, []*common.FindingRule) {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	defer f.Close()
+
+	log, err := Parse(f)
+	require.NoError(t, err)
+	findings, rules, err := log.Findings()
+	require.NoError(t, err)
+	for _, finding := range findings {
+		require.NoError(t, finding.Validate())
+		require.NotEmpty(t, finding.Fingerprint)
+	}
+	return findings, rules
+}
+
+func TestFindingsCodeQL(t *testing.T) {
+	findings, rules := readFixture(t, "codeql.sarif")
+	require.Len(t, findings, 2)
+
+	// Правила расширения находятся по rule.toolComponent, инструмент — драйвер
+	xss := findings[0]
+	assert.Equal(t, "CodeQL", xss.Tool)
+	assert.Equal(t, "2.15.3", *xss.ToolVersion)
+	assert.Equal(t, "js/xss", xss.RuleID)
+	assert.Equal(t, common.SeverityMedium, xss.Severity, "security-severity 6.1")
+	assert.Equal(t, 79, *xss.CWE)
+	assert.Equal(t, "src/render.js", *xss.File)
+	assert.Equal(t, 42, *xss.StartLine)
+	assert.Nil(t, xss.EndLine)
+	assert.Equal(t, "a4b5f7f6fe3b2c1d:1", xss.PartialFingerprints["primaryLocationLineHash"])
+
+	// Файл по индексу артефакта, важность по уровню правила
+	unused := findings[1]
+	assert.Equal(t, "src/util.js", *unused.File)
+	assert.Equal(t, common.SeverityLow, unused.Severity)
+	assert.Nil(t, unused.CWE)
+	assert.NotEqual(t, xss.Fingerprint, unused.Fingerprint)
+
+	require.Len(t, rules, 2)
+	assert.Equal(t, "Client-side cross-site scripting", rules[0].ShortDescription)
+	assert.Contains(t, rules[0].Help, "sanitizing")
+	assert.Contains(t, rules[0].Tags, "external/cwe/cwe-079")
+}
+
+func TestFindingsSemgrep(t *testing.T) {
+	findings, rules := readFixture(t, "semgrep.sarif")
+	require.Len(t, findings, 2)
+
+	sqli := findings[0]
+	assert.Equal(t, "Semgrep OSS", sqli.Tool)
+	assert.Equal(t, "1.45.0", *sqli.ToolVersion)
+	assert.Equal(t, common.SeverityHigh, sqli.Severity, "default level error")
+	assert.Equal(t, 89, *sqli.CWE)
+	assert.Equal(t, "app/views.py", *sqli.File)
+	assert.Equal(t, 27, *sqli.EndLine)
+	assert.True(t, strings.HasPrefix(sqli.Fingerprint, "matchBasedId/v1:"))
+
+	debug := findings[1]
+	assert.Equal(t, common.SeverityMedium, debug.Severity)
+	assert.Equal(t, 489, *debug.CWE)
+
+	require.Len(t, rules, 2)
+	assert.Equal(t, "https://semgrep.dev/r/python.django.security.audit.django-debug-true.django-debug-true", rules[1].HelpURI)
+}
+
+func TestFindingsGosec(t *testing.T) {
+	findings, rules := readFixture(t, "gosec.sarif")
+	require.Len(t, findings, 3)
+
+	// CWE из relationships правила
+	assert.Equal(t, "G101", findings[0].RuleID)
+	assert.Equal(t, common.SeverityHigh, findings[0].Severity)
+	assert.Equal(t, 798, *findings[0].CWE)
+	assert.Equal(t, 22, *findings[1].CWE)
+	assert.Equal(t, "internal/config/config.go", *findings[0].File)
+	assert.Equal(t, "2.18.2", *findings[0].ToolVersion)
+
+	require.Len(t, rules, 3)
+	assert.Equal(t, "Use of Hard-coded Credentials", rules[0].Name)
+
+	// Подавление без статуса принято
+	assert.Nil(t, findings[0].Suppression)
+	require.NotNil(t, findings[2].Suppression)
+	assert.True(t, findings[2].Suppressed())
+	assert.Equal(t, common.SuppressionInSource, findings[2].Suppression.Kind)
+	assert.Equal(t, "close error is irrelevant for a read-only file", findings[2].Suppression.Justification)
+}
+
+func TestFindingsESLint(t *testing.T) {
+	findings, rules := readFixture(t, "eslint.sarif")
+	require.Len(t, findings, 2)
+
+	// file:// URI превращается в путь, %20 раскрывается
+	assert.Equal(t, "/home/runner/work/web/web/src/legacy loader.js", *findings[0].File)
+	assert.Equal(t, "ESLint", findings[0].Tool)
+	assert.Equal(t, "8.50.0", *findings[0].ToolVersion)
+	assert.Equal(t, common.SeverityHigh, findings[0].Severity)
+	assert.Equal(t, common.SeverityMedium, findings[1].Severity)
+	assert.Equal(t, 3, *findings[1].StartLine)
+	assert.Equal(t, 5, *findings[1].EndLine)
+	assert.Equal(t, "Disallow the use of `eval()`", rules[0].ShortDescription)
+}
+
+func TestFindingsMessageStrings(t *testing.T) {
+	log, err := Parse(strings.NewReader(`{"version": "2.1.0", "runs": [{
+		"tool": {"driver": {"name": "t", "rules": [{"id": "R1",
+			"messageStrings": {"default": {"text": "{0} flows to {1}"}},
+			"properties": {"security-severity": 9.8}}]}},
+		"results": [
+			{"ruleId": "R1", "message": {"id": "default", "arguments": ["input", "query"]}},
+			{"ruleId": "R1/sub", "kind": "open", "message": {"text": "subrule"}},
+			{"ruleId": "R2", "message": {"text": "unknown rule"}},
+			{"ruleId": "R1", "kind": "pass", "message": {"text": "passed"}},
+			{"ruleId": "R1", "message": {"text": "suppressed"}, "suppressions": [
+				{"kind": "external", "status": "rejected"}, {"kind": "inSource", "status": "accepted"}]}
+		]}]}`))
+	require.NoError(t, err)
+	findings, rules, err := log.Findings()
+	require.NoError(t, err)
+	require.Len(t, findings, 4)
+
+	assert.Equal(t, "input flows to query", findings[0].Message)
+	assert.Equal(t, common.SeverityCritical, findings[0].Severity)
+	assert.Nil(t, findings[0].ToolVersion)
+	assert.Equal(t, "R1/sub", findings[1].RuleID)
+	assert.Equal(t, common.SeverityCritical, findings[1].Severity, "subrule inherits the rule")
+	assert.Equal(t, common.SeverityMedium, findings[2].Severity)
+	assert.Equal(t, common.SuppressionInSource, findings[3].Suppression.Kind, "the accepted suppression wins")
+	assert.Len(t, rules, 3)
+}
+
+func TestParseErrors(t *testing.T) {
+	run := func(results string) string {
+		return `{"version": "2.1.0", "runs": [{"tool": {"driver": {"name": "t", "rules": [{"id": "R1"}]}},
+			"artifacts": [], "results": [` + results + `]}]}`
+	}
+	tests := []struct {
+		name string
+		log  string
+		want string
+	}{
+		{"empty", ``, "log is empty"},
+		{"truncated", `{"version": `, "log is truncated"},
+		{"bad json", `{"version": "2.1.0",}`, "invalid JSON at offset"},
+		{"trailing data", `{"version": "2.1.0", "runs": []} {}`, "unexpected data after the log"},
+		{"version", `{"version": "2.0.0", "runs": []}`, `version: unsupported version "2.0.0"`},
+		{"runs", `{"version": "2.1.0"}`, "runs: is required"},
+		{"type", `{"version": "2.1.0", "runs": [{"results": [{"ruleIndex": "0"}]}]}`, "runs[0].results[0].ruleIndex: must be a number"},
+		{"driver name", `{"version": "2.1.0", "runs": [{"tool": {"driver": {}}, "results": []}]}`, "runs[0].tool.driver.name: must not be empty"},
+		{"rule id", `{"version": "2.1.0", "runs": [{"tool": {"driver": {"name": "t", "rules": [{}]}}, "results": []}]}`,
+			"runs[0].tool.driver.rules[0].id: must not be empty"},
+		{"rule level", `{"version": "2.1.0", "runs": [{"tool": {"driver": {"name": "t", "rules": [{"id": "R", "defaultConfiguration": {"level": "fatal"}}]}}, "results": []}]}`,
+			`runs[0].tool.driver.rules[0].defaultConfiguration.level: unknown level "fatal"`},
+		{"no rule", run(`{"message": {"text": "m"}}`), "runs[0].results[0].ruleId: is required"},
+		{"rule index", run(`{"ruleIndex": 3, "message": {"text": "m"}}`), "runs[0].results[0].ruleIndex: 3 is out of range"},
+		{"rule mismatch", run(`{"ruleId": "R2", "ruleIndex": 0, "message": {"text": "m"}}`), `"R2" does not match rule "R1"`},
+		{"extension", run(`{"rule": {"id": "R1", "toolComponent": {"index": 0}}, "message": {"text": "m"}}`),
+			"runs[0].results[0].rule.toolComponent.index: 0 is out of range"},
+		{"message", run(`{"ruleId": "R1", "message": {}}`), "runs[0].results[0].message: text is required"},
+		{"kind", run(`{"ruleId": "R1", "kind": "bad", "message": {"text": "m"}}`), `runs[0].results[0].kind: unknown kind "bad"`},
+		{"level", run(`{"ruleId": "R1", "level": "fatal", "message": {"text": "m"}}`), `runs[0].results[0].level: unknown level "fatal"`},
+		{"security severity", run(`{"ruleId": "R1", "message": {"text": "m"}, "properties": {"security-severity": true}}`),
+			"runs[0].results[0].properties.security-severity: must be a number from 0 to 10"},
+		{"security severity range", run(`{"ruleId": "R1", "message": {"text": "m"}, "properties": {"security-severity": "11"}}`),
+			"runs[0].results[0].properties.security-severity: must be a number from 0 to 10"},
+		{"start line", run(`{"ruleId": "R1", "message": {"text": "m"}, "locations": [{"physicalLocation": {"region": {"startLine": 0}}}]}`),
+			"runs[0].results[0].locations[0].physicalLocation.region.startLine: must be positive"},
+		{"end line", run(`{"ruleId": "R1", "message": {"text": "m"}, "locations": [{"physicalLocation": {"region": {"startLine": 5, "endLine": 4}}}]}`),
+			"runs[0].results[0].locations[0].physicalLocation.region.endLine: must not be before startLine"},
+		{"artifact", run(`{"ruleId": "R1", "message": {"text": "m"}, "locations": [{"physicalLocation": {"artifactLocation": {"index": 2}}}]}`),
+			"runs[0].results[0].locations[0].physicalLocation.artifactLocation.index: 2 is out of range"},
+		{"suppression kind", run(`{"ruleId": "R1", "message": {"text": "m"}, "suppressions": [{"kind": "comment"}]}`),
+			`runs[0].results[0].suppressions[0].kind: unknown kind "comment"`},
+		{"suppression status", run(`{"ruleId": "R1", "message": {"text": "m"}, "suppressions": [{"kind": "external", "status": "done"}]}`),
+			`runs[0].results[0].suppressions[0].status: unknown status "done"`},
+		{"uri scheme", run(`{"ruleId": "R1", "message": {"text": "m"}, "locations": [{"physicalLocation": {"artifactLocation": {"uri": "https://example.com/a.go"}}}]}`),
+			`artifactLocation.uri: unsupported scheme "https"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, err := Parse(strings.NewReader(tt.log))
+			if err == nil {
+				_, _, err = log.Findings()
+			}
+			require.Error(t, err)
+			var sarifErr *Error
+			require.ErrorAs(t, err, &sarifErr)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
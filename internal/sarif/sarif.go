@@ -0,0 +1,200 @@
+// Package sarif reads and writes the results of static analysis tools in SARIF 2.1.0, the
+// Static Analysis Results Interchange Format:
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+//
+// Only the parts of the format that map to findings are modelled; everything else in a log,
+// including unknown properties, is ignored on import.
+package sarif
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Version is the only supported SARIF version.
+const Version = "2.1.0"
+
+// Schema is the JSON schema of Version.
+const Schema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+type Log struct {
+	Schema  string `json:"$schema,omitempty"`
+	Version string `json:"version"`
+	Runs    []Run  `json:"runs"`
+}
+
+type Run struct {
+	Tool      Tool       `json:"tool"`
+	Artifacts []Artifact `json:"artifacts,omitempty"`
+	Results   []Result   `json:"results"`
+}
+
+type Tool struct {
+	Driver     ToolComponent   `json:"driver"`
+	Extensions []ToolComponent `json:"extensions,omitempty"`
+}
+
+type ToolComponent struct {
+	Name            string                `json:"name"`
+	Version         string                `json:"version,omitempty"`
+	SemanticVersion string                `json:"semanticVersion,omitempty"`
+	InformationURI  string                `json:"informationUri,omitempty"`
+	Rules           []ReportingDescriptor `json:"rules,omitempty"`
+}
+
+// ReportingDescriptor is the metadata of a rule.
+type ReportingDescriptor struct {
+	ID                   string                              `json:"id"`
+	Name                 string                              `json:"name,omitempty"`
+	ShortDescription     *MultiformatMessageString           `json:"shortDescription,omitempty"`
+	FullDescription      *MultiformatMessageString           `json:"fullDescription,omitempty"`
+	Help                 *MultiformatMessageString           `json:"help,omitempty"`
+	HelpURI              string                              `json:"helpUri,omitempty"`
+	MessageStrings       map[string]MultiformatMessageString `json:"messageStrings,omitempty"`
+	DefaultConfiguration *ReportingConfiguration             `json:"defaultConfiguration,omitempty"`
+	Relationships        []Relationship                      `json:"relationships,omitempty"`
+	Properties           *PropertyBag                        `json:"properties,omitempty"`
+}
+
+type MultiformatMessageString struct {
+	Text     string `json:"text"`
+	Markdown string `json:"markdown,omitempty"`
+}
+
+type ReportingConfiguration struct {
+	Level string `json:"level,omitempty"`
+}
+
+type Relationship struct {
+	Target ReportingDescriptorReference `json:"target"`
+	Kinds  []string                     `json:"kinds,omitempty"`
+}
+
+// ReportingDescriptorReference points to a rule or a taxon, by id or by index in its component.
+type ReportingDescriptorReference struct {
+	ID            string                  `json:"id,omitempty"`
+	Index         *int                    `json:"index,omitempty"`
+	ToolComponent *ToolComponentReference `json:"toolComponent,omitempty"`
+}
+
+type ToolComponentReference struct {
+	Name  string `json:"name,omitempty"`
+	Index *int   `json:"index,omitempty"`
+}
+
+// PropertyBag holds the well-known properties used for findings. Tags carry CWE identifiers
+// ("CWE-79", "external/cwe/cwe-079"); security-severity is a CVSS-like score from 0.0 to 10.0.
+type PropertyBag struct {
+	Tags             []string `json:"tags,omitempty"`
+	SecuritySeverity Score    `json:"security-severity,omitempty"`
+}
+
+// Score is a number that tools write either as a JSON number or as a string. Any other value
+// is kept as is and rejected when the score is used, where the error can name its path.
+type Score string
+
+func (s *Score) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		*s = Score(str)
+		return nil
+	}
+	if string(data) != "null" {
+		*s = Score(data)
+	}
+	return nil
+}
+
+type Result struct {
+	RuleID              string                         `json:"ruleId,omitempty"`
+	RuleIndex           *int                           `json:"ruleIndex,omitempty"`
+	Rule                *ReportingDescriptorReference  `json:"rule,omitempty"`
+	Kind                string                         `json:"kind,omitempty"`
+	Level               string                         `json:"level,omitempty"`
+	Message             Message                        `json:"message"`
+	Locations           []Location                     `json:"locations,omitempty"`
+	Fingerprints        map[string]string              `json:"fingerprints,omitempty"`
+	PartialFingerprints map[string]string              `json:"partialFingerprints,omitempty"`
+	Taxa                []ReportingDescriptorReference `json:"taxa,omitempty"`
+	Suppressions        []Suppression                  `json:"suppressions,omitempty"`
+	BaselineState       string                         `json:"baselineState,omitempty"`
+	Properties          *PropertyBag                   `json:"properties,omitempty"`
+}
+
+// Suppression of a result. A result is suppressed when one of its suppressions is accepted; a
+// suppression without a status is accepted.
+type Suppression struct {
+	Kind          string `json:"kind"`
+	Status        string `json:"status,omitempty"`
+	Justification string `json:"justification,omitempty"`
+}
+
+type Message struct {
+	Text      string   `json:"text,omitempty"`
+	Markdown  string   `json:"markdown,omitempty"`
+	ID        string   `json:"id,omitempty"`
+	Arguments []string `json:"arguments,omitempty"`
+}
+
+type Location struct {
+	PhysicalLocation *PhysicalLocation `json:"physicalLocation,omitempty"`
+}
+
+type PhysicalLocation struct {
+	ArtifactLocation *ArtifactLocation `json:"artifactLocation,omitempty"`
+	Region           *Region           `json:"region,omitempty"`
+}
+
+type ArtifactLocation struct {
+	URI       string `json:"uri,omitempty"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+	Index     *int   `json:"index,omitempty"`
+}
+
+type Region struct {
+	StartLine *int `json:"startLine,omitempty"`
+	EndLine   *int `json:"endLine,omitempty"`
+}
+
+type Artifact struct {
+	Location *ArtifactLocation `json:"location,omitempty"`
+}
+
+// Result levels.
+const (
+	LevelError   = "error"
+	LevelWarning = "warning"
+	LevelNote    = "note"
+	LevelNone    = "none"
+)
+
+// Suppression kinds and statuses.
+const (
+	SuppressionInSource    = "inSource"
+	SuppressionExternal    = "external"
+	SuppressionAccepted    = "accepted"
+	SuppressionUnderReview = "underReview"
+	SuppressionRejected    = "rejected"
+)
+
+// FingerprintKey is the key of the fingerprint of an exported finding in result.fingerprints.
+// It is imported as is, so a log exported and imported again keeps its fingerprints.
+const FingerprintKey = "findingFingerprint/v1"
+
+// Error is an invalid log. Path points to the offending element, for example
+// runs[0].results[3].locations[0].physicalLocation.region.startLine; it is empty for syntax errors.
+type Error struct {
+	Path string
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	if e.Path == "" {
+		return e.Msg
+	}
+	return e.Path + ": " + e.Msg
+}
+
+func errorf(path, format string, args ...any) *Error {
+	return &Error{Path: path, Msg: fmt.Sprintf(format, args...)}
+}
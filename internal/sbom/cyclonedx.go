@@ -0,0 +1,231 @@
+package sbom
+
+import (
+	"bytes"
+	"cmp"
+	"data_processor/internal/common"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// cycloneDXVersions — поддерживаемые версии спецификации CycloneDX
+var cycloneDXVersions = []string{"1.5", "1.6"}
+
+// cycloneDXNamespace — пространство имён XML версии спецификации
+const cycloneDXNamespace = "http://cyclonedx.org/schema/bom/"
+
+// Структуры описывают и JSON, и XML; где представления расходятся, поля разделены.
+// Поля, которые только пишутся, в XML не читаются
+type cdxBOM struct {
+	BOMFormat    string          `json:"bomFormat" xml:"-"`
+	SpecVersion  string          `json:"specVersion" xml:"-"`
+	SerialNumber string          `json:"serialNumber,omitempty" xml:"-"`
+	Version      int             `json:"version,omitempty" xml:"-"`
+	Metadata     *cdxMetadata    `json:"metadata,omitempty" xml:"metadata"`
+	Components   []cdxComponent  `json:"components,omitempty" xml:"components>component"`
+	Dependencies []cdxDependency `json:"dependencies,omitempty" xml:"dependencies>dependency"`
+}
+
+type cdxMetadata struct {
+	Timestamp string        `json:"timestamp,omitempty" xml:"-"`
+	Tools     *cdxTools     `json:"tools,omitempty" xml:"-"`
+	Component *cdxComponent `json:"component,omitempty" xml:"component"`
+}
+
+type cdxTools struct {
+	Components []cdxComponent `json:"components"`
+}
+
+type cdxComponent struct {
+	Type        string             `json:"type,omitempty" xml:"-"`
+	BOMRef      string             `json:"bom-ref,omitempty" xml:"bom-ref,attr"`
+	Group       string             `json:"group,omitempty" xml:"group"`
+	Name        string             `json:"name" xml:"name"`
+	Version     string             `json:"version,omitempty" xml:"version"`
+	PURL        string             `json:"purl,omitempty" xml:"purl"`
+	Hashes      []cdxHash          `json:"hashes,omitempty" xml:"hashes>hash"`
+	Licenses    []cdxLicenseChoice `json:"licenses,omitempty" xml:"-"`
+	XMLLicenses *cdxXMLLicenses    `json:"-" xml:"licenses"`
+	Components  []cdxComponent     `json:"components,omitempty" xml:"components>component"`
+}
+
+type cdxHash struct {
+	Alg     string `json:"alg" xml:"alg,attr"`
+	Content string `json:"content" xml:",chardata"`
+}
+
+type cdxLicenseChoice struct {
+	License    *cdxLicense `json:"license,omitempty"`
+	Expression string      `json:"expression,omitempty"`
+}
+
+type cdxLicense struct {
+	ID   string `json:"id,omitempty" xml:"id"`
+	Name string `json:"name,omitempty" xml:"name"`
+}
+
+type cdxXMLLicenses struct {
+	Licenses    []cdxLicense `xml:"license"`
+	Expressions []string     `xml:"expression"`
+}
+
+type cdxDependency struct {
+	Ref          string   `json:"ref" xml:"ref,attr"`
+	DependsOn    []string `json:"dependsOn,omitempty" xml:"-"`
+	XMLDependsOn []struct {
+		Ref string `xml:"ref,attr"`
+	} `json:"-" xml:"dependency"`
+}
+
+func parseCycloneDXJSON(data []byte) ([]*common.Component, error) {
+	var bom cdxBOM
+	if err := decodeJSON(data, &bom); err != nil {
+		return nil, err
+	}
+	if bom.BOMFormat != "CycloneDX" {
+		return nil, errorf("bomFormat", "must be CycloneDX, not %q", bom.BOMFormat)
+	}
+	if !slices.Contains(cycloneDXVersions, bom.SpecVersion) {
+		return nil, errorf("specVersion", "unsupported version %q, expected one of %s", bom.SpecVersion, strings.Join(cycloneDXVersions, ", "))
+	}
+	return convertCycloneDX(&bom)
+}
+
+func parseCycloneDXXML(data []byte) ([]*common.Component, error) {
+	var bom struct {
+		XMLName xml.Name
+		cdxBOM
+	}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	err := decoder.Decode(&bom)
+	if errors.Is(err, io.EOF) {
+		return nil, errorf("", "document is empty")
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, errorf("", "document is truncated")
+	}
+	var syntaxErr *xml.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return nil, errorf("", "invalid XML at line %d: %s", syntaxErr.Line, syntaxErr.Msg)
+	}
+	if err != nil {
+		return nil, errorf("", "invalid XML: %v", err)
+	}
+
+	if bom.XMLName.Local != "bom" {
+		return nil, errorf("", "root element must be bom, not %s", bom.XMLName.Local)
+	}
+	version, ok := strings.CutPrefix(bom.XMLName.Space, cycloneDXNamespace)
+	if !ok || !slices.Contains(cycloneDXVersions, version) {
+		return nil, errorf("bom", "unsupported namespace %q, expected %s followed by one of %s", bom.XMLName.Space,
+			cycloneDXNamespace, strings.Join(cycloneDXVersions, ", "))
+	}
+
+	for i := range bom.Dependencies {
+		dep := &bom.Dependencies[i]
+		for _, child := range dep.XMLDependsOn {
+			dep.DependsOn = append(dep.DependsOn, child.Ref)
+		}
+	}
+	var convert func(components []cdxComponent)
+	convert = func(components []cdxComponent) {
+		for i := range components {
+			c := &components[i]
+			if c.XMLLicenses != nil {
+				for _, license := range c.XMLLicenses.Licenses {
+					c.Licenses = append(c.Licenses, cdxLicenseChoice{License: &license})
+				}
+				for _, expression := range c.XMLLicenses.Expressions {
+					c.Licenses = append(c.Licenses, cdxLicenseChoice{Expression: expression})
+				}
+			}
+			convert(c.Components)
+		}
+	}
+	convert(bom.Components)
+	if bom.Metadata != nil && bom.Metadata.Component != nil {
+		primary := []cdxComponent{*bom.Metadata.Component}
+		convert(primary)
+		bom.Metadata.Component = &primary[0]
+	}
+	return convertCycloneDX(&bom.cdxBOM)
+}
+
+func convertCycloneDX(bom *cdxBOM) ([]*common.Component, error) {
+	b := newBuilder()
+	if bom.Metadata != nil && bom.Metadata.Component != nil {
+		if err := addCycloneDX(b, bom.Metadata.Component, "metadata.component", true); err != nil {
+			return nil, err
+		}
+	}
+	var add func(components []cdxComponent, path string) error
+	add = func(components []cdxComponent, path string) error {
+		for i := range components {
+			path := fmt.Sprintf("%s[%d]", path, i)
+			if err := addCycloneDX(b, &components[i], path, false); err != nil {
+				return err
+			}
+			if err := add(components[i].Components, path+".components"); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := add(bom.Components, "components"); err != nil {
+		return nil, err
+	}
+
+	for i, dep := range bom.Dependencies {
+		path := fmt.Sprintf("dependencies[%d]", i)
+		if _, ok := b.byRef[dep.Ref]; !ok {
+			return nil, errorf(path+".ref", "unknown bom-ref %q", dep.Ref)
+		}
+		for j, target := range dep.DependsOn {
+			if _, ok := b.byRef[target]; !ok {
+				return nil, errorf(fmt.Sprintf("%s.dependsOn[%d]", path, j), "unknown bom-ref %q", target)
+			}
+			b.depend(dep.Ref, target)
+		}
+	}
+	return b.components, nil
+}
+
+func addCycloneDX(b *builder, c *cdxComponent, path string, primary bool) error {
+	component := &common.Component{
+		Ref:     c.BOMRef,
+		Primary: primary,
+		Name:    c.Name,
+		Version: optional(c.Version),
+		PURL:    optional(c.PURL),
+	}
+	// bom-ref необязателен, если на компонент не ссылаются; тогда ссылкой служит путь
+	if component.Ref == "" {
+		component.Ref = path
+	}
+	if c.Group != "" && c.Name != "" {
+		component.Name = c.Group + "/" + c.Name
+	}
+	for _, hash := range c.Hashes {
+		addHash(component, hash.Alg, hash.Content)
+	}
+	for i, choice := range c.Licenses {
+		switch {
+		case choice.Expression != "":
+			component.Licenses = append(component.Licenses, choice.Expression)
+		case choice.License != nil && cmp.Or(choice.License.ID, choice.License.Name) != "":
+			component.Licenses = append(component.Licenses, cmp.Or(choice.License.ID, choice.License.Name))
+		default:
+			return errorf(fmt.Sprintf("%s.licenses[%d]", path, i), "needs a license id, name or expression")
+		}
+	}
+	return b.add(component, func(name string) string {
+		if name == "ref" {
+			name = "bom-ref"
+		}
+		return path + "." + name
+	})
+}
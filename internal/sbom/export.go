@@ -0,0 +1,330 @@
+package sbom
+
+import (
+	"cmp"
+	"crypto/sha1"
+	"data_processor/internal/common"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ToolName names this service as the tool that created an exported document.
+const ToolName = "data_processor"
+
+// ExportCycloneDXVersion is the CycloneDX version of exported documents.
+const ExportCycloneDXVersion = "1.6"
+
+// Document is a bill of materials to export: the components of a scan of an application version.
+type Document struct {
+	// Name and Version are those of the application version
+	Name    string
+	Version string
+	// ID is unique to the document, e.g. names the scan. The CycloneDX serial number and the
+	// SPDX document namespace are a URN with a UUID derived from it, so they do not change when
+	// the same document is exported again
+	ID string
+	// Created is the moment the components were collected
+	Created    time.Time
+	Components []*common.Component
+}
+
+// Write writes the document as CycloneDX 1.6 JSON or SPDX 2.3 JSON; other formats are not
+// written. Components come sorted by name, version and ref, their licenses, hashes and
+// dependencies sorted as well, so the same components give the same bytes whatever order they
+// were uploaded in, and documents of consecutive versions differ only where the components do.
+func Write(w io.Writer, format Format, doc *Document) error {
+	components := slices.Clone(doc.Components)
+	slices.SortFunc(components, func(a, b *common.Component) int {
+		return cmp.Or(
+			strings.Compare(a.Name, b.Name),
+			strings.Compare(deref(a.Version), deref(b.Version)),
+			strings.Compare(a.Ref, b.Ref),
+		)
+	})
+
+	var v any
+	switch format {
+	case CycloneDXJSON:
+		v = exportCycloneDX(doc, components)
+	case SPDXJSON:
+		v = exportSPDX(doc, components)
+	default:
+		return fmt.Errorf("export to %s is not supported", format)
+	}
+	// purl с квалификаторами содержит &, который не нужно экранировать
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// exportCycloneDX описывает документ единственным основным компонентом, а если их нет или
+// несколько — самой версией приложения
+func exportCycloneDX(doc *Document, components []*common.Component) *cdxBOM {
+	bom := &cdxBOM{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  ExportCycloneDXVersion,
+		SerialNumber: "urn:uuid:" + nameUUID(doc.ID),
+		Version:      1,
+		Metadata: &cdxMetadata{
+			Timestamp: timestamp(doc.Created),
+			Tools:     &cdxTools{Components: []cdxComponent{{Type: "application", Name: ToolName}}},
+			Component: &cdxComponent{Type: "application", Name: doc.Name, Version: doc.Version},
+		},
+	}
+	primary := slices.DeleteFunc(slices.Clone(components), func(c *common.Component) bool { return !c.Primary })
+	for _, c := range components {
+		converted := cdxComponent{
+			Type:     "library",
+			BOMRef:   c.Ref,
+			Name:     c.Name,
+			Version:  deref(c.Version),
+			PURL:     deref(c.PURL),
+			Licenses: cdxLicenses(c.Licenses),
+		}
+		if c.Primary {
+			converted.Type = "application"
+		}
+		for _, alg := range slices.Sorted(maps.Keys(c.Hashes)) {
+			if _, ok := hashAlgorithms[hashKey(alg)]; ok {
+				converted.Hashes = append(converted.Hashes, cdxHash{Alg: HashAlgorithm(alg), Content: c.Hashes[alg]})
+			}
+		}
+		if len(primary) == 1 && c == primary[0] {
+			bom.Metadata.Component = &converted
+		} else {
+			bom.Components = append(bom.Components, converted)
+		}
+		// Компонент без зависимостей тоже перечисляется, чтобы было видно, что их нет
+		bom.Dependencies = append(bom.Dependencies, cdxDependency{Ref: c.Ref, DependsOn: slices.Sorted(slices.Values(c.DependsOn))})
+	}
+	return bom
+}
+
+// cdxLicenses пишет лицензии списком, а если среди них есть составное выражение — одним
+// выражением: CycloneDX не позволяет смешивать выражения с лицензиями
+func cdxLicenses(licenses []string) []cdxLicenseChoice {
+	if slices.ContainsFunc(licenses, compoundExpression) {
+		if expression, ok := joinLicenses(licenses); ok {
+			return []cdxLicenseChoice{{Expression: expression}}
+		}
+	}
+	var choices []cdxLicenseChoice
+	for _, license := range slices.Sorted(slices.Values(licenses)) {
+		if licenseID.MatchString(license) {
+			choices = append(choices, cdxLicenseChoice{License: &cdxLicense{ID: license}})
+		} else {
+			choices = append(choices, cdxLicenseChoice{License: &cdxLicense{Name: license}})
+		}
+	}
+	return choices
+}
+
+// exportSPDX описывает документом основные компоненты, а если их нет — компоненты, от которых
+// никто не зависит
+func exportSPDX(doc *Document, components []*common.Component) *spdxDocument {
+	out := &spdxDocument{
+		SPDXVersion:       SPDXVersion,
+		DataLicense:       "CC0-1.0",
+		SPDXID:            spdxDocumentID,
+		Name:              doc.Name + "@" + doc.Version,
+		DocumentNamespace: "urn:uuid:" + nameUUID(doc.ID),
+		CreationInfo:      &spdxCreationInfo{Created: timestamp(doc.Created), Creators: []string{"Tool: " + ToolName}},
+	}
+
+	ids := spdxIDs(components)
+	extracted := map[string]string{}
+	filesAnalyzed := false
+	for _, c := range components {
+		pkg := spdxPackage{
+			SPDXID:           ids[c.Ref],
+			Name:             c.Name,
+			VersionInfo:      deref(c.Version),
+			DownloadLocation: "NOASSERTION",
+			FilesAnalyzed:    &filesAnalyzed,
+			LicenseConcluded: "NOASSERTION",
+			LicenseDeclared:  spdxLicenseExpression(c.Licenses, extracted),
+		}
+		for _, alg := range slices.Sorted(maps.Keys(c.Hashes)) {
+			if spdxAlg, ok := spdxHashAlgorithm(alg); ok {
+				pkg.Checksums = append(pkg.Checksums, spdxChecksum{Algorithm: spdxAlg, ChecksumValue: c.Hashes[alg]})
+			}
+		}
+		if c.PURL != nil {
+			pkg.ExternalRefs = []spdxExternalRef{{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: *c.PURL}}
+		}
+		out.Packages = append(out.Packages, pkg)
+	}
+	for _, id := range slices.Sorted(maps.Keys(extracted)) {
+		out.HasExtractedLicensingInfos = append(out.HasExtractedLicensingInfos,
+			spdxExtractedLicensing{LicenseID: id, Name: extracted[id], ExtractedText: extracted[id]})
+	}
+
+	described := slices.DeleteFunc(slices.Clone(components), func(c *common.Component) bool { return !c.Primary })
+	if len(described) == 0 {
+		dependents := map[string]bool{}
+		for _, c := range components {
+			for _, ref := range c.DependsOn {
+				dependents[ref] = true
+			}
+		}
+		described = slices.DeleteFunc(slices.Clone(components), func(c *common.Component) bool { return dependents[c.Ref] })
+	}
+	for _, c := range described {
+		out.Relationships = append(out.Relationships, spdxRelationship{
+			SPDXElementID: spdxDocumentID, RelationshipType: "DESCRIBES", RelatedSPDXElement: ids[c.Ref],
+		})
+	}
+	for _, c := range components {
+		deps := make([]string, 0, len(c.DependsOn))
+		for _, ref := range c.DependsOn {
+			deps = append(deps, ids[ref])
+		}
+		slices.Sort(deps)
+		for _, dep := range deps {
+			out.Relationships = append(out.Relationships, spdxRelationship{
+				SPDXElementID: ids[c.Ref], RelationshipType: "DEPENDS_ON", RelatedSPDXElement: dep,
+			})
+		}
+	}
+	return out
+}
+
+// spdxIDPattern — допустимый SPDXID; ссылки CycloneDX вроде purl ему обычно не соответствуют
+var spdxIDPattern = regexp.MustCompile(`^SPDXRef-[A-Za-z0-9.-]+$`)
+
+var spdxIDInvalid = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+
+// sanitize заменяет недопустимые в SPDXID и LicenseRef-… символы дефисами
+func sanitize(s string) string {
+	return strings.Trim(spdxIDInvalid.ReplaceAllString(s, "-"), "-")
+}
+
+// spdxIDs сопоставляет ссылкам компонентов SPDXID. Недопустимые символы заменяются дефисами,
+// совпавшие после замены идентификаторы различаются номером в порядке компонентов
+func spdxIDs(components []*common.Component) map[string]string {
+	ids := map[string]string{}
+	used := map[string]bool{spdxDocumentID: true}
+	for _, c := range components {
+		if spdxIDPattern.MatchString(c.Ref) && !used[c.Ref] {
+			ids[c.Ref], used[c.Ref] = c.Ref, true
+		}
+	}
+	for _, c := range components {
+		if _, ok := ids[c.Ref]; ok {
+			continue
+		}
+		base := "SPDXRef-" + cmp.Or(sanitize(strings.TrimPrefix(c.Ref, "SPDXRef-")), "Package")
+		id := base
+		for n := 2; used[id]; n++ {
+			id = fmt.Sprintf("%s-%d", base, n)
+		}
+		ids[c.Ref], used[id] = id, true
+	}
+	return ids
+}
+
+// spdxLicenseExpression объединяет лицензии в выражение SPDX. Лицензия, которая не является
+// выражением, например "Apache License 2.0", заменяется на LicenseRef-…, а её текст
+// записывается в extracted
+func spdxLicenseExpression(licenses []string, extracted map[string]string) string {
+	if len(licenses) == 0 {
+		return "NOASSERTION"
+	}
+	valid := make([]string, 0, len(licenses))
+	for _, license := range licenses {
+		if _, ok := joinLicenses([]string{license}); ok {
+			valid = append(valid, license)
+			continue
+		}
+		id := "LicenseRef-" + cmp.Or(sanitize(license), "unknown")
+		extracted[id] = license
+		valid = append(valid, id)
+	}
+	expression, _ := joinLicenses(valid)
+	return expression
+}
+
+// licenseID — идентификатор лицензии SPDX или LicenseRef-…
+var licenseID = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9.+-]*$`)
+
+// joinLicenses объединяет выражения через AND, заключая составные в скобки, и сообщает, все ли
+// они — выражения SPDX
+func joinLicenses(licenses []string) (string, bool) {
+	parts := make([]string, 0, len(licenses))
+	for _, license := range slices.Sorted(slices.Values(licenses)) {
+		if !licenseExpression(license) {
+			return "", false
+		}
+		if compoundExpression(license) && len(licenses) > 1 {
+			license = "(" + license + ")"
+		}
+		parts = append(parts, license)
+	}
+	return strings.Join(parts, " AND "), true
+}
+
+// licenseExpression проверяет грамматику выражения SPDX: идентификаторы, AND, OR, WITH и скобки
+func licenseExpression(s string) bool {
+	tokens := strings.Fields(strings.NewReplacer("(", " ( ", ")", " ) ").Replace(s))
+	depth, operand := 0, true
+	for _, token := range tokens {
+		switch {
+		case token == "(" && operand:
+			depth++
+		case token == ")" && !operand && depth > 0:
+			depth--
+		case (token == "AND" || token == "OR" || token == "WITH") && !operand:
+			operand = true
+		case licenseID.MatchString(token) && operand:
+			operand = false
+		default:
+			return false
+		}
+	}
+	return len(tokens) > 0 && !operand && depth == 0
+}
+
+func compoundExpression(license string) bool {
+	return licenseExpression(license) && len(strings.Fields(license)) > 1
+}
+
+// spdxHashAlgorithms — алгоритмы контрольных сумм SPDX 2.3
+var spdxHashAlgorithms = []string{"SHA1", "SHA224", "SHA256", "SHA384", "SHA512", "SHA3-256", "SHA3-384", "SHA3-512",
+	"BLAKE2b-256", "BLAKE2b-384", "BLAKE2b-512", "BLAKE3", "MD2", "MD4", "MD5", "MD6", "ADLER32"}
+
+// spdxHashAlgorithm возвращает написание алгоритма в SPDX: SHA-256 пишется SHA256
+func spdxHashAlgorithm(alg string) (string, bool) {
+	for _, spdx := range spdxHashAlgorithms {
+		if hashKey(spdx) == hashKey(alg) {
+			return spdx, true
+		}
+	}
+	return "", false
+}
+
+func timestamp(t time.Time) string {
+	return t.UTC().Truncate(time.Second).Format(time.RFC3339)
+}
+
+// nameUUID возвращает UUID версии 5 для имени в пространстве URL (RFC 4122)
+func nameUUID(name string) string {
+	urlNamespace := []byte{0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	sum := sha1.Sum(append(urlNamespace, name...))
+	sum[6] = sum[6]&0x0f | 0x50
+	sum[8] = sum[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,143 @@
+package sbom
+
+import (
+	"bytes"
+	"data_processor/internal/common"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exportDocument(t *testing.T, format Format, components []*common.Component) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, format, &Document{
+		Name:       "web-shop",
+		Version:    "1.2.0",
+		ID:         "urn:example:versions:1:scans:7",
+		Created:    time.Date(2026, 10, 17, 12, 30, 45, 500, time.FixedZone("MSK", 3*60*60)),
+		Components: components,
+	}))
+	return buf.Bytes()
+}
+
+// sortedComponents упорядочивает компоненты и их зависимости по имени
+func sortedComponents(components []component) []component {
+	for i := range components {
+		slices.Sort(components[i].DependsOn)
+	}
+	slices.SortFunc(components, func(a, b component) int { return strings.Compare(a.Name, b.Name) })
+	return components
+}
+
+func TestExportRoundTrip(t *testing.T) {
+	for _, name := range []string{"cyclonedx.json", "spdx.spdx"} {
+		data, err := os.ReadFile(filepath.Join("testdata", name))
+		require.NoError(t, err)
+		components, err := Parse(data, "")
+		require.NoError(t, err)
+
+		for _, format := range []Format{CycloneDXJSON, SPDXJSON} {
+			t.Run(name+" to "+string(format), func(t *testing.T) {
+				exported, err := Parse(exportDocument(t, format, components), format)
+				require.NoError(t, err)
+				want := sortedComponents(slices.Clone(fixtureComponents))
+				assert.Equal(t, want, sortedComponents(normalize(exported)))
+			})
+		}
+	}
+}
+
+func TestExportDeterministic(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "cyclonedx.xml"))
+	require.NoError(t, err)
+	components, err := Parse(data, "")
+	require.NoError(t, err)
+	reversed := slices.Clone(components)
+	slices.Reverse(reversed)
+
+	for _, format := range []Format{CycloneDXJSON, SPDXJSON} {
+		assert.Equal(t, string(exportDocument(t, format, components)), string(exportDocument(t, format, reversed)))
+	}
+
+	var bom struct {
+		SerialNumber string `json:"serialNumber"`
+		Metadata     struct {
+			Timestamp string `json:"timestamp"`
+			Tools     struct {
+				Components []struct {
+					Name string `json:"name"`
+				} `json:"components"`
+			} `json:"tools"`
+			Component struct {
+				Name string `json:"name"`
+			} `json:"component"`
+		} `json:"metadata"`
+	}
+	require.NoError(t, json.Unmarshal(exportDocument(t, CycloneDXJSON, components), &bom))
+	// UUID версии 5 в пространстве URL, как uuid.uuid5(uuid.NAMESPACE_URL, ...) в Python
+	assert.Equal(t, "urn:uuid:f81e791e-2daf-573d-9ec6-57f049109896", bom.SerialNumber)
+	assert.Equal(t, "2026-10-17T09:30:45Z", bom.Metadata.Timestamp)
+	assert.Equal(t, ToolName, bom.Metadata.Tools.Components[0].Name)
+	assert.Equal(t, "web-shop", bom.Metadata.Component.Name)
+
+	var doc spdxDocument
+	require.NoError(t, json.Unmarshal(exportDocument(t, SPDXJSON, components), &doc))
+	assert.Equal(t, bom.SerialNumber, doc.DocumentNamespace)
+	assert.Equal(t, "2026-10-17T09:30:45Z", doc.CreationInfo.Created)
+	assert.Equal(t, []string{"Tool: " + ToolName}, doc.CreationInfo.Creators)
+}
+
+func TestExportSPDXIdentifiers(t *testing.T) {
+	version := "1.0"
+	components := []*common.Component{
+		{Ref: "pkg:npm/a@1.0", Name: "a", Version: &version, Licenses: []string{"Apache License 2.0", "MIT OR ISC"},
+			Hashes: map[string]string{"SHA-256": "ab", "ADLER32": "cd", "XXH64": "ef"}, DependsOn: []string{"pkg:npm/a@1.0?x=1"}},
+		{Ref: "pkg:npm/a@1.0?x=1", Name: "a", Version: &version, Licenses: []string{"MIT", "GPL-2.0-only WITH Classpath-exception-2.0"}},
+		{Ref: "SPDXRef-DOCUMENT", Name: "b"},
+	}
+
+	var doc spdxDocument
+	require.NoError(t, json.Unmarshal(exportDocument(t, SPDXJSON, components), &doc))
+	require.Len(t, doc.Packages, 3)
+	assert.Equal(t, "SPDXRef-pkg-npm-a-1.0", doc.Packages[0].SPDXID)
+	assert.Equal(t, "SPDXRef-pkg-npm-a-1.0-x-1", doc.Packages[1].SPDXID)
+	assert.Equal(t, "SPDXRef-DOCUMENT-2", doc.Packages[2].SPDXID)
+	assert.Equal(t, "LicenseRef-Apache-License-2.0 AND (MIT OR ISC)", doc.Packages[0].LicenseDeclared)
+	assert.Equal(t, "(GPL-2.0-only WITH Classpath-exception-2.0) AND MIT", doc.Packages[1].LicenseDeclared)
+	assert.Equal(t, "NOASSERTION", doc.Packages[2].LicenseDeclared)
+	assert.Equal(t, []spdxExtractedLicensing{
+		{LicenseID: "LicenseRef-Apache-License-2.0", Name: "Apache License 2.0", ExtractedText: "Apache License 2.0"},
+	}, doc.HasExtractedLicensingInfos)
+	assert.Equal(t, []spdxChecksum{{Algorithm: "ADLER32", ChecksumValue: "cd"}, {Algorithm: "SHA256", ChecksumValue: "ab"}},
+		doc.Packages[0].Checksums)
+
+	// Без основных компонентов документ описывает те, от которых никто не зависит
+	assert.Equal(t, []spdxRelationship{
+		{SPDXElementID: spdxDocumentID, RelationshipType: "DESCRIBES", RelatedSPDXElement: "SPDXRef-pkg-npm-a-1.0"},
+		{SPDXElementID: spdxDocumentID, RelationshipType: "DESCRIBES", RelatedSPDXElement: "SPDXRef-DOCUMENT-2"},
+		{SPDXElementID: "SPDXRef-pkg-npm-a-1.0", RelationshipType: "DEPENDS_ON", RelatedSPDXElement: "SPDXRef-pkg-npm-a-1.0-x-1"},
+	}, doc.Relationships)
+
+	var bom cdxBOM
+	require.NoError(t, json.Unmarshal(exportDocument(t, CycloneDXJSON, components), &bom))
+	require.Len(t, bom.Components, 3)
+	// Выражение с лицензией без идентификатора SPDX не составить, поэтому лицензии перечисляются
+	assert.Equal(t, []cdxLicenseChoice{{License: &cdxLicense{Name: "Apache License 2.0"}}, {License: &cdxLicense{Name: "MIT OR ISC"}}},
+		bom.Components[0].Licenses)
+	assert.Equal(t, []cdxLicenseChoice{{Expression: "(GPL-2.0-only WITH Classpath-exception-2.0) AND MIT"}}, bom.Components[1].Licenses)
+	assert.Equal(t, []cdxLicenseChoice{{License: &cdxLicense{ID: "MIT"}}}, cdxLicenses([]string{"MIT"}))
+	assert.Equal(t, []cdxHash{{Alg: "SHA-256", Content: "ab"}}, bom.Components[0].Hashes)
+}
+
+func TestExportUnsupportedFormat(t *testing.T) {
+	err := Write(&bytes.Buffer{}, CycloneDXXML, &Document{})
+	assert.EqualError(t, err, "export to cyclonedx-xml is not supported")
+}
@@ -0,0 +1,223 @@
+// Package sbom reads software bills of materials in CycloneDX 1.5 and 1.6 (JSON and XML) and
+// SPDX 2.3 (JSON and tag-value) into components:
+// https://cyclonedx.org/specification/overview/, https://spdx.github.io/spdx-spec/v2.3/
+//
+// Only packages and the dependencies between them are read; files, snippets, services,
+// vulnerabilities and other parts of a document are ignored. Components are written back as
+// CycloneDX 1.6 JSON or SPDX 2.3 JSON.
+package sbom
+
+import (
+	"bytes"
+	"data_processor/internal/common"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+type Format string
+
+const (
+	CycloneDXJSON Format = "cyclonedx-json"
+	CycloneDXXML  Format = "cyclonedx-xml"
+	SPDXJSON      Format = "spdx-json"
+	SPDXTagValue  Format = "spdx-tag-value"
+)
+
+// Error is an invalid document. Path points to the offending element, for example
+// components[2].purl, or to a line of a tag-value document; it is empty for syntax errors.
+type Error struct {
+	Path string
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	if e.Path == "" {
+		return e.Msg
+	}
+	return e.Path + ": " + e.Msg
+}
+
+func errorf(path, format string, args ...any) *Error {
+	return &Error{Path: path, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Detect tells the format of a document by its first characters and, for JSON, by the
+// property naming the format.
+func Detect(data []byte) (Format, error) {
+	data = bytes.TrimLeft(bytes.TrimPrefix(data, []byte("\ufeff")), " \t\r\n")
+	switch {
+	case len(data) == 0:
+		return "", errorf("", "document is empty")
+	case data[0] == '<':
+		return CycloneDXXML, nil
+	case data[0] == '{':
+		// Свойство формата обычно первое, поэтому документ не разбирается целиком
+		cdx, spdx := bytes.Index(data, []byte(`"bomFormat"`)), bytes.Index(data, []byte(`"spdxVersion"`))
+		switch {
+		case cdx >= 0 && (spdx < 0 || cdx < spdx):
+			return CycloneDXJSON, nil
+		case spdx >= 0:
+			return SPDXJSON, nil
+		}
+		return "", errorf("", "JSON document has neither bomFormat nor spdxVersion")
+	case bytes.Contains(data, []byte("SPDXVersion:")):
+		return SPDXTagValue, nil
+	}
+	return "", errorf("", "unknown document format, expected CycloneDX or SPDX")
+}
+
+// Parse reads the components of a document in the given format, or in the detected one if
+// format is empty. Components come in document order, nested CycloneDX components after their
+// parent. An invalid document is reported as *Error.
+func Parse(data []byte, format Format) ([]*common.Component, error) {
+	if format == "" {
+		detected, err := Detect(data)
+		if err != nil {
+			return nil, err
+		}
+		format = detected
+	}
+	switch format {
+	case CycloneDXJSON:
+		return parseCycloneDXJSON(data)
+	case CycloneDXXML:
+		return parseCycloneDXXML(data)
+	case SPDXJSON:
+		return parseSPDXJSON(data)
+	case SPDXTagValue:
+		return parseSPDXTagValue(data)
+	}
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+// decodeJSON разбирает документ целиком и переводит ошибки encoding/json в *Error с путём
+func decodeJSON(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	err := decoder.Decode(v)
+	if errors.Is(err, io.EOF) {
+		return errorf("", "document is empty")
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return errorf("", "document is truncated")
+	}
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return errorf("", "invalid JSON at offset %d: %v", syntaxErr.Offset, err)
+	}
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return errorf(jsonIndex.ReplaceAllString(typeErr.Field, "[$1]"), "must be %s, not %s", jsonType(typeErr.Type.Kind().String()), typeErr.Value)
+	}
+	if err != nil {
+		return err
+	}
+	if decoder.More() {
+		return errorf("", "unexpected data after the document")
+	}
+	return nil
+}
+
+// jsonIndex — индексы массивов в пути encoding/json ("components.0.hashes"), они пишутся как components[0]
+var jsonIndex = regexp.MustCompile(`\.(\d+)`)
+
+// jsonType называет тип Go так, как он выглядит в JSON
+func jsonType(kind string) string {
+	switch kind {
+	case "struct", "map":
+		return "an object"
+	case "slice", "array":
+		return "an array"
+	case "string":
+		return "a string"
+	case "bool":
+		return "a boolean"
+	}
+	return "a number"
+}
+
+// builder собирает компоненты документа и проверяет ссылки между ними
+type builder struct {
+	components []*common.Component
+	byRef      map[string]*common.Component
+	edges      map[[2]string]bool
+}
+
+func newBuilder() *builder {
+	return &builder{byRef: map[string]*common.Component{}, edges: map[[2]string]bool{}}
+}
+
+// add проверяет компонент; field возвращает путь к его полю ref, name или purl
+func (b *builder) add(c *common.Component, field func(name string) string) error {
+	if c.Ref == "" {
+		return errorf(field("ref"), "is required")
+	}
+	if _, ok := b.byRef[c.Ref]; ok {
+		return errorf(field("ref"), "duplicate reference %q", c.Ref)
+	}
+	if strings.TrimSpace(c.Name) == "" {
+		return errorf(field("name"), "is required")
+	}
+	if c.PURL != nil && !purlPattern.MatchString(*c.PURL) {
+		return errorf(field("purl"), "invalid package URL %q", *c.PURL)
+	}
+	b.byRef[c.Ref] = c
+	b.components = append(b.components, c)
+	return nil
+}
+
+func (b *builder) depend(from, to string) {
+	if !b.edges[[2]string{from, to}] {
+		b.edges[[2]string{from, to}] = true
+		b.byRef[from].DependsOn = append(b.byRef[from].DependsOn, to)
+	}
+}
+
+// purlPattern — package URL вида pkg:type/namespace/name@version:
+// https://github.com/package-url/purl-spec
+var purlPattern = regexp.MustCompile(`^pkg:[A-Za-z.+-][A-Za-z0-9.+-]*/[^@?#]*[^/@?#]`)
+
+// hashAlgorithms — написание алгоритмов CycloneDX по имени без дефисов в верхнем регистре;
+// так же пишет их SPDX, кроме SHA-1 и SHA-2 (SHA256)
+var hashAlgorithms = map[string]string{}
+
+func init() {
+	for _, alg := range []string{"MD5", "SHA-1", "SHA-256", "SHA-384", "SHA-512", "SHA3-256", "SHA3-384", "SHA3-512",
+		"BLAKE2b-256", "BLAKE2b-384", "BLAKE2b-512", "BLAKE3"} {
+		hashAlgorithms[hashKey(alg)] = alg
+	}
+}
+
+func hashKey(alg string) string {
+	return strings.ToUpper(strings.ReplaceAll(alg, "-", ""))
+}
+
+// HashAlgorithm returns the CycloneDX spelling of a hash algorithm, e.g. SHA-256 for SPDX
+// SHA256. Algorithms CycloneDX does not know are returned as is.
+func HashAlgorithm(alg string) string {
+	if canonical, ok := hashAlgorithms[hashKey(alg)]; ok {
+		return canonical
+	}
+	return alg
+}
+
+func addHash(c *common.Component, alg, value string) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if alg == "" || value == "" {
+		return
+	}
+	if c.Hashes == nil {
+		c.Hashes = map[string]string{}
+	}
+	c.Hashes[HashAlgorithm(alg)] = value
+}
+
+func optional(s string) *string {
+	if s = strings.TrimSpace(s); s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,164 @@
+package sbom
+
+import (
+	"data_processor/internal/common"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// component — компонент без ссылок, которые в разных форматах разные
+type component struct {
+	Name      string
+	Version   string
+	PURL      string
+	Primary   bool
+	Licenses  []string
+	Hashes    map[string]string
+	DependsOn []string
+}
+
+func readFixture(t *testing.T, name string, format Format) []component {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	detected, err := Detect(data)
+	require.NoError(t, err)
+	require.Equal(t, format, detected)
+
+	components, err := Parse(data, "")
+	require.NoError(t, err)
+	return normalize(components)
+}
+
+// normalize заменяет ссылки зависимостей именами компонентов
+func normalize(components []*common.Component) []component {
+	names := map[string]string{}
+	for _, c := range components {
+		names[c.Ref] = c.Name
+	}
+	var result []component
+	for _, c := range components {
+		converted := component{Name: c.Name, Primary: c.Primary, Licenses: c.Licenses, Hashes: c.Hashes}
+		if c.Version != nil {
+			converted.Version = *c.Version
+		}
+		if c.PURL != nil {
+			converted.PURL = *c.PURL
+		}
+		for _, ref := range c.DependsOn {
+			converted.DependsOn = append(converted.DependsOn, names[ref])
+		}
+		result = append(result, converted)
+	}
+	return result
+}
+
+// fixtureComponents — компоненты, одинаковые во всех документах testdata
+var fixtureComponents = []component{
+	{Name: "web-shop", Version: "1.2.0", PURL: "pkg:npm/web-shop@1.2.0", Primary: true, DependsOn: []string{"express", "lodash"}},
+	{Name: "express", Version: "4.18.2", PURL: "pkg:npm/express@4.18.2", Licenses: []string{"MIT"},
+		Hashes:    map[string]string{"SHA-512": "5b3ac5d2c6c7b2f3a1c0e1d9f8e7d6c5b4a3928170f6e5d4c3b2a1908f7e6d5c4b3a29180f7e6d5c4b3a29180f7e6d5c4b3a29180f7e6d5c4b3a29180f7e6d5c4"},
+		DependsOn: []string{"body-parser"}},
+	{Name: "body-parser", Version: "1.20.1", PURL: "pkg:npm/body-parser@1.20.1", Licenses: []string{"MIT"}},
+	{Name: "lodash", Version: "4.17.21", PURL: "pkg:npm/lodash@4.17.21", Licenses: []string{"MIT OR CC0-1.0"},
+		Hashes: map[string]string{"SHA-1": "679591c564c3bffaae8454cf0b3df370c3d6911c"}},
+}
+
+func TestParseFormats(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+	}{
+		{"cyclonedx.json", CycloneDXJSON},
+		{"cyclonedx.xml", CycloneDXXML},
+		{"spdx.json", SPDXJSON},
+		{"spdx.spdx", SPDXTagValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, fixtureComponents, readFixture(t, tt.name, tt.format))
+		})
+	}
+}
+
+func TestParseCycloneDXRefs(t *testing.T) {
+	components, err := Parse([]byte(`{"bomFormat": "CycloneDX", "specVersion": "1.6", "components": [
+		{"group": "org.apache.commons", "name": "commons-lang3", "version": "3.14.0",
+			"purl": "pkg:maven/org.apache.commons/commons-lang3@3.14.0", "licenses": [{"license": {"name": "Apache License 2.0"}}]},
+		{"name": "unreferenced"}
+	]}`), CycloneDXJSON)
+	require.NoError(t, err)
+	require.Len(t, components, 2)
+
+	// Без bom-ref ссылкой служит путь
+	assert.Equal(t, "org.apache.commons/commons-lang3", components[0].Name)
+	assert.Equal(t, "components[0]", components[0].Ref)
+	assert.Equal(t, []string{"Apache License 2.0"}, components[0].Licenses)
+	assert.Equal(t, "components[1]", components[1].Ref)
+	assert.False(t, components[1].Primary)
+}
+
+func TestHashAlgorithm(t *testing.T) {
+	assert.Equal(t, "SHA-256", HashAlgorithm("SHA256"))
+	assert.Equal(t, "SHA-256", HashAlgorithm("sha-256"))
+	assert.Equal(t, "SHA3-512", HashAlgorithm("SHA3-512"))
+	assert.Equal(t, "BLAKE2b-256", HashAlgorithm("BLAKE2B-256"))
+	assert.Equal(t, "ADLER32", HashAlgorithm("ADLER32"))
+}
+
+func TestParseErrors(t *testing.T) {
+	cdx := func(body string) string {
+		return `{"bomFormat": "CycloneDX", "specVersion": "1.5", ` + body + `}`
+	}
+	spdx := func(body string) string {
+		return `{"spdxVersion": "SPDX-2.3", ` + body + `}`
+	}
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{"empty", ``, "document is empty"},
+		{"unknown format", `name,version`, "unknown document format"},
+		{"json without format", `{"components": []}`, "neither bomFormat nor spdxVersion"},
+		{"truncated", `{"bomFormat": "CycloneDX", `, "document is truncated"},
+		{"cyclonedx version", `{"bomFormat": "CycloneDX", "specVersion": "1.4"}`, `specVersion: unsupported version "1.4"`},
+		{"type", cdx(`"components": [{"name": 1}]`), "components[0].name: must be a string"},
+		{"name", cdx(`"components": [{"bom-ref": "a", "name": "a", "components": [{"bom-ref": "b"}]}]`), "components[0].components[0].name: is required"},
+		{"purl", cdx(`"components": [{"name": "a", "purl": "npm/a@1"}]`), `components[0].purl: invalid package URL "npm/a@1"`},
+		{"duplicate ref", cdx(`"components": [{"bom-ref": "a", "name": "a"}, {"bom-ref": "a", "name": "b"}]`),
+			`components[1].bom-ref: duplicate reference "a"`},
+		{"license", cdx(`"components": [{"name": "a", "licenses": [{"license": {}}]}]`), "components[0].licenses[0]: needs a license id"},
+		{"dependency", cdx(`"components": [{"bom-ref": "a", "name": "a"}], "dependencies": [{"ref": "a", "dependsOn": ["b"]}]`),
+			`dependencies[0].dependsOn[0]: unknown bom-ref "b"`},
+		{"xml syntax", `<bom xmlns="http://cyclonedx.org/schema/bom/1.5"><components></bom>`, "invalid XML at line 1"},
+		{"xml namespace", `<bom xmlns="http://cyclonedx.org/schema/bom/1.3"/>`, `bom: unsupported namespace`},
+		{"xml root", `<sbom/>`, "root element must be bom"},
+		{"spdx version", `{"spdxVersion": "SPDX-2.2"}`, `spdxVersion: unsupported version "SPDX-2.2"`},
+		{"spdx id", spdx(`"packages": [{"name": "a"}]`), "packages[0].SPDXID: is required"},
+		{"spdx purl", spdx(`"packages": [{"SPDXID": "SPDXRef-a", "name": "a",
+			"externalRefs": [{"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "a@1"}]}]`),
+			`packages[0].externalRefs: invalid package URL "a@1"`},
+		{"spdx relationship", spdx(`"relationships": [{"spdxElementId": "SPDXRef-a", "relationshipType": "DEPENDS_ON"}]`),
+			"relationships[0]: DEPENDS_ON needs both elements"},
+		{"tag-value version", "SPDXVersion: SPDX-2.1\n", `line 1 (SPDXVersion): unsupported version "SPDX-2.1"`},
+		{"tag-value line", "SPDXVersion: SPDX-2.3\nPackageName\n", "line 2: expected Tag: value"},
+		{"tag-value text", "SPDXVersion: SPDX-2.3\nDocumentComment: <text>open\n", "line 2: <text> is not closed"},
+		{"tag-value package", "SPDXVersion: SPDX-2.3\n\nPackageName: a\nPackageVersion: 1\n", "package at line 3: SPDXID: is required"},
+		{"tag-value relationship", "SPDXVersion: SPDX-2.3\nRelationship: SPDXRef-a DEPENDS_ON\n",
+			"line 2 (Relationship): expected element, relationship type and related element"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.doc), "")
+			require.Error(t, err)
+			var sbomErr *Error
+			require.ErrorAs(t, err, &sbomErr)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package sbom
+
+import (
+	"bufio"
+	"bytes"
+	"data_processor/internal/common"
+	"fmt"
+	"strings"
+)
+
+// SPDXVersion is the only supported SPDX version.
+const SPDXVersion = "SPDX-2.3"
+
+// spdxDocumentID — SPDXID самого документа
+const spdxDocumentID = "SPDXRef-DOCUMENT"
+
+// Поля, которые только пишутся, tag-value не читает
+type spdxDocument struct {
+	SPDXVersion                string                   `json:"spdxVersion"`
+	DataLicense                string                   `json:"dataLicense,omitempty"`
+	SPDXID                     string                   `json:"SPDXID"`
+	Name                       string                   `json:"name,omitempty"`
+	DocumentNamespace          string                   `json:"documentNamespace,omitempty"`
+	CreationInfo               *spdxCreationInfo        `json:"creationInfo,omitempty"`
+	DocumentDescribes          []string                 `json:"documentDescribes,omitempty"`
+	Packages                   []spdxPackage            `json:"packages,omitempty"`
+	HasExtractedLicensingInfos []spdxExtractedLicensing `json:"hasExtractedLicensingInfos,omitempty"`
+	Relationships              []spdxRelationship       `json:"relationships,omitempty"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation,omitempty"`
+	FilesAnalyzed    *bool             `json:"filesAnalyzed,omitempty"`
+	Checksums        []spdxChecksum    `json:"checksums,omitempty"`
+	LicenseConcluded string            `json:"licenseConcluded,omitempty"`
+	LicenseDeclared  string            `json:"licenseDeclared,omitempty"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+// spdxExtractedLicensing — лицензия без идентификатора SPDX, на которую ссылается LicenseRef-…
+type spdxExtractedLicensing struct {
+	LicenseID     string `json:"licenseId"`
+	Name          string `json:"name"`
+	ExtractedText string `json:"extractedText"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+func parseSPDXJSON(data []byte) ([]*common.Component, error) {
+	var doc spdxDocument
+	if err := decodeJSON(data, &doc); err != nil {
+		return nil, err
+	}
+	return convertSPDX(&doc, spdxJSONPaths{})
+}
+
+// spdxPaths называет элементы документа в ошибках: пути JSON или строки tag-value.
+// Поле пакета — ref, name или purl, как в builder.add
+type spdxPaths interface {
+	version() string
+	packageField(i int, field string) string
+	relationship(i int) string
+}
+
+type spdxJSONPaths struct{}
+
+var spdxJSONFields = map[string]string{"ref": "SPDXID", "name": "name", "purl": "externalRefs"}
+
+func (spdxJSONPaths) version() string { return "spdxVersion" }
+
+func (spdxJSONPaths) packageField(i int, field string) string {
+	return fmt.Sprintf("packages[%d].%s", i, spdxJSONFields[field])
+}
+
+func (spdxJSONPaths) relationship(i int) string { return fmt.Sprintf("relationships[%d]", i) }
+
+// convertSPDX переводит пакеты в компоненты. Пакеты, которые описывает документ, — основные.
+// Связи DEPENDS_ON и *DEPENDENCY_OF между пакетами становятся зависимостями; связи с файлами,
+// внешними документами, NONE и NOASSERTION пропускаются.
+func convertSPDX(doc *spdxDocument, paths spdxPaths) ([]*common.Component, error) {
+	if doc.SPDXVersion != SPDXVersion {
+		return nil, errorf(paths.version(), "unsupported version %q, expected %q", doc.SPDXVersion, SPDXVersion)
+	}
+
+	b := newBuilder()
+	for i, pkg := range doc.Packages {
+		component := &common.Component{
+			Ref:     pkg.SPDXID,
+			Name:    pkg.Name,
+			Version: optional(pkg.VersionInfo),
+		}
+		if license := spdxLicense(pkg.LicenseConcluded, pkg.LicenseDeclared); license != "" {
+			component.Licenses = []string{license}
+		}
+		for _, checksum := range pkg.Checksums {
+			addHash(component, checksum.Algorithm, checksum.ChecksumValue)
+		}
+		for _, ref := range pkg.ExternalRefs {
+			category := strings.ReplaceAll(ref.ReferenceCategory, "_", "-")
+			if category == "PACKAGE-MANAGER" && ref.ReferenceType == "purl" && component.PURL == nil {
+				component.PURL = optional(ref.ReferenceLocator)
+			}
+		}
+		if err := b.add(component, func(field string) string { return paths.packageField(i, field) }); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, id := range doc.DocumentDescribes {
+		if c, ok := b.byRef[id]; ok {
+			c.Primary = true
+		}
+	}
+	for i, rel := range doc.Relationships {
+		from, to := rel.SPDXElementID, rel.RelatedSPDXElement
+		switch kind := strings.ToUpper(rel.RelationshipType); {
+		case kind == "DESCRIBES" && from == spdxDocumentID:
+			if c, ok := b.byRef[to]; ok {
+				c.Primary = true
+			}
+		case kind == "DESCRIBED_BY" && to == spdxDocumentID:
+			if c, ok := b.byRef[from]; ok {
+				c.Primary = true
+			}
+		case kind == "DEPENDS_ON", strings.HasSuffix(kind, "DEPENDENCY_OF"):
+			if kind != "DEPENDS_ON" {
+				from, to = to, from
+			}
+			if from == "" || to == "" {
+				return nil, errorf(paths.relationship(i), "%s needs both elements", rel.RelationshipType)
+			}
+			_, fromPackage := b.byRef[from]
+			_, toPackage := b.byRef[to]
+			if fromPackage && toPackage {
+				b.depend(from, to)
+			}
+		}
+	}
+	return b.components, nil
+}
+
+// spdxLicense возвращает установленную лицензию пакета, а если её нет — заявленную
+func spdxLicense(concluded, declared string) string {
+	for _, license := range []string{concluded, declared} {
+		if license = strings.TrimSpace(license); license != "" && license != "NOASSERTION" && license != "NONE" {
+			return license
+		}
+	}
+	return ""
+}
+
+type spdxTagValuePaths struct {
+	versionLine       int
+	packageLines      []int
+	relationshipLines []int
+}
+
+var spdxTagValueFields = map[string]string{"ref": "SPDXID", "name": "PackageName", "purl": "ExternalRef"}
+
+func (p *spdxTagValuePaths) version() string {
+	if p.versionLine == 0 {
+		return "SPDXVersion"
+	}
+	return fmt.Sprintf("line %d (SPDXVersion)", p.versionLine)
+}
+
+func (p *spdxTagValuePaths) packageField(i int, field string) string {
+	return fmt.Sprintf("package at line %d: %s", p.packageLines[i], spdxTagValueFields[field])
+}
+
+func (p *spdxTagValuePaths) relationship(i int) string {
+	return fmt.Sprintf("line %d (Relationship)", p.relationshipLines[i])
+}
+
+// parseSPDXTagValue читает документ вида "Tag: value". Теги пакета относятся к последнему
+// PackageName до начала файла или фрагмента; многострочные значения заключены в <text>…</text>.
+func parseSPDXTagValue(data []byte) ([]*common.Component, error) {
+	var doc spdxDocument
+	paths := &spdxTagValuePaths{}
+	var pkg *spdxPackage
+	inFile := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, 1<<20)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		tag, value, ok := strings.Cut(text, ":")
+		if !ok {
+			return nil, errorf(fmt.Sprintf("line %d", line), "expected Tag: value")
+		}
+		tag, value = strings.TrimSpace(tag), strings.TrimSpace(value)
+		if strings.HasPrefix(value, "<text>") {
+			start := line
+			for !strings.Contains(value, "</text>") {
+				if !scanner.Scan() {
+					return nil, errorf(fmt.Sprintf("line %d", start), "<text> is not closed")
+				}
+				line++
+				value += "\n" + scanner.Text()
+			}
+			value = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(value), "<text>"), "</text>"))
+		}
+
+		path := fmt.Sprintf("line %d (%s)", line, tag)
+		switch tag {
+		case "SPDXVersion":
+			doc.SPDXVersion, paths.versionLine = value, line
+		case "SPDXID":
+			switch {
+			case pkg != nil && !inFile:
+				pkg.SPDXID = value
+			case pkg == nil && !inFile:
+				doc.SPDXID = value
+			}
+		case "PackageName":
+			doc.Packages = append(doc.Packages, spdxPackage{Name: value})
+			pkg, inFile = &doc.Packages[len(doc.Packages)-1], false
+			paths.packageLines = append(paths.packageLines, line)
+		case "FileName", "SnippetSPDXID", "LicenseID":
+			inFile = true
+		case "Relationship":
+			fields := strings.Fields(value)
+			if len(fields) != 3 {
+				return nil, errorf(path, "expected element, relationship type and related element")
+			}
+			doc.Relationships = append(doc.Relationships, spdxRelationship{
+				SPDXElementID: fields[0], RelationshipType: fields[1], RelatedSPDXElement: fields[2],
+			})
+			paths.relationshipLines = append(paths.relationshipLines, line)
+		}
+		if pkg == nil || inFile {
+			continue
+		}
+
+		switch tag {
+		case "PackageVersion":
+			pkg.VersionInfo = value
+		case "PackageLicenseConcluded":
+			pkg.LicenseConcluded = value
+		case "PackageLicenseDeclared":
+			pkg.LicenseDeclared = value
+		case "PackageChecksum":
+			alg, checksum, ok := strings.Cut(value, ":")
+			if !ok {
+				return nil, errorf(path, "expected algorithm: value")
+			}
+			pkg.Checksums = append(pkg.Checksums, spdxChecksum{Algorithm: strings.TrimSpace(alg), ChecksumValue: strings.TrimSpace(checksum)})
+		case "ExternalRef":
+			fields := strings.Fields(value)
+			if len(fields) != 3 {
+				return nil, errorf(path, "expected category, type and locator")
+			}
+			pkg.ExternalRefs = append(pkg.ExternalRefs, spdxExternalRef{
+				ReferenceCategory: fields[0], ReferenceType: fields[1], ReferenceLocator: fields[2],
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errorf("", "failed to read the document: %v", err)
+	}
+	if doc.SPDXVersion == "" && len(doc.Packages) == 0 {
+		return nil, errorf("", "document is empty")
+	}
+	return convertSPDX(&doc, paths)
+}
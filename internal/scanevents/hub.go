@@ -0,0 +1,110 @@
+// Package scanevents delivers changes of scans to the WatchScan streams of this replica.
+//
+// Changes are made by any replica and announced by Postgres NOTIFY on the scan_changes channel;
+// Run listens for them and wakes the subscriptions of the changed scan. A notification only
+// tells that the scan changed: subscribers read its current state themselves, so notifications
+// may be coalesced, and a subscriber that was woken up but finds nothing new simply waits again.
+package scanevents
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// ListenFunc calls fn with the id of every changed scan until ctx is cancelled or the connection
+// is lost.
+type ListenFunc func(ctx context.Context, fn func(scanID int)) error
+
+// Hub keeps the subscriptions of this replica. The zero value is not usable, use NewHub.
+type Hub struct {
+	mu   sync.Mutex
+	subs map[int]map[*Subscription]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{subs: make(map[int]map[*Subscription]struct{})}
+}
+
+// Subscription is woken up through C whenever its scan may have changed. Wake-ups that arrive
+// while the previous one was not yet received are merged into it.
+type Subscription struct {
+	C <-chan struct{}
+
+	c      chan struct{}
+	hub    *Hub
+	scanID int
+}
+
+// Subscribe starts watching the scan. The subscription must be closed when it is no longer
+// needed.
+func (h *Hub) Subscribe(scanID int) *Subscription {
+	c := make(chan struct{}, 1)
+	sub := &Subscription{C: c, c: c, hub: h, scanID: scanID}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subs[scanID] == nil {
+		h.subs[scanID] = make(map[*Subscription]struct{})
+	}
+	h.subs[scanID][sub] = struct{}{}
+	return sub
+}
+
+// Close stops the subscription. It is safe to call Close more than once.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	subs := s.hub.subs[s.scanID]
+	delete(subs, s)
+	if len(subs) == 0 {
+		delete(s.hub.subs, s.scanID)
+	}
+}
+
+// Notify wakes up the subscriptions of the scan.
+func (h *Hub) Notify(scanID int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs[scanID] {
+		sub.wake()
+	}
+}
+
+// notifyAll будит все подписки: изменения, пришедшие без соединения, потеряны
+func (h *Hub) notifyAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, subs := range h.subs {
+		for sub := range subs {
+			sub.wake()
+		}
+	}
+}
+
+func (s *Subscription) wake() {
+	select {
+	case s.c <- struct{}{}:
+	default:
+	}
+}
+
+// Run feeds the hub from listen until ctx is cancelled. A lost connection is reopened after
+// retry; since notifications sent in between are lost, every subscription is woken up then.
+func (h *Hub) Run(ctx context.Context, listen ListenFunc, retry time.Duration) {
+	for {
+		err := listen(ctx, h.Notify)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("scan change notifications interrupted: %v", err)
+		h.notifyAll()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retry):
+		}
+	}
+}
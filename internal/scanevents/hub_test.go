@@ -0,0 +1,86 @@
+package scanevents
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func woken(sub *Subscription) bool {
+	select {
+	case <-sub.C:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestHub(t *testing.T) {
+	h := NewHub()
+	first := h.Subscribe(1)
+	second := h.Subscribe(1)
+	other := h.Subscribe(2)
+
+	// Несколько изменений до чтения сливаются в одно пробуждение
+	h.Notify(1)
+	h.Notify(1)
+	assert.True(t, woken(first))
+	assert.False(t, woken(first))
+	assert.True(t, woken(second))
+	assert.False(t, woken(other))
+
+	first.Close()
+	first.Close()
+	h.Notify(1)
+	assert.False(t, woken(first))
+	assert.True(t, woken(second))
+
+	second.Close()
+	other.Close()
+	assert.Empty(t, h.subs)
+}
+
+func TestHubRun(t *testing.T) {
+	h := NewHub()
+	sub := h.Subscribe(5)
+	defer sub.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	proceed := make(chan struct{})
+	listen := func(ctx context.Context, fn func(int)) error {
+		calls++
+		if calls == 1 {
+			return errors.New("connection lost")
+		}
+		<-proceed
+		fn(5)
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		h.Run(ctx, listen, time.Millisecond)
+		close(done)
+	}()
+
+	// Обрыв соединения будит подписку: уведомления за это время потеряны
+	select {
+	case <-sub.C:
+	case <-time.After(time.Second):
+		t.Fatal("subscription was not woken up after the connection was lost")
+	}
+	close(proceed)
+	select {
+	case <-sub.C:
+	case <-time.After(time.Second):
+		t.Fatal("subscription was not woken up by a notification")
+	}
+	cancel()
+	<-done
+	assert.Equal(t, 2, calls)
+}
@@ -3,8 +3,6 @@ package data_processor
 import (
 	"context"
 	"data_processor/internal/common"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -16,7 +14,7 @@ func (s *Server) CreateApplication(ctx context.Context, req *CreateApplicationRe
 	}
 
 	if err := s.repositories.CreateApplication(ctx, app); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create application: %v", err)
+		return nil, repoError(err, "create application")
 	}
 
 	return &Application{
@@ -24,16 +22,14 @@ func (s *Server) CreateApplication(ctx context.Context, req *CreateApplicationRe
 		Name:        app.Name,
 		Description: app.Description,
 		TeamId:      int32(app.TeamID),
+		Etag:        etag(app.Revision),
 	}, nil
 }
 
 func (s *Server) GetApplication(ctx context.Context, req *GetApplicationRequest) (*Application, error) {
 	app, err := s.repositories.GetApplicationByID(ctx, int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get application: %v", err)
-	}
-	if app == nil {
-		return nil, status.Errorf(codes.NotFound, "application not found")
+		return nil, repoError(err, "get application")
 	}
 
 	return &Application{
@@ -41,16 +37,14 @@ func (s *Server) GetApplication(ctx context.Context, req *GetApplicationRequest)
 		Name:        app.Name,
 		Description: app.Description,
 		TeamId:      int32(app.TeamID),
+		Etag:        etag(app.Revision),
 	}, nil
 }
 
 func (s *Server) GetApplicationByName(ctx context.Context, req *GetApplicationByNameRequest) (*Application, error) {
 	app, err := s.repositories.GetApplicationByName(ctx, req.Name)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get application: %v", err)
-	}
-	if app == nil {
-		return nil, status.Errorf(codes.NotFound, "application not found")
+		return nil, repoError(err, "get application")
 	}
 
 	return &Application{
@@ -58,79 +52,91 @@ func (s *Server) GetApplicationByName(ctx context.Context, req *GetApplicationBy
 		Name:        app.Name,
 		Description: app.Description,
 		TeamId:      int32(app.TeamID),
+		Etag:        etag(app.Revision),
 	}, nil
 }
 
 func (s *Server) UpdateApplication(ctx context.Context, req *UpdateApplicationRequest) (*Application, error) {
-	// Получаем текущее состояние приложения
-	currentApp, err := s.repositories.GetApplicationByID(ctx, int(req.Id))
+	fields, err := updatePaths(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current application: %v", err)
+		return nil, err
 	}
-	if currentApp == nil {
-		return nil, status.Errorf(codes.NotFound, "application not found")
+	if err := requireMaskedIDs(req, fields, "team_id"); err != nil {
+		return nil, err
 	}
-
-	// Подготавливаем обновленные данные
-	updatedApp := &common.Application{
-		ID: int(req.Id),
-	}
-
-	// Обрабатываем Name
-	if req.Name != nil {
-		updatedApp.Name = *req.Name
-	} else {
-		updatedApp.Name = currentApp.Name
+	revision, err := revisionFromEtag(req.Etag)
+	if err != nil {
+		return nil, err
 	}
-
-	// Обрабатываем Description
-	if req.Description != nil {
-		updatedApp.Description = req.Description
-	} else {
-		updatedApp.Description = currentApp.Description
+	if len(fields) == 0 {
+		app, err := s.GetApplication(ctx, &GetApplicationRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		if err := checkEtag(app.Etag, revision, "update application"); err != nil {
+			return nil, err
+		}
+		return app, nil
 	}
 
-	// Обрабатываем TeamID
-	if req.TeamId != nil {
-		updatedApp.TeamID = int(*req.TeamId)
-	} else {
-		updatedApp.TeamID = currentApp.TeamID
+	app := &common.Application{
+		ID:          int(req.Id),
+		Revision:    revision,
+		Name:        req.GetName(),
+		Description: req.Description,
+		TeamID:      int(req.GetTeamId()),
 	}
-
-	// Обновляем приложение
-	if err := s.repositories.UpdateApplication(ctx, updatedApp); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update application: %v", err)
+	updatedApp, err := s.repositories.UpdateApplication(ctx, app, fields)
+	if err != nil {
+		return nil, repoError(err, "update application")
 	}
 
-	// Возвращаем обновленное приложение
 	return &Application{
 		Id:          int32(updatedApp.ID),
 		Name:        updatedApp.Name,
 		Description: updatedApp.Description,
 		TeamId:      int32(updatedApp.TeamID),
+		Etag:        etag(updatedApp.Revision),
 	}, nil
 }
 
 func (s *Server) DeleteApplication(ctx context.Context, req *DeleteApplicationRequest) (*emptypb.Empty, error) {
-	if err := s.repositories.DeleteApplication(ctx, int(req.Id)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete application: %v", err)
+	revision, err := revisionFromEtag(req.Etag)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repositories.DeleteApplication(ctx, int(req.Id), revision); err != nil {
+		return nil, repoError(err, "delete application")
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) ListApplications(ctx context.Context, req *ListApplicationsRequest) (*ListApplicationsResponse, error) {
-	apps, err := s.repositories.ListApplications(ctx)
+	page, err := s.pageFromRequest(req, "applications")
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list applications: %v", err)
+		return nil, err
 	}
 
-	resp := &ListApplicationsResponse{}
+	apps, total, err := s.repositories.ListApplications(ctx, page.Page)
+	if err != nil {
+		return nil, listError(err, "applications")
+	}
+
+	apps, nextPageToken, err := nextPage(s, apps, page, func(item *common.Application) common.PageKey {
+		return common.PageKey{ID: item.ID}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListApplicationsResponse{TotalCount: int32(total), NextPageToken: nextPageToken}
 	for _, app := range apps {
 		resp.Applications = append(resp.Applications, &Application{
 			Id:          int32(app.ID),
 			Name:        app.Name,
 			Description: app.Description,
 			TeamId:      int32(app.TeamID),
+			Etag:        etag(app.Revision),
 		})
 	}
 
@@ -140,7 +146,7 @@ func (s *Server) ListApplications(ctx context.Context, req *ListApplicationsRequ
 func (s *Server) ListApplicationsByTeam(ctx context.Context, req *ListByParentRequest) (*ListApplicationsResponse, error) {
 	apps, err := s.repositories.ListApplicationsByTeam(ctx, int(req.ParentId))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list applications by team: %v", err)
+		return nil, repoError(err, "list applications by team")
 	}
 
 	resp := &ListApplicationsResponse{}
@@ -150,6 +156,7 @@ func (s *Server) ListApplicationsByTeam(ctx context.Context, req *ListByParentRe
 			Name:        app.Name,
 			Description: app.Description,
 			TeamId:      int32(app.TeamID),
+			Etag:        etag(app.Revision),
 		})
 	}
 
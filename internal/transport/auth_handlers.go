@@ -0,0 +1,173 @@
+package data_processor
+
+import (
+	"context"
+	"data_processor/internal/auth"
+	"data_processor/internal/common"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) Login(ctx context.Context, req *LoginRequest) (*TokenPair, error) {
+	user, err := s.repositories.VerifyCredentials(ctx, req.Name, req.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify credentials: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
+	}
+
+	refreshToken, hash, err := auth.NewRefreshToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create session: %v", err)
+	}
+
+	session := &common.Session{
+		UserID:           user.ID,
+		RefreshTokenHash: hash,
+		UserAgent:        userAgent(ctx),
+	}
+	if err := s.repositories.CreateSession(ctx, session, s.tokens.RefreshTTL()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create session: %v", err)
+	}
+
+	return s.issueTokenPair(session, refreshToken)
+}
+
+func (s *Server) Refresh(ctx context.Context, req *RefreshRequest) (*TokenPair, error) {
+	if req.RefreshToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "refresh token cannot be empty")
+	}
+
+	// Каждый refresh token одноразовый: при обновлении выдаём новый
+	refreshToken, hash, err := auth.NewRefreshToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to refresh session: %v", err)
+	}
+
+	session, err := s.repositories.RotateSessionToken(ctx, auth.HashRefreshToken(req.RefreshToken), hash, s.tokens.RefreshTTL())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to refresh session: %v", err)
+	}
+	if session == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	return s.issueTokenPair(session, refreshToken)
+}
+
+func (s *Server) Logout(ctx context.Context, req *LogoutRequest) (*emptypb.Empty, error) {
+	var sessionID int
+
+	if req.RefreshToken != "" {
+		session, err := s.repositories.GetSessionByTokenHash(ctx, auth.HashRefreshToken(req.RefreshToken))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get session: %v", err)
+		}
+		if session == nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token")
+		}
+		sessionID = session.ID
+	} else {
+		principal, err := s.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		sessionID = principal.SessionID
+	}
+
+	if err := s.repositories.RevokeSession(ctx, sessionID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke session: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListSessions(ctx context.Context, req *ListSessionsRequest) (*ListSessionsResponse, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing access token")
+	}
+
+	sessions, err := s.repositories.ListSessions(ctx, principal.UserID, req.IncludeInactive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list sessions: %v", err)
+	}
+
+	resp := &ListSessionsResponse{}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, convertSessionToProto(session, principal.SessionID))
+	}
+
+	return resp, nil
+}
+
+func (s *Server) RevokeSession(ctx context.Context, req *RevokeSessionRequest) (*emptypb.Empty, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing access token")
+	}
+
+	session, err := s.repositories.GetSessionByID(ctx, int(req.SessionId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get session: %v", err)
+	}
+	// Чужие сессии не раскрываем
+	if session == nil || session.UserID != principal.UserID {
+		return nil, status.Errorf(codes.NotFound, "session not found")
+	}
+
+	if err := s.repositories.RevokeSession(ctx, session.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke session: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) issueTokenPair(session *common.Session, refreshToken string) (*TokenPair, error) {
+	accessToken, expiresAt, err := s.tokens.Issue(int(session.UserID), session.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue access token: %v", err)
+	}
+
+	return &TokenPair{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  timestamppb.New(expiresAt),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(session.ExpiresAt),
+		TokenType:             "Bearer",
+		SessionId:             int32(session.ID),
+	}, nil
+}
+
+func userAgent(ctx context.Context) *string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("user-agent")
+	if len(values) == 0 {
+		return nil
+	}
+	agent := values[0]
+	if len(agent) > 512 {
+		agent = strings.ToValidUTF8(agent[:512], "")
+	}
+	return &agent
+}
+
+func convertSessionToProto(session *common.Session, currentSessionID int) *Session {
+	resp := &Session{
+		Id:         int32(session.ID),
+		UserId:     int32(session.UserID),
+		UserAgent:  session.UserAgent,
+		CreatedAt:  timestamppb.New(session.CreatedAt),
+		LastUsedAt: timestamppb.New(session.LastUsedAt),
+		ExpiresAt:  timestamppb.New(session.ExpiresAt),
+		Current:    session.ID == currentSessionID,
+	}
+	if session.RevokedAt != nil {
+		resp.RevokedAt = timestamppb.New(*session.RevokedAt)
+	}
+	return resp
+}
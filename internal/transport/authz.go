@@ -0,0 +1,428 @@
+package data_processor
+
+import (
+	"context"
+	"data_processor/internal/auth"
+	"data_processor/internal/common"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type access int
+
+const (
+	accessRead access = iota
+	accessWrite
+)
+
+func (a access) String() string {
+	if a == accessWrite {
+		return "write"
+	}
+	return "read"
+}
+
+type policyKind int
+
+const (
+	// нулевое значение намеренно невалидно: пустая запись в таблице не открывает метод
+	policyPublic policyKind = iota + 1
+	policyAuthenticated
+	policyAdmin
+	// policyWorker — методы воркеров; администраторам они тоже доступны
+	policyWorker
+	policySelf
+	policyResource
+)
+
+// resourceCheck requires an access right on the resource whose id is stored in a message field.
+type resourceCheck struct {
+	resource common.ResourceType
+	field    protoreflect.Name
+	access   access
+	// optional — проверка пропускается, если поле не задано в запросе
+	optional bool
+	// fromResponse — id берётся из ответа (поиск по имени), проверка выполняется после обработчика
+	fromResponse bool
+}
+
+type methodPolicy struct {
+	kind policyKind
+	// selfField — поле с id пользователя, которому разрешён вызов (policySelf)
+	selfField protoreflect.Name
+	checks    []resourceCheck
+	// unscoped — запрос без id ресурса разрешён любому пользователю (onResourceIfSet)
+	unscoped bool
+	// mostSpecific — из необязательных проверок выполняется только первая заданная (onMostSpecific)
+	mostSpecific bool
+}
+
+func public() methodPolicy     { return methodPolicy{kind: policyPublic} }
+func anyUser() methodPolicy    { return methodPolicy{kind: policyAuthenticated} }
+func adminOnly() methodPolicy  { return methodPolicy{kind: policyAdmin} }
+func workerOnly() methodPolicy { return methodPolicy{kind: policyWorker} }
+func selfOrAdmin(field protoreflect.Name) methodPolicy {
+	return methodPolicy{kind: policySelf, selfField: field}
+}
+
+func onResource(checks ...resourceCheck) methodPolicy {
+	return methodPolicy{kind: policyResource, checks: checks}
+}
+
+// onResourceIfSet проверяет только заданные в запросе поля; запрос, не указывающий ни одного
+// ресурса (например, проверка черновика), разрешён любому аутентифицированному пользователю
+func onResourceIfSet(checks ...resourceCheck) methodPolicy {
+	for i := range checks {
+		checks[i].optional = true
+	}
+	return methodPolicy{kind: policyResource, checks: checks, unscoped: true}
+}
+
+// onMostSpecific проверяет из необязательных проверок только первую заданную, поэтому их
+// перечисляют от самого узкого уровня иерархии к самому широкому: для правила команды
+// достаточно прав на команду, права на её организацию не нужны
+func onMostSpecific(checks ...resourceCheck) methodPolicy {
+	return methodPolicy{kind: policyResource, checks: checks, mostSpecific: true}
+}
+
+func read(resource common.ResourceType, field protoreflect.Name) resourceCheck {
+	return resourceCheck{resource: resource, field: field, access: accessRead}
+}
+
+func write(resource common.ResourceType, field protoreflect.Name) resourceCheck {
+	return resourceCheck{resource: resource, field: field, access: accessWrite}
+}
+
+func readResult(resource common.ResourceType, field protoreflect.Name) resourceCheck {
+	return resourceCheck{resource: resource, field: field, access: accessRead, fromResponse: true}
+}
+
+func optional(check resourceCheck) resourceCheck {
+	check.optional = true
+	return check
+}
+
+// ValidateMethodPolicies checks that every RPC in processor.proto has an authorization policy
+// and that the policies only reference existing integer fields.
+func ValidateMethodPolicies() error {
+	known := make(map[string]bool)
+	services := File_processor_proto.Services()
+	for i := 0; i < services.Len(); i++ {
+		methods := services.Get(i).Methods()
+		for j := 0; j < methods.Len(); j++ {
+			method := methods.Get(j)
+			name := fmt.Sprintf("/%s/%s", services.Get(i).FullName(), method.Name())
+			known[name] = true
+
+			policy, ok := methodPolicies[name]
+			if !ok {
+				return fmt.Errorf("method %s has no authorization policy", name)
+			}
+			if err := policy.validate(method); err != nil {
+				return fmt.Errorf("method %s: %w", name, err)
+			}
+		}
+	}
+
+	for name := range methodPolicies {
+		if !known[name] {
+			return fmt.Errorf("authorization policy for unknown method %s", name)
+		}
+	}
+	return nil
+}
+
+func (p methodPolicy) validate(method protoreflect.MethodDescriptor) error {
+	switch p.kind {
+	case policyPublic, policyAuthenticated, policyAdmin, policyWorker:
+		return nil
+	case policySelf:
+		return checkIDField(method.Input(), p.selfField)
+	case policyResource:
+		if len(p.checks) == 0 {
+			return errors.New("resource policy without checks")
+		}
+		for _, check := range p.checks {
+			message := method.Input()
+			if check.fromResponse {
+				if method.IsStreamingClient() || method.IsStreamingServer() {
+					return errors.New("response checks are not supported on streaming methods")
+				}
+				message = method.Output()
+			}
+			if err := checkIDField(message, check.field); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return errors.New("policy kind is not set")
+	}
+}
+
+func checkIDField(message protoreflect.MessageDescriptor, name protoreflect.Name) error {
+	field := message.Fields().ByName(name)
+	if field == nil {
+		return fmt.Errorf("%s has no field %q", message.FullName(), name)
+	}
+	if field.Kind() != protoreflect.Int32Kind || field.IsList() {
+		return fmt.Errorf("field %s is not an int32", field.FullName())
+	}
+	return nil
+}
+
+// AuthUnaryInterceptor authenticates the caller and enforces methodPolicies on unary RPCs.
+func (s *Server) AuthUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		policy, ok := methodPolicies[info.FullMethod]
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "method %s has no authorization policy", info.FullMethod)
+		}
+
+		ctx, principal, err := s.authorizeCaller(ctx, policy)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.authorizeMessage(ctx, principal, policy, req, false); err != nil {
+			return nil, err
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.authorizeMessage(ctx, principal, policy, resp, true); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+}
+
+// AuthStreamInterceptor enforces methodPolicies on streaming RPCs. Resource checks run against
+// the first message received from the client.
+func (s *Server) AuthStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		policy, ok := methodPolicies[info.FullMethod]
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, "method %s has no authorization policy", info.FullMethod)
+		}
+
+		ctx, principal, err := s.authorizeCaller(ss.Context(), policy)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authorizedStream{
+			ServerStream: ss,
+			ctx:          ctx,
+			authorize: func(msg interface{}) error {
+				return s.authorizeMessage(ctx, principal, policy, msg, false)
+			},
+		})
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	authorize func(msg interface{}) error
+	checked   bool
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.checked {
+		return nil
+	}
+	s.checked = true
+	return s.authorize(m)
+}
+
+// checkStreamScan rejects a later message of an upload stream that names a different scan
+// than the first one. AuthStreamInterceptor authorizes only the first message, so switching
+// scans mid-stream would write to a scan the caller was never checked against.
+func checkStreamScan(first, scanID int32) error {
+	if scanID != first {
+		return status.Errorf(codes.InvalidArgument, "scan_id must be %d in every message of the stream", first)
+	}
+	return nil
+}
+
+// authorizeCaller проверяет аутентификацию и политики, не зависящие от содержимого запроса
+func (s *Server) authorizeCaller(ctx context.Context, policy methodPolicy) (context.Context, *auth.Principal, error) {
+	if policy.kind == policyPublic {
+		return ctx, nil, nil
+	}
+
+	principal, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx = withPermissionCache(auth.WithPrincipal(ctx, principal))
+
+	if err := checkCaller(policy, principal); err != nil {
+		return nil, nil, err
+	}
+	return ctx, principal, nil
+}
+
+// checkCaller проверяет политики, которым достаточно флагов вызывающего
+func checkCaller(policy methodPolicy, principal *auth.Principal) error {
+	switch {
+	case policy.kind == policyAdmin && !principal.IsAdmin:
+		return status.Errorf(codes.PermissionDenied, "administrator access required")
+	case policy.kind == policyWorker && !principal.IsWorker && !principal.IsAdmin:
+		return status.Errorf(codes.PermissionDenied, "worker access required")
+	}
+	return nil
+}
+
+// authorizeMessage проверяет политики, которым нужны поля запроса (или ответа, если response == true)
+func (s *Server) authorizeMessage(ctx context.Context, principal *auth.Principal, policy methodPolicy, msg interface{}, response bool) error {
+	if principal == nil || principal.IsAdmin {
+		return nil
+	}
+	message, ok := msg.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected message type %T", msg)
+	}
+	reflected := message.ProtoReflect()
+
+	switch policy.kind {
+	case policySelf:
+		if response {
+			return nil
+		}
+		if userID, _ := int32Field(reflected, policy.selfField); common.UserID(userID) != principal.UserID {
+			return status.Errorf(codes.PermissionDenied, "access to another user's data denied")
+		}
+	case policyResource:
+		var refs []common.ResourceRef
+		var checks []resourceCheck
+		specificFound := false
+		for _, check := range policy.checks {
+			if check.fromResponse != response {
+				continue
+			}
+			id, set := int32Field(reflected, check.field)
+			if !set && check.optional {
+				continue
+			}
+			if check.optional && policy.mostSpecific {
+				if specificFound {
+					continue
+				}
+				specificFound = true
+			}
+			refs = append(refs, common.ResourceRef{Type: check.resource, ID: int(id)})
+			checks = append(checks, check)
+		}
+		// Запрос, в котором не задано ни одно из необязательных полей, не должен проходить без проверки
+		if len(refs) == 0 {
+			if hasChecks(policy, response) && !policy.unscoped {
+				return status.Errorf(codes.PermissionDenied, "request does not identify a resource")
+			}
+			return nil
+		}
+
+		// Все проверки метода выполняются одним запросом к базе
+		rights, err := s.resourceRights(ctx, principal.UserID, refs)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check permissions: %v", err)
+		}
+		for i, check := range checks {
+			if !allows(rights[i], check.access) {
+				return status.Errorf(codes.PermissionDenied, "%s access to %s %d denied", check.access, refs[i].Type, refs[i].ID)
+			}
+		}
+	}
+	return nil
+}
+
+func hasChecks(policy methodPolicy, response bool) bool {
+	for _, check := range policy.checks {
+		if check.fromResponse == response {
+			return true
+		}
+	}
+	return false
+}
+
+func int32Field(message protoreflect.Message, name protoreflect.Name) (int32, bool) {
+	field := message.Descriptor().Fields().ByName(name)
+	if field == nil {
+		return 0, false
+	}
+	return int32(message.Get(field).Int()), message.Has(field)
+}
+
+// allows — право на запись включает право на чтение
+func allows(rights common.PermissionReadWrite, acc access) bool {
+	if acc == accessWrite {
+		return rights.Write
+	}
+	return rights.Read || rights.Write
+}
+
+// authenticate проверяет access token из заголовка authorization и то, что его сессия не отозвана
+func (s *Server) authenticate(ctx context.Context) (*auth.Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "missing access token")
+	}
+
+	scheme, token, found := strings.Cut(values[0], " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return nil, status.Errorf(codes.Unauthenticated, "authorization must use the Bearer scheme")
+	}
+
+	claims, err := s.tokens.Verify(token)
+	if err != nil {
+		if errors.Is(err, auth.ErrExpiredToken) {
+			return nil, status.Errorf(codes.Unauthenticated, "access token expired")
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "invalid access token")
+	}
+	userID, err := claims.UserID()
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid access token")
+	}
+
+	session, err := s.repositories.GetSessionByID(ctx, claims.SessionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get session: %v", err)
+	}
+	if session == nil || session.RevokedAt != nil || session.UserID != common.UserID(userID) {
+		return nil, status.Errorf(codes.Unauthenticated, "session revoked")
+	}
+
+	user, err := s.repositories.GetUserByID(ctx, common.UserID(userID))
+	if common.IsNotFound(err) {
+		return nil, status.Errorf(codes.Unauthenticated, "user no longer exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+
+	return &auth.Principal{
+		UserID:    user.ID,
+		SessionID: session.ID,
+		IsAdmin:   user.IsAdmin,
+		IsWorker:  user.IsWorker,
+	}, nil
+}
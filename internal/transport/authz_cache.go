@@ -0,0 +1,87 @@
+package data_processor
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"sync"
+)
+
+type permissionCacheKey struct {
+	userID common.UserID
+	ref    common.ResourceRef
+}
+
+// permissionCache хранит права, вычисленные в рамках одного запроса: интерсептор и обработчик
+// не ходят в базу повторно за одним и тем же ресурсом.
+type permissionCache struct {
+	mu     sync.Mutex
+	rights map[permissionCacheKey]common.PermissionReadWrite
+}
+
+type permissionCacheKeyType struct{}
+
+func withPermissionCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, permissionCacheKeyType{}, &permissionCache{
+		rights: make(map[permissionCacheKey]common.PermissionReadWrite),
+	})
+}
+
+func permissionCacheFromContext(ctx context.Context) *permissionCache {
+	cache, _ := ctx.Value(permissionCacheKeyType{}).(*permissionCache)
+	return cache
+}
+
+// resourceRights возвращает права пользователя на ресурсы в порядке refs.
+// В базу уходит один запрос за всеми ресурсами, которых ещё нет в кеше запроса.
+func (s *Server) resourceRights(ctx context.Context, userID common.UserID, refs []common.ResourceRef) ([]common.PermissionReadWrite, error) {
+	cache := permissionCacheFromContext(ctx)
+	result := make([]common.PermissionReadWrite, len(refs))
+
+	var missing []common.ResourceRef
+	seen := make(map[common.ResourceRef]bool)
+	if cache != nil {
+		cache.mu.Lock()
+		for _, ref := range refs {
+			if _, ok := cache.rights[permissionCacheKey{userID, ref}]; !ok && !seen[ref] {
+				missing = append(missing, ref)
+				seen[ref] = true
+			}
+		}
+		cache.mu.Unlock()
+	} else {
+		for _, ref := range refs {
+			if !seen[ref] {
+				missing = append(missing, ref)
+				seen[ref] = true
+			}
+		}
+	}
+
+	fetched := make(map[common.ResourceRef]common.PermissionReadWrite, len(missing))
+	if len(missing) > 0 {
+		rights, err := s.repositories.CheckPermissions(ctx, userID, missing)
+		if err != nil {
+			return nil, err
+		}
+		for i, ref := range missing {
+			fetched[ref] = rights[i]
+		}
+	}
+
+	if cache != nil {
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+		for ref, rights := range fetched {
+			cache.rights[permissionCacheKey{userID, ref}] = rights
+		}
+		for i, ref := range refs {
+			result[i] = cache.rights[permissionCacheKey{userID, ref}]
+		}
+		return result, nil
+	}
+
+	for i, ref := range refs {
+		result[i] = fetched[ref]
+	}
+	return result, nil
+}
@@ -0,0 +1,141 @@
+package data_processor
+
+import "data_processor/internal/common"
+
+// methodPolicies описывает, кто может вызывать каждый метод. Метод без записи в таблице
+// сервер не запустит (см. ValidateMethodPolicies), поэтому новый RPC нельзя выкатить без защиты.
+var methodPolicies = map[string]methodPolicy{
+	// UserService
+	UserService_CreateUser_FullMethodName:        adminOnly(),
+	UserService_GetUser_FullMethodName:           anyUser(),
+	UserService_GetUserByName_FullMethodName:     anyUser(),
+	UserService_UpdateUser_FullMethodName:        selfOrAdmin("id"),
+	UserService_DeleteUser_FullMethodName:        adminOnly(),
+	UserService_ListUsers_FullMethodName:         adminOnly(),
+	UserService_VerifyCredentials_FullMethodName: adminOnly(),
+
+	// OrganizationService
+	OrganizationService_CreateOrganization_FullMethodName:       selfOrAdmin("owner_id"),
+	OrganizationService_GetOrganization_FullMethodName:          onResource(read(common.ResourceOrganization, "id")),
+	OrganizationService_GetOrganizationByName_FullMethodName:    onResource(readResult(common.ResourceOrganization, "id")),
+	OrganizationService_UpdateOrganization_FullMethodName:       onResource(write(common.ResourceOrganization, "id")),
+	OrganizationService_DeleteOrganization_FullMethodName:       onResource(write(common.ResourceOrganization, "id")),
+	OrganizationService_ListOrganizations_FullMethodName:        adminOnly(),
+	OrganizationService_ListOrganizationsByOwner_FullMethodName: selfOrAdmin("owner_id"),
+
+	// TeamService
+	TeamService_CreateTeam_FullMethodName:              onResource(write(common.ResourceOrganization, "organization_id")),
+	TeamService_GetTeam_FullMethodName:                 onResource(read(common.ResourceTeam, "id")),
+	TeamService_GetTeamByName_FullMethodName:           onResource(readResult(common.ResourceTeam, "id")),
+	TeamService_UpdateTeam_FullMethodName:              onResource(write(common.ResourceTeam, "id"), optional(write(common.ResourceOrganization, "organization_id"))),
+	TeamService_DeleteTeam_FullMethodName:              onResource(write(common.ResourceTeam, "id")),
+	TeamService_ListTeams_FullMethodName:               adminOnly(),
+	TeamService_ListTeamsByOrganization_FullMethodName: onResource(read(common.ResourceOrganization, "parent_id")),
+	TeamService_ListTeamsByOwner_FullMethodName:        selfOrAdmin("owner_id"),
+	TeamService_AddMember_FullMethodName:               onResource(write(common.ResourceTeam, "team_id")),
+	TeamService_RemoveMember_FullMethodName:            onResource(write(common.ResourceTeam, "team_id")),
+	TeamService_ListMembers_FullMethodName:             onResource(read(common.ResourceTeam, "team_id")),
+	TeamService_ListTeamsForUser_FullMethodName:        selfOrAdmin("user_id"),
+
+	// ApplicationService
+	ApplicationService_CreateApplication_FullMethodName:      onResource(write(common.ResourceTeam, "team_id")),
+	ApplicationService_GetApplication_FullMethodName:         onResource(read(common.ResourceApplication, "id")),
+	ApplicationService_GetApplicationByName_FullMethodName:   onResource(readResult(common.ResourceApplication, "id")),
+	ApplicationService_UpdateApplication_FullMethodName:      onResource(write(common.ResourceApplication, "id"), optional(write(common.ResourceTeam, "team_id"))),
+	ApplicationService_DeleteApplication_FullMethodName:      onResource(write(common.ResourceApplication, "id")),
+	ApplicationService_ListApplications_FullMethodName:       adminOnly(),
+	ApplicationService_ListApplicationsByTeam_FullMethodName: onResource(read(common.ResourceTeam, "parent_id")),
+
+	// VersionService
+	VersionService_CreateVersion_FullMethodName:      onResource(write(common.ResourceApplication, "application_id")),
+	VersionService_GetVersion_FullMethodName:         onResource(read(common.ResourceVersion, "id")),
+	VersionService_GetVersionByNumber_FullMethodName: onResource(read(common.ResourceApplication, "application_id")),
+	VersionService_UpdateVersion_FullMethodName:      onResource(write(common.ResourceVersion, "id"), optional(write(common.ResourceApplication, "application_id"))),
+	VersionService_DeleteVersion_FullMethodName:      onResource(write(common.ResourceVersion, "id")),
+	VersionService_ListVersions_FullMethodName:       onResource(read(common.ResourceApplication, "application_id")),
+	VersionService_ListComponents_FullMethodName:     onResource(read(common.ResourceVersion, "version_id")),
+	VersionService_ExportSbom_FullMethodName:         onResource(read(common.ResourceVersion, "version_id")),
+
+	// ScanService
+	ScanService_CreateScan_FullMethodName:   onResource(write(common.ResourceVersion, "version_id")),
+	ScanService_GetScan_FullMethodName:      onResource(read(common.ResourceScan, "id")),
+	ScanService_UpdateScan_FullMethodName:   onResource(write(common.ResourceScan, "id"), optional(write(common.ResourceVersion, "version_id"))),
+	ScanService_DeleteScan_FullMethodName:   onResource(write(common.ResourceScan, "id")),
+	ScanService_ListScans_FullMethodName:    onResource(read(common.ResourceVersion, "version_id")),
+	ScanService_StartScan_FullMethodName:    onResource(write(common.ResourceScan, "id")),
+	ScanService_CompleteScan_FullMethodName: onResource(write(common.ResourceScan, "id")),
+	ScanService_FailScan_FullMethodName:     onResource(write(common.ResourceScan, "id")),
+	ScanService_CancelScan_FullMethodName:   onResource(write(common.ResourceScan, "id")),
+	ScanService_WatchScan_FullMethodName:    onResource(read(common.ResourceScan, "id")),
+	// Воркеры обрабатывают сканы всех организаций
+	ScanService_LeaseScan_FullMethodName:      workerOnly(),
+	ScanService_HeartbeatScan_FullMethodName:  workerOnly(),
+	ScanService_ReportProgress_FullMethodName: workerOnly(),
+
+	// ScanInfoService
+	ScanInfoService_CreateScanInfo_FullMethodName:    onResource(write(common.ResourceScan, "scan_id")),
+	ScanInfoService_GetScanInfo_FullMethodName:       onResource(read(common.ResourceScanInfo, "id")),
+	ScanInfoService_GetScanInfoByScan_FullMethodName: onResource(read(common.ResourceScan, "scan_id")),
+	ScanInfoService_UpdateScanInfo_FullMethodName:    onResource(write(common.ResourceScanInfo, "id"), optional(write(common.ResourceScan, "scan_id"))),
+	ScanInfoService_DeleteScanInfo_FullMethodName:    onResource(write(common.ResourceScanInfo, "id")),
+	ScanInfoService_UploadFindings_FullMethodName:    onResource(write(common.ResourceScan, "scan_id")),
+	ScanInfoService_ListFindings_FullMethodName:      onResource(read(common.ResourceScan, "scan_id")),
+	ScanInfoService_UploadSarif_FullMethodName:       onResource(write(common.ResourceScan, "scan_id")),
+	ScanInfoService_ListFindingRules_FullMethodName:  onResource(read(common.ResourceScan, "scan_id")),
+	ScanInfoService_ExportSarif_FullMethodName:       onResource(read(common.ResourceScan, "scan_id")),
+	ScanInfoService_UploadSbom_FullMethodName:        onResource(write(common.ResourceScan, "scan_id")),
+
+	// ScanRuleService
+	// Правила приложений и команд требуют прав на команду, правила организаций — на организацию
+	ScanRuleService_CreateScanRule_FullMethodName: onMostSpecific(optional(write(common.ResourceTeam, "team_id")),
+		optional(write(common.ResourceOrganization, "organization_id"))),
+	ScanRuleService_GetScanRule_FullMethodName: onResource(read(common.ResourceScanRule, "id")),
+	ScanRuleService_UpdateScanRule_FullMethodName: onMostSpecific(write(common.ResourceScanRule, "id"),
+		optional(write(common.ResourceTeam, "team_id")), optional(write(common.ResourceOrganization, "organization_id"))),
+	ScanRuleService_DeleteScanRule_FullMethodName: onResource(write(common.ResourceScanRule, "id")),
+	ScanRuleService_ListScanRules_FullMethodName:  adminOnly(),
+	ScanRuleService_GetScanRuleByComposite_FullMethodName: onMostSpecific(optional(read(common.ResourceTeam, "team_id")),
+		optional(read(common.ResourceOrganization, "organization_id"))),
+	ScanRuleService_GetEffectiveScanRule_FullMethodName: onResource(read(common.ResourceApplication, "application_id")),
+	ScanRuleService_TestExcludes_FullMethodName:         onResourceIfSet(read(common.ResourceScanRule, "rule_id")),
+	// Права на удалённое правило не вычислить, поэтому его историю видят только администраторы
+	ScanRuleService_ListScanRuleRevisions_FullMethodName:   onResource(read(common.ResourceScanRule, "rule_id")),
+	ScanRuleService_DiffScanRuleRevisions_FullMethodName:   onResource(read(common.ResourceScanRule, "rule_id")),
+	ScanRuleService_RestoreScanRuleRevision_FullMethodName: onResource(write(common.ResourceScanRule, "rule_id")),
+	// Без organization_id документ охватывает все организации — это доступно только администраторам
+	ScanRuleService_ExportScanRules_FullMethodName: onResource(optional(read(common.ResourceOrganization, "organization_id"))),
+	ScanRuleService_ApplyScanRules_FullMethodName:  onResource(optional(write(common.ResourceOrganization, "organization_id"))),
+
+	// PermissionService: управление моделью RBAC остаётся за администраторами
+	PermissionService_CreatePermission_FullMethodName:           adminOnly(),
+	PermissionService_GetPermission_FullMethodName:              adminOnly(),
+	PermissionService_GetPermissionByName_FullMethodName:        adminOnly(),
+	PermissionService_UpdatePermission_FullMethodName:           adminOnly(),
+	PermissionService_DeletePermission_FullMethodName:           adminOnly(),
+	PermissionService_ListPermissions_FullMethodName:            adminOnly(),
+	PermissionService_GetTeamPermissions_FullMethodName:         selfOrAdmin("user_id"),
+	PermissionService_GetOrganizationPermissions_FullMethodName: selfOrAdmin("user_id"),
+	PermissionService_GetEffectivePermissions_FullMethodName:    selfOrAdmin("user_id"),
+	PermissionService_CheckPermissions_FullMethodName:           selfOrAdmin("user_id"),
+
+	// RoleService
+	RoleService_CreateRole_FullMethodName:         adminOnly(),
+	RoleService_GetRole_FullMethodName:            adminOnly(),
+	RoleService_GetRoleByName_FullMethodName:      adminOnly(),
+	RoleService_UpdateRole_FullMethodName:         adminOnly(),
+	RoleService_DeleteRole_FullMethodName:         adminOnly(),
+	RoleService_ListRoles_FullMethodName:          adminOnly(),
+	RoleService_ListRolesByScope_FullMethodName:   onResource(optional(read(common.ResourceOrganization, "organization_id")), optional(read(common.ResourceTeam, "team_id"))),
+	RoleService_AddPermission_FullMethodName:      adminOnly(),
+	RoleService_RemovePermission_FullMethodName:   adminOnly(),
+	RoleService_AssignRoleToUser_FullMethodName:   adminOnly(),
+	RoleService_RemoveRoleFromUser_FullMethodName: adminOnly(),
+	RoleService_GetUserRoles_FullMethodName:       selfOrAdmin("user_id"),
+
+	// AuthService
+	AuthService_Login_FullMethodName:         public(),
+	AuthService_Refresh_FullMethodName:       public(),
+	AuthService_Logout_FullMethodName:        public(),
+	AuthService_ListSessions_FullMethodName:  anyUser(),
+	AuthService_RevokeSession_FullMethodName: anyUser(),
+}
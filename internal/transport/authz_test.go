@@ -0,0 +1,93 @@
+package data_processor
+
+import (
+	"context"
+	"data_processor/internal/auth"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMethodPolicies(t *testing.T) {
+	t.Run("Every Method Covered", func(t *testing.T) {
+		require.NoError(t, ValidateMethodPolicies())
+	})
+
+	t.Run("Missing Policy Detected", func(t *testing.T) {
+		saved := methodPolicies[ScanService_DeleteScan_FullMethodName]
+		delete(methodPolicies, ScanService_DeleteScan_FullMethodName)
+		defer func() { methodPolicies[ScanService_DeleteScan_FullMethodName] = saved }()
+
+		err := ValidateMethodPolicies()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "DeleteScan")
+	})
+
+	t.Run("Empty Policy Rejected", func(t *testing.T) {
+		saved := methodPolicies[ScanService_DeleteScan_FullMethodName]
+		methodPolicies[ScanService_DeleteScan_FullMethodName] = methodPolicy{}
+		defer func() { methodPolicies[ScanService_DeleteScan_FullMethodName] = saved }()
+
+		require.Error(t, ValidateMethodPolicies())
+	})
+
+	t.Run("Unknown Field Rejected", func(t *testing.T) {
+		saved := methodPolicies[ScanService_DeleteScan_FullMethodName]
+		methodPolicies[ScanService_DeleteScan_FullMethodName] = onResource(write("scan", "scan_id"))
+		defer func() { methodPolicies[ScanService_DeleteScan_FullMethodName] = saved }()
+
+		require.Error(t, ValidateMethodPolicies())
+	})
+}
+
+func TestAuthorizeSelf(t *testing.T) {
+	s := &Server{}
+	policy := methodPolicies[UserService_UpdateUser_FullMethodName]
+	ctx := context.Background()
+
+	// Пользователь может менять только себя
+	principal := &auth.Principal{UserID: 7}
+	require.NoError(t, s.authorizeMessage(ctx, principal, policy, &UpdateUserRequest{Id: 7}, false))
+
+	err := s.authorizeMessage(ctx, principal, policy, &UpdateUserRequest{Id: 8}, false)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Администратору можно всё
+	admin := &auth.Principal{UserID: 1, IsAdmin: true}
+	require.NoError(t, s.authorizeMessage(ctx, admin, policy, &UpdateUserRequest{Id: 8}, false))
+}
+
+func TestCheckCaller(t *testing.T) {
+	policy := methodPolicies[ScanService_LeaseScan_FullMethodName]
+
+	// Методы воркеров не требуют прав администратора
+	require.NoError(t, checkCaller(policy, &auth.Principal{UserID: 7, IsWorker: true}))
+	require.NoError(t, checkCaller(policy, &auth.Principal{UserID: 1, IsAdmin: true}))
+	err := checkCaller(policy, &auth.Principal{UserID: 7})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Воркер не получает прав администратора
+	err = checkCaller(methodPolicies[UserService_ListUsers_FullMethodName], &auth.Principal{UserID: 7, IsWorker: true})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAuthorizeWithoutResource(t *testing.T) {
+	s := &Server{}
+	policy := methodPolicies[RoleService_ListRolesByScope_FullMethodName]
+
+	// Ни одно из необязательных полей не задано — запрос не проходит без проверки
+	err := s.authorizeMessage(context.Background(), &auth.Principal{UserID: 7}, policy, &ListRolesByScopeRequest{}, false)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAuthorizeUnscoped(t *testing.T) {
+	s := &Server{}
+	policy := methodPolicies[ScanRuleService_TestExcludes_FullMethodName]
+
+	// Черновик правила не ссылается на ресурсы и доступен любому пользователю
+	draft := &TestExcludesRequest{Rule: &TestExcludesRequest_Draft{Draft: &ScanRule{}}}
+	require.NoError(t, s.authorizeMessage(context.Background(), &auth.Principal{UserID: 7}, policy, draft, false))
+}
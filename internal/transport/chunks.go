@@ -0,0 +1,28 @@
+package data_processor
+
+import "bufio"
+
+// maxUploadSize ограничивает размер загружаемого документа: он разбирается целиком в памяти
+const maxUploadSize = 256 << 20
+
+// exportChunkSize — размер частей, которыми отдаётся выгружаемый документ
+const exportChunkSize = 64 << 10
+
+// chunkWriter отправляет каждую запись отдельным сообщением, созданным newMessage
+type chunkWriter[T any] struct {
+	send       func(T) error
+	newMessage func(chunk []byte) T
+}
+
+// newChunkWriter буферизует запись до exportChunkSize байт перед отправкой. Вызывающий
+// должен вызвать Flush после последней записи.
+func newChunkWriter[T any](send func(T) error, newMessage func(chunk []byte) T) *bufio.Writer {
+	return bufio.NewWriterSize(chunkWriter[T]{send: send, newMessage: newMessage}, exportChunkSize)
+}
+
+func (w chunkWriter[T]) Write(p []byte) (int, error) {
+	if err := w.send(w.newMessage(p)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,67 @@
+package data_processor
+
+import (
+	"data_processor/internal/common"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// repoError переводит ошибку репозитория в статус gRPC. Доменные ошибки common.Error получают
+// свой код и детали google.rpc: ResourceInfo для отсутствующих и дублирующихся сущностей,
+// BadRequest с нарушением поля для ошибочных ссылок и значений. Остальные ошибки — Internal.
+func repoError(err error, action string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var domainErr *common.Error
+	if !errors.As(err, &domainErr) {
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+
+	if errors.Is(err, common.ErrRevisionMismatch) {
+		return status.Errorf(codes.Aborted, "failed to %s: etag does not match, the resource was modified concurrently", action)
+	}
+
+	msg := "failed to " + action + ": " + domainErr.Error()
+	switch domainErr.Kind {
+	case common.KindNotFound:
+		return withDetails(status.New(codes.NotFound, msg), resourceInfo(domainErr))
+	case common.KindAlreadyExists:
+		return withDetails(status.New(codes.AlreadyExists, msg), resourceInfo(domainErr))
+	case common.KindInvalidReference, common.KindValidation:
+		return withDetails(status.New(codes.InvalidArgument, msg), fieldViolation(domainErr))
+	case common.KindConflict:
+		return status.New(codes.FailedPrecondition, msg).Err()
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
+
+func resourceInfo(err *common.Error) *errdetails.ResourceInfo {
+	return &errdetails.ResourceInfo{
+		ResourceType: err.Resource,
+		ResourceName: err.ID,
+		Description:  err.Error(),
+	}
+}
+
+func fieldViolation(err *common.Error) *errdetails.BadRequest {
+	return &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       err.Field,
+			Description: err.Error(),
+		}},
+	}
+}
+
+// withDetails добавляет детали к статусу; если их не удалось сериализовать, возвращается статус без них
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	if detailed, err := st.WithDetails(details...); err == nil {
+		return detailed.Err()
+	}
+	return st.Err()
+}
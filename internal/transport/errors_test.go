@@ -0,0 +1,60 @@
+package data_processor
+
+import (
+	"data_processor/internal/common"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRepoError(t *testing.T) {
+	err := repoError(fmt.Errorf("failed to update role: %w", common.ErrRevisionMismatch), "update role")
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	err = repoError(errors.New("connection refused"), "update role")
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	// Готовый статус не переписывается
+	err = repoError(status.Error(codes.PermissionDenied, "denied"), "get team")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = repoError(common.ConflictError("organization", "is still referenced by team"), "delete organization")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	st := status.Convert(repoError(common.NotFoundError("team", 7), "get team"))
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "failed to get team: team not found", st.Message())
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ResourceInfo)
+	require.True(t, ok)
+	assert.Equal(t, "team", info.ResourceType)
+	assert.Equal(t, "7", info.ResourceName)
+
+	st = status.Convert(repoError(common.AlreadyExistsError("team_member", "already exists"), "add team member"))
+	assert.Equal(t, codes.AlreadyExists, st.Code())
+	require.Len(t, st.Details(), 1)
+	assert.IsType(t, &errdetails.ResourceInfo{}, st.Details()[0])
+
+	// Ошибка ссылки указывает на поле запроса; текст ошибки базы клиенту не передаётся
+	ref := &common.Error{Kind: common.KindInvalidReference, Field: "organization_id", Resource: "organization", ID: "42",
+		Msg: "with id 42 does not exist", Err: errors.New(`insert or update on table "teams" violates foreign key constraint`)}
+	st = status.Convert(repoError(fmt.Errorf("failed to create team: %w", ref), "create team"))
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.NotContains(t, st.Message(), "violates")
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 1)
+	assert.Equal(t, "organization_id", badRequest.FieldViolations[0].Field)
+
+	st = status.Convert(repoError(common.ValidationError("role", "unknown team member role"), "add team member"))
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	assert.Equal(t, "role", st.Details()[0].(*errdetails.BadRequest).FieldViolations[0].Field)
+}
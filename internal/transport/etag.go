@@ -0,0 +1,36 @@
+package data_processor
+
+import (
+	"data_processor/internal/common"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// etag — внешнее представление ревизии сущности
+func etag(revision int) string {
+	return strconv.Itoa(revision)
+}
+
+// revisionFromEtag разбирает etag из запроса. Пустой etag даёт 0: операция выполняется
+// без проверки ревизии.
+func revisionFromEtag(etag string) (int, error) {
+	if etag == "" {
+		return 0, nil
+	}
+	revision, err := strconv.Atoi(etag)
+	if err != nil || revision <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid etag %q", etag)
+	}
+	return revision, nil
+}
+
+// checkEtag сверяет ревизию из запроса с etag сущности. Нужна, когда обновлять нечего и
+// условное обновление в репозитории не выполняется: устаревший etag всё равно даёт Aborted.
+func checkEtag(current string, revision int, action string) error {
+	if revision != 0 && etag(revision) != current {
+		return repoError(common.ErrRevisionMismatch, action)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package data_processor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRevisionFromEtag(t *testing.T) {
+	revision, err := revisionFromEtag("")
+	require.NoError(t, err)
+	assert.Zero(t, revision)
+
+	revision, err = revisionFromEtag(etag(7))
+	require.NoError(t, err)
+	assert.Equal(t, 7, revision)
+
+	for _, value := range []string{"abc", "0", "-1", `"7"`} {
+		_, err = revisionFromEtag(value)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), value)
+	}
+}
+
+func TestCheckEtag(t *testing.T) {
+	assert.NoError(t, checkEtag(etag(3), 0, "update team"))
+	assert.NoError(t, checkEtag(etag(3), 3, "update team"))
+	assert.Equal(t, codes.Aborted, status.Code(checkEtag(etag(3), 2, "update team")))
+}
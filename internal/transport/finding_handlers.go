@@ -0,0 +1,237 @@
+package data_processor
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) UploadFindings(stream ScanInfoService_UploadFindingsServer) error {
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "scan_id is required")
+	}
+	if err != nil {
+		return err
+	}
+
+	findings := &findingStream{stream: stream, scanID: first.ScanId, batch: first.Findings, message: 1}
+	count, err := s.repositories.ReplaceFindings(stream.Context(), int(first.ScanId), nil, findings.next)
+	if err != nil {
+		return repoError(err, "upload findings")
+	}
+	return stream.SendAndClose(&UploadFindingsResponse{ScanId: first.ScanId, Count: int64(count)})
+}
+
+// findingStream отдаёт находки из сообщений потока по одной
+type findingStream struct {
+	stream ScanInfoService_UploadFindingsServer
+	scanID int32
+	batch  []*UploadedFinding
+	pos    int
+	// message — номер текущего сообщения в потоке, начиная с 1
+	message int
+}
+
+func (r *findingStream) next() (*common.Finding, error) {
+	for r.pos == len(r.batch) {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if err := checkStreamScan(r.scanID, req.ScanId); err != nil {
+			return nil, err
+		}
+		r.batch, r.pos = req.Findings, 0
+		r.message++
+	}
+
+	finding := convertFindingFromProto(r.batch[r.pos])
+	if err := finding.Validate(); err != nil {
+		var domainErr *common.Error
+		if errors.As(err, &domainErr) {
+			// Индекс считается внутри сообщения, как и в нарушениях правил из processor.proto
+			err = common.ValidationError(fmt.Sprintf("findings[%d].%s", r.pos, domainErr.Field),
+				fmt.Sprintf("%s (message %d of the stream)", domainErr.Msg, r.message))
+		}
+		return nil, err
+	}
+	r.pos++
+	return finding, nil
+}
+
+func (s *Server) ListFindings(ctx context.Context, req *ListFindingsRequest) (*ListFindingsResponse, error) {
+	list := fmt.Sprintf("findings:%d:%d", req.ScanId, req.MinSeverity)
+	page, err := s.pageFromRequest(req, list)
+	if err != nil {
+		return nil, err
+	}
+
+	findings, total, err := s.repositories.ListFindings(ctx, int(req.ScanId), severityFromProto(req.MinSeverity), page.Page)
+	if err != nil {
+		return nil, listError(err, "findings")
+	}
+
+	findings, nextPageToken, err := nextPage(s, findings, page, func(item *common.Finding) common.PageKey {
+		return common.PageKey{ID: item.ID}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListFindingsResponse{TotalCount: int32(total), NextPageToken: nextPageToken}
+	for _, finding := range findings {
+		resp.Findings = append(resp.Findings, convertFindingToProto(finding))
+	}
+	return resp, nil
+}
+
+// convertFindingFromProto дополняет находку отпечатком по умолчанию; нулевая важность
+// остаётся пустой и отклоняется common.Finding.Validate
+func convertFindingFromProto(f *UploadedFinding) *common.Finding {
+	finding := &common.Finding{
+		Tool:                f.Tool,
+		RuleID:              f.RuleId,
+		Severity:            severityFromProto(f.Severity),
+		CWE:                 intPtr(f.Cwe),
+		File:                f.File,
+		StartLine:           intPtr(f.StartLine),
+		EndLine:             intPtr(f.EndLine),
+		Message:             f.Message,
+		Fingerprint:         f.Fingerprint,
+		ToolVersion:         f.ToolVersion,
+		PartialFingerprints: f.PartialFingerprints,
+		Suppression:         suppressionFromProto(f.Suppression),
+	}
+	if finding.Fingerprint == "" {
+		finding.Fingerprint = finding.DefaultFingerprint()
+	}
+	return finding
+}
+
+func convertFindingToProto(f *common.Finding) *Finding {
+	return &Finding{
+		Id:                  int64(f.ID),
+		ScanId:              int32(f.ScanID),
+		Tool:                f.Tool,
+		RuleId:              f.RuleID,
+		Severity:            severityToProto(f.Severity),
+		Cwe:                 int32Ptr(f.CWE),
+		File:                f.File,
+		StartLine:           int32Ptr(f.StartLine),
+		EndLine:             int32Ptr(f.EndLine),
+		Message:             f.Message,
+		Fingerprint:         f.Fingerprint,
+		ToolVersion:         f.ToolVersion,
+		PartialFingerprints: f.PartialFingerprints,
+		Suppression:         suppressionToProto(f.Suppression),
+	}
+}
+
+// suppressionFromProto считает подавление без статуса принятым; неизвестный вид остаётся пустым
+// и отклоняется common.Finding.Validate
+func suppressionFromProto(s *FindingSuppression) *common.Suppression {
+	if s == nil {
+		return nil
+	}
+	suppression := &common.Suppression{Status: common.SuppressionAccepted, Justification: s.Justification}
+	switch s.Kind {
+	case SuppressionKind_SUPPRESSION_KIND_IN_SOURCE:
+		suppression.Kind = common.SuppressionInSource
+	case SuppressionKind_SUPPRESSION_KIND_EXTERNAL:
+		suppression.Kind = common.SuppressionExternal
+	}
+	switch s.Status {
+	case SuppressionStatus_SUPPRESSION_STATUS_UNDER_REVIEW:
+		suppression.Status = common.SuppressionUnderReview
+	case SuppressionStatus_SUPPRESSION_STATUS_REJECTED:
+		suppression.Status = common.SuppressionRejected
+	}
+	return suppression
+}
+
+func suppressionToProto(s *common.Suppression) *FindingSuppression {
+	if s == nil {
+		return nil
+	}
+	suppression := &FindingSuppression{Justification: s.Justification}
+	switch s.Kind {
+	case common.SuppressionInSource:
+		suppression.Kind = SuppressionKind_SUPPRESSION_KIND_IN_SOURCE
+	case common.SuppressionExternal:
+		suppression.Kind = SuppressionKind_SUPPRESSION_KIND_EXTERNAL
+	}
+	switch s.Status {
+	case common.SuppressionAccepted:
+		suppression.Status = SuppressionStatus_SUPPRESSION_STATUS_ACCEPTED
+	case common.SuppressionUnderReview:
+		suppression.Status = SuppressionStatus_SUPPRESSION_STATUS_UNDER_REVIEW
+	case common.SuppressionRejected:
+		suppression.Status = SuppressionStatus_SUPPRESSION_STATUS_REJECTED
+	}
+	return suppression
+}
+
+func severityToProto(severity common.Severity) Severity {
+	switch severity {
+	case common.SeverityInfo:
+		return Severity_SEVERITY_INFO
+	case common.SeverityLow:
+		return Severity_SEVERITY_LOW
+	case common.SeverityMedium:
+		return Severity_SEVERITY_MEDIUM
+	case common.SeverityHigh:
+		return Severity_SEVERITY_HIGH
+	case common.SeverityCritical:
+		return Severity_SEVERITY_CRITICAL
+	}
+	return Severity_SEVERITY_UNSPECIFIED
+}
+
+func severityFromProto(severity Severity) common.Severity {
+	switch severity {
+	case Severity_SEVERITY_INFO:
+		return common.SeverityInfo
+	case Severity_SEVERITY_LOW:
+		return common.SeverityLow
+	case Severity_SEVERITY_MEDIUM:
+		return common.SeverityMedium
+	case Severity_SEVERITY_HIGH:
+		return common.SeverityHigh
+	case Severity_SEVERITY_CRITICAL:
+		return common.SeverityCritical
+	}
+	return ""
+}
+
+func (s *Server) ListFindingRules(ctx context.Context, req *ListFindingRulesRequest) (*ListFindingRulesResponse, error) {
+	rules, err := s.repositories.ListFindingRules(ctx, int(req.ScanId))
+	if err != nil {
+		return nil, repoError(err, "list finding rules")
+	}
+
+	resp := &ListFindingRulesResponse{}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, convertFindingRuleToProto(rule))
+	}
+	return resp, nil
+}
+
+func convertFindingRuleToProto(r *common.FindingRule) *FindingRule {
+	return &FindingRule{
+		ScanId:           int32(r.ScanID),
+		Tool:             r.Tool,
+		RuleId:           r.RuleID,
+		Name:             r.Name,
+		ShortDescription: r.ShortDescription,
+		FullDescription:  r.FullDescription,
+		Help:             r.Help,
+		HelpUri:          r.HelpURI,
+		Tags:             r.Tags,
+	}
+}
@@ -0,0 +1,139 @@
+package data_processor
+
+import (
+	"data_processor/internal/common"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestConvertFinding(t *testing.T) {
+	uploaded := &UploadedFinding{
+		Tool:      "semgrep",
+		RuleId:    "go.sql-injection",
+		Severity:  Severity_SEVERITY_HIGH,
+		Cwe:       proto.Int32(89),
+		File:      proto.String("internal/repo/db.go"),
+		StartLine: proto.Int32(10),
+		EndLine:   proto.Int32(12),
+		Message:   "SQL built from user input",
+	}
+	finding := convertFindingFromProto(uploaded)
+	require.NoError(t, finding.Validate())
+	assert.Equal(t, common.SeverityHigh, finding.Severity)
+	assert.Equal(t, 89, *finding.CWE)
+
+	// Отпечаток по умолчанию не зависит от строк
+	assert.Len(t, finding.Fingerprint, 64)
+	uploaded.StartLine, uploaded.EndLine = proto.Int32(40), nil
+	assert.Equal(t, finding.Fingerprint, convertFindingFromProto(uploaded).Fingerprint)
+	uploaded.Fingerprint = "tool-fingerprint"
+	assert.Equal(t, "tool-fingerprint", convertFindingFromProto(uploaded).Fingerprint)
+
+	resp := convertFindingToProto(finding)
+	assert.Equal(t, Severity_SEVERITY_HIGH, resp.Severity)
+	assert.Equal(t, int32(12), resp.GetEndLine())
+
+	unspecified := convertFindingFromProto(&UploadedFinding{Tool: "t", RuleId: "r", Message: "m"})
+	assert.ErrorIs(t, unspecified.Validate(), common.ErrValidation)
+
+	inverted := convertFindingFromProto(&UploadedFinding{Tool: "t", RuleId: "r", Message: "m", Severity: Severity_SEVERITY_LOW,
+		StartLine: proto.Int32(5), EndLine: proto.Int32(4)})
+	assert.ErrorIs(t, inverted.Validate(), common.ErrValidation)
+	// Подавление без статуса принято, без вида — отклоняется
+	uploaded.Suppression = &FindingSuppression{Kind: SuppressionKind_SUPPRESSION_KIND_IN_SOURCE, Justification: "test data"}
+	suppressed := convertFindingFromProto(uploaded)
+	require.NoError(t, suppressed.Validate())
+	assert.True(t, suppressed.Suppressed())
+	assert.Equal(t, SuppressionStatus_SUPPRESSION_STATUS_ACCEPTED, convertFindingToProto(suppressed).Suppression.Status)
+
+	uploaded.Suppression = &FindingSuppression{Status: SuppressionStatus_SUPPRESSION_STATUS_REJECTED}
+	err := convertFindingFromProto(uploaded).Validate()
+	var domainErr *common.Error
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "suppression.kind", domainErr.Field)
+}
+
+// uploadStream отдаёт заранее заданные сообщения, затем io.EOF. Подходит для любого
+// потока загрузки: Req — тип сообщений клиента, Resp — тип ответа
+type uploadStream[Req, Resp any] struct {
+	grpc.ServerStream
+	requests []Req
+}
+
+func (s *uploadStream[Req, Resp]) Recv() (Req, error) {
+	if len(s.requests) == 0 {
+		var zero Req
+		return zero, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *uploadStream[Req, Resp]) SendAndClose(Resp) error {
+	return nil
+}
+
+func TestUploadFindingsEmptyStream(t *testing.T) {
+	s := &Server{}
+	err := s.UploadFindings(&uploadStream[*UploadFindingsRequest, *UploadFindingsResponse]{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestFindingStream(t *testing.T) {
+	valid := &UploadedFinding{Tool: "t", RuleId: "r", Message: "m", Severity: Severity_SEVERITY_LOW}
+	inverted := &UploadedFinding{Tool: "t", RuleId: "r", Message: "m", Severity: Severity_SEVERITY_LOW,
+		StartLine: proto.Int32(5), EndLine: proto.Int32(4)}
+	findings := &findingStream{
+		stream:  &uploadStream[*UploadFindingsRequest, *UploadFindingsResponse]{requests: []*UploadFindingsRequest{{ScanId: 1}, {ScanId: 1, Findings: []*UploadedFinding{valid, inverted}}}},
+		scanID:  1,
+		batch:   []*UploadedFinding{valid},
+		message: 1,
+	}
+
+	for range 2 {
+		_, err := findings.next()
+		require.NoError(t, err)
+	}
+	// Индекс считается внутри сообщения, а сообщение называется по номеру; пустое тоже считается
+	_, err := findings.next()
+	var domainErr *common.Error
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "findings[1].end_line", domainErr.Field)
+	assert.Contains(t, domainErr.Msg, "(message 3 of the stream)")
+}
+
+func TestUploadSarifInvalidLog(t *testing.T) {
+	s := &Server{}
+
+	// Журнал разрезан на части посреди значения
+	err := s.UploadSarif(&uploadStream[*UploadSarifRequest, *UploadFindingsResponse]{requests: []*UploadSarifRequest{
+		{ScanId: 1, Chunk: []byte(`{"version": "2.1.0", "runs": [{"tool": {"driver": {"na`)},
+		{ScanId: 1, Chunk: []byte(`me": "t"}}, "results": [{"ruleId": "R1", "message": {}}]}]}`)},
+	}})
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	violation := st.Details()[0].(*errdetails.BadRequest).FieldViolations[0]
+	assert.Equal(t, "chunk", violation.Field)
+	assert.Equal(t, "runs[0].results[0].message: text is required", violation.Description)
+
+	err = s.UploadSarif(&uploadStream[*UploadSarifRequest, *UploadFindingsResponse]{requests: []*UploadSarifRequest{
+		{ScanId: 1, Chunk: []byte(`{"version": `)},
+		{ScanId: 2, Chunk: []byte(`"2.1.0", "runs": []}`)},
+	}})
+	st = status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Contains(t, st.Message(), "scan_id must be 1")
+
+	err = s.UploadSarif(&uploadStream[*UploadSarifRequest, *UploadFindingsResponse]{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
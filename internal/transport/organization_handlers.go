@@ -3,8 +3,6 @@ package data_processor
 import (
 	"context"
 	"data_processor/internal/common"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -15,103 +13,123 @@ func (s *Server) CreateOrganization(ctx context.Context, req *CreateOrganization
 	}
 
 	if err := s.repositories.CreateOrganization(ctx, org); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create organization: %v", err)
+		return nil, repoError(err, "create organization")
 	}
 
 	return &Organization{
 		Id:          int32(org.ID),
 		ProjectName: org.ProjectName,
 		OwnerId:     int32(org.OwnerID),
+		Etag:        etag(org.Revision),
 	}, nil
 }
 
 func (s *Server) GetOrganization(ctx context.Context, req *GetOrganizationRequest) (*Organization, error) {
 	org, err := s.repositories.GetOrganizationByID(ctx, int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
-	}
-	if org == nil {
-		return nil, status.Errorf(codes.NotFound, "organization not found")
+		return nil, repoError(err, "get organization")
 	}
 
 	return &Organization{
 		Id:          int32(org.ID),
 		ProjectName: org.ProjectName,
 		OwnerId:     int32(org.OwnerID),
+		Etag:        etag(org.Revision),
 	}, nil
 }
 
 func (s *Server) GetOrganizationByName(ctx context.Context, req *GetOrganizationByNameRequest) (*Organization, error) {
 	org, err := s.repositories.GetOrganizationByName(ctx, req.Name)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get organization: %v", err)
-	}
-	if org == nil {
-		return nil, status.Errorf(codes.NotFound, "organization not found")
+		return nil, repoError(err, "get organization")
 	}
 
 	return &Organization{
 		Id:          int32(org.ID),
 		ProjectName: org.ProjectName,
 		OwnerId:     int32(org.OwnerID),
+		Etag:        etag(org.Revision),
 	}, nil
 }
 
 func (s *Server) UpdateOrganization(ctx context.Context, req *UpdateOrganizationRequest) (*Organization, error) {
-	currentOrg, err := s.repositories.GetOrganizationByID(ctx, int(req.Id))
+	fields, err := updatePaths(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current organization: %v", err)
+		return nil, err
 	}
-	if currentOrg == nil {
-		return nil, status.Errorf(codes.NotFound, "organization not found")
+	if err := requireMaskedIDs(req, fields, "owner_id"); err != nil {
+		return nil, err
 	}
-
-	updatedOrg := &common.Organization{
-		ID: int(req.Id),
+	revision, err := revisionFromEtag(req.Etag)
+	if err != nil {
+		return nil, err
 	}
-
-	if req.ProjectName != nil {
-		updatedOrg.ProjectName = *req.ProjectName
-	} else {
-		updatedOrg.ProjectName = currentOrg.ProjectName
+	if len(fields) == 0 {
+		org, err := s.GetOrganization(ctx, &GetOrganizationRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		if err := checkEtag(org.Etag, revision, "update organization"); err != nil {
+			return nil, err
+		}
+		return org, nil
 	}
 
-	if req.OwnerId != nil {
-		updatedOrg.OwnerID = common.UserID(*req.OwnerId)
-	} else {
-		updatedOrg.OwnerID = currentOrg.OwnerID
+	org := &common.Organization{
+		ID:          int(req.Id),
+		Revision:    revision,
+		ProjectName: req.GetProjectName(),
+		OwnerID:     common.UserID(req.GetOwnerId()),
 	}
-
-	if err := s.repositories.UpdateOrganization(ctx, updatedOrg); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update organization: %v", err)
+	updatedOrg, err := s.repositories.UpdateOrganization(ctx, org, fields)
+	if err != nil {
+		return nil, repoError(err, "update organization")
 	}
 
 	return &Organization{
 		Id:          int32(updatedOrg.ID),
 		ProjectName: updatedOrg.ProjectName,
 		OwnerId:     int32(updatedOrg.OwnerID),
+		Etag:        etag(updatedOrg.Revision),
 	}, nil
 }
 
 func (s *Server) DeleteOrganization(ctx context.Context, req *DeleteOrganizationRequest) (*emptypb.Empty, error) {
-	if err := s.repositories.DeleteOrganization(ctx, int(req.Id)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete organization: %v", err)
+	revision, err := revisionFromEtag(req.Etag)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repositories.DeleteOrganization(ctx, int(req.Id), revision); err != nil {
+		return nil, repoError(err, "delete organization")
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) ListOrganizations(ctx context.Context, req *ListOrganizationsRequest) (*ListOrganizationsResponse, error) {
-	orgs, err := s.repositories.ListOrganizations(ctx)
+	page, err := s.pageFromRequest(req, "organizations")
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list organizations: %v", err)
+		return nil, err
 	}
 
-	resp := &ListOrganizationsResponse{}
+	orgs, total, err := s.repositories.ListOrganizations(ctx, page.Page)
+	if err != nil {
+		return nil, listError(err, "organizations")
+	}
+
+	orgs, nextPageToken, err := nextPage(s, orgs, page, func(item *common.Organization) common.PageKey {
+		return common.PageKey{ID: item.ID}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListOrganizationsResponse{TotalCount: int32(total), NextPageToken: nextPageToken}
 	for _, org := range orgs {
 		resp.Organizations = append(resp.Organizations, &Organization{
 			Id:          int32(org.ID),
 			ProjectName: org.ProjectName,
 			OwnerId:     int32(org.OwnerID),
+			Etag:        etag(org.Revision),
 		})
 	}
 
@@ -121,7 +139,7 @@ func (s *Server) ListOrganizations(ctx context.Context, req *ListOrganizationsRe
 func (s *Server) ListOrganizationsByOwner(ctx context.Context, req *ListByOwnerRequest) (*ListOrganizationsResponse, error) {
 	orgs, err := s.repositories.ListOrganizationsByOwner(ctx, common.UserID(req.OwnerId))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list organizations by owner: %v", err)
+		return nil, repoError(err, "list organizations by owner")
 	}
 
 	resp := &ListOrganizationsResponse{}
@@ -130,6 +148,7 @@ func (s *Server) ListOrganizationsByOwner(ctx context.Context, req *ListByOwnerR
 			Id:          int32(org.ID),
 			ProjectName: org.ProjectName,
 			OwnerId:     int32(org.OwnerID),
+			Etag:        etag(org.Revision),
 		})
 	}
 
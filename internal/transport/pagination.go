@@ -0,0 +1,111 @@
+package data_processor
+
+import (
+	"data_processor/internal/common"
+	"data_processor/internal/filter"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultPageSize используется, когда клиент не указал limit
+	defaultPageSize = 100
+	// maxPageSize — верхняя граница страницы: больший limit молча уменьшается
+	maxPageSize = 1000
+)
+
+// pagedRequest is implemented by every List request that supports paging and filtering.
+type pagedRequest interface {
+	GetLimit() int32
+	GetOffset() int32
+	GetPageToken() string
+	GetFilter() string
+	GetOrderBy() string
+}
+
+// listPage — страница репозитория и область действия её токенов
+type listPage struct {
+	common.Page
+	// scope — список вместе с filter и order_by: токен годится только для той же выборки
+	scope string
+}
+
+// pageFromRequest переводит limit/offset/page_token/filter/order_by из запроса в страницу
+// репозитория. list идентифицирует список, для которого выдан токен.
+func (s *Server) pageFromRequest(req pagedRequest, list string) (listPage, error) {
+	limit, offset := req.GetLimit(), req.GetOffset()
+	if limit < 0 {
+		return listPage{}, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+	if offset < 0 {
+		return listPage{}, status.Errorf(codes.InvalidArgument, "offset must not be negative")
+	}
+
+	page := listPage{
+		Page: common.Page{
+			Limit:   int(limit),
+			Offset:  int(offset),
+			Filter:  req.GetFilter(),
+			OrderBy: req.GetOrderBy(),
+		},
+		scope: list + "\x00" + req.GetFilter() + "\x00" + req.GetOrderBy(),
+	}
+	if page.Limit == 0 {
+		page.Limit = defaultPageSize
+	}
+	if page.Limit > maxPageSize {
+		page.Limit = maxPageSize
+	}
+
+	if token := req.GetPageToken(); token != "" {
+		if offset != 0 {
+			return listPage{}, status.Errorf(codes.InvalidArgument, "page_token and offset cannot be combined")
+		}
+		key, err := s.pageTokens.Verify(token, page.scope)
+		if err != nil {
+			return listPage{}, status.Errorf(codes.InvalidArgument, "invalid page_token")
+		}
+		// При пользовательской сортировке токен хранит смещение, иначе — ключ последней строки
+		if page.OrderBy != "" {
+			page.Offset = key.Offset
+		} else {
+			page.After = &key
+		}
+	}
+
+	// Запрашиваем на одну строку больше, чтобы знать, есть ли следующая страница
+	page.Limit++
+	return page, nil
+}
+
+// nextPage отрезает лишнюю строку, запрошенную pageFromRequest, и возвращает токен следующей
+// страницы, указывающий на последнюю отданную строку. Токен выдаётся и при листании по offset,
+// чтобы клиент мог перейти на курсоры.
+func nextPage[T any](s *Server, items []T, page listPage, key func(T) common.PageKey) ([]T, string, error) {
+	size := page.Limit - 1
+	if len(items) <= size {
+		return items, "", nil
+	}
+	items = items[:size]
+
+	next := common.PageKey{Offset: page.Offset + size}
+	if page.OrderBy == "" {
+		next = key(items[size-1])
+	}
+	token, err := s.pageTokens.Sign(page.scope, next)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "failed to sign page token: %v", err)
+	}
+	return items, token, nil
+}
+
+// listError переводит ошибку списка в статус: некорректные filter/order_by — ошибка клиента
+func listError(err error, entity string) error {
+	var filterErr *filter.Error
+	if errors.As(err, &filterErr) {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return repoError(err, "list "+entity)
+}
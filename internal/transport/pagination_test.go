@@ -0,0 +1,116 @@
+package data_processor
+
+import (
+	"data_processor/internal/auth"
+	"data_processor/internal/common"
+	"data_processor/internal/filter"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newPagingServer() *Server {
+	return &Server{pageTokens: auth.NewPageTokenSigner([]byte("secret"))}
+}
+
+func versionKey(v *common.Version) common.PageKey {
+	return common.PageKey{ID: v.ID}
+}
+
+func TestPageFromRequest(t *testing.T) {
+	s := newPagingServer()
+
+	// Лимит увеличен на одну строку, чтобы обнаружить следующую страницу
+	page, err := s.pageFromRequest(&ListUsersRequest{}, "users")
+	require.NoError(t, err)
+	assert.Equal(t, common.Page{Limit: defaultPageSize + 1}, page.Page)
+
+	page, err = s.pageFromRequest(&ListUsersRequest{Limit: 25, Offset: 50, Filter: "is_admin = true", OrderBy: "name"}, "users")
+	require.NoError(t, err)
+	assert.Equal(t, common.Page{Limit: 26, Offset: 50, Filter: "is_admin = true", OrderBy: "name"}, page.Page)
+
+	// Слишком большой limit урезается до максимума
+	page, err = s.pageFromRequest(&ListUsersRequest{Limit: maxPageSize + 1}, "users")
+	require.NoError(t, err)
+	assert.Equal(t, maxPageSize+1, page.Limit)
+
+	_, err = s.pageFromRequest(&ListUsersRequest{Limit: -1}, "users")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.pageFromRequest(&ListUsersRequest{Offset: -1}, "users")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPageTokens(t *testing.T) {
+	s := newPagingServer()
+	versions := []*common.Version{{ID: 1}, {ID: 2}, {ID: 3}}
+
+	page, err := s.pageFromRequest(&ListVersionsRequest{Limit: 2}, "versions:1")
+	require.NoError(t, err)
+
+	// Репозиторий вернул на строку больше — страница обрезается и выдаётся токен
+	items, token, err := nextPage(s, versions, page, versionKey)
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+	require.NotEmpty(t, token)
+
+	page, err = s.pageFromRequest(&ListVersionsRequest{Limit: 2, PageToken: token}, "versions:1")
+	require.NoError(t, err)
+	require.NotNil(t, page.After)
+	assert.Equal(t, 2, page.After.ID)
+
+	// Последняя страница — без токена
+	items, token, err = nextPage(s, versions[2:], page, versionKey)
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Empty(t, token)
+
+	t.Run("Token From Another List", func(t *testing.T) {
+		page, err := s.pageFromRequest(&ListVersionsRequest{Limit: 2}, "versions:1")
+		require.NoError(t, err)
+		_, token, err := nextPage(s, versions, page, versionKey)
+		require.NoError(t, err)
+
+		_, err = s.pageFromRequest(&ListVersionsRequest{Limit: 2, PageToken: token}, "versions:2")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Токен не переносится на другую выборку того же списка
+		_, err = s.pageFromRequest(&ListVersionsRequest{Limit: 2, PageToken: token, Filter: "id > 1"}, "versions:1")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Token With Offset", func(t *testing.T) {
+		page, err := s.pageFromRequest(&ListUsersRequest{Limit: 2}, "users")
+		require.NoError(t, err)
+		_, token, err := nextPage(s, versions, page, versionKey)
+		require.NoError(t, err)
+
+		_, err = s.pageFromRequest(&ListUsersRequest{Offset: 10, PageToken: token}, "users")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Custom Order Continues By Offset", func(t *testing.T) {
+		page, err := s.pageFromRequest(&ListVersionsRequest{Limit: 2, OrderBy: "version desc"}, "versions:1")
+		require.NoError(t, err)
+		_, token, err := nextPage(s, versions, page, versionKey)
+		require.NoError(t, err)
+
+		page, err = s.pageFromRequest(&ListVersionsRequest{Limit: 2, OrderBy: "version desc", PageToken: token}, "versions:1")
+		require.NoError(t, err)
+		assert.Nil(t, page.After)
+		assert.Equal(t, 2, page.Offset)
+	})
+}
+
+func TestListError(t *testing.T) {
+	err := listError(fmt.Errorf("invalid filter: %w", &filter.Error{Pos: 3, Msg: "unknown field"}), "users")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "position 3")
+
+	err = listError(errors.New("connection refused"), "users")
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
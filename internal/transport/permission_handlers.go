@@ -2,11 +2,13 @@ package data_processor
 
 import (
 	"context"
+	"data_processor/internal/auth"
 	"data_processor/internal/common"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"log"
+	"slices"
 )
 
 func (s *Server) CreatePermission(ctx context.Context, req *CreatePermissionRequest) (*Permission, error) {
@@ -27,7 +29,7 @@ func (s *Server) CreatePermission(ctx context.Context, req *CreatePermissionRequ
 	}
 
 	if err := s.repositories.CreatePermission(ctx, perm); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create permission: %v", err)
+		return nil, repoError(err, "create permission")
 	}
 
 	resp := &Permission{
@@ -36,6 +38,7 @@ func (s *Server) CreatePermission(ctx context.Context, req *CreatePermissionRequ
 		Description: perm.Description,
 		Read:        perm.Read,
 		Write:       perm.Write,
+		Etag:        etag(perm.Revision),
 	}
 
 	if perm.OrganizationID != nil {
@@ -50,10 +53,7 @@ func (s *Server) CreatePermission(ctx context.Context, req *CreatePermissionRequ
 func (s *Server) GetPermission(ctx context.Context, req *GetPermissionRequest) (*Permission, error) {
 	perm, err := s.repositories.GetPermissionByID(ctx, int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get permission: %v", err)
-	}
-	if perm == nil {
-		return nil, status.Errorf(codes.NotFound, "permission not found")
+		return nil, repoError(err, "get permission")
 	}
 
 	resp := &Permission{
@@ -62,6 +62,7 @@ func (s *Server) GetPermission(ctx context.Context, req *GetPermissionRequest) (
 		Description: perm.Description,
 		Read:        perm.Read,
 		Write:       perm.Write,
+		Etag:        etag(perm.Revision),
 	}
 
 	if perm.OrganizationID != nil {
@@ -83,7 +84,7 @@ func (s *Server) GetTeamPermissions(
 
 	perms, err := s.repositories.GetTeamPermissions(ctx, userID, teamID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get team permissions: %v", err)
+		return nil, repoError(err, "get team permissions")
 	}
 
 	return &GetPermissionsResponse{
@@ -110,7 +111,7 @@ func (s *Server) GetOrganizationPermissions(
 
 	perms, err := s.repositories.GetOrganizationPermissions(ctx, userID, orgID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get organization permissions: %v", err)
+		return nil, repoError(err, "get organization permissions")
 	}
 
 	return &GetPermissionsResponse{
@@ -127,11 +128,7 @@ func (s *Server) GetPermissionByName(ctx context.Context, req *GetPermissionByNa
 	// Получаем permission из репозитория
 	perm, err := s.repositories.GetPermissionByName(ctx, req.Name)
 	if err != nil {
-		log.Printf("Error getting permission by name '%s': %v", req.Name, err)
-		return nil, status.Errorf(codes.Internal, "failed to get permission")
-	}
-	if perm == nil {
-		return nil, status.Errorf(codes.NotFound, "permission '%s' not found", req.Name)
+		return nil, repoError(err, "get permission")
 	}
 
 	// Создаем response объект
@@ -141,6 +138,7 @@ func (s *Server) GetPermissionByName(ctx context.Context, req *GetPermissionByNa
 		Description: perm.Description,
 		Read:        perm.Read,
 		Write:       perm.Write,
+		Etag:        etag(perm.Revision),
 	}
 
 	// Устанавливаем scope в зависимости от типа permission
@@ -158,90 +156,59 @@ func (s *Server) GetPermissionByName(ctx context.Context, req *GetPermissionByNa
 }
 
 func (s *Server) UpdatePermission(ctx context.Context, req *UpdatePermissionRequest) (*Permission, error) {
-	// 1. Получаем текущее состояние permission
-	currentPerm, err := s.repositories.GetPermissionByID(ctx, int(req.Id))
+	fields, err := updatePaths(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current permission: %v", err)
-	}
-	if currentPerm == nil {
-		return nil, status.Errorf(codes.NotFound, "permission with id %d not found", req.Id)
-	}
-
-	// 2. Подготавливаем обновленные данные
-	updatedPerm := &common.Permission{
-		ID: int(req.Id),
+		return nil, err
 	}
-
-	// 3. Обрабатываем Name
-	if req.Name != nil {
-		updatedPerm.Name = *req.Name
-	} else {
-		updatedPerm.Name = currentPerm.Name
+	revision, err := revisionFromEtag(req.Etag)
+	if err != nil {
+		return nil, err
 	}
-
-	// 4. Обрабатываем Description
-	if req.Description != nil {
-		updatedPerm.Description = req.Description
-	} else {
-		updatedPerm.Description = currentPerm.Description
+	// Область разрешения задаётся при создании и не меняется
+	if slices.Contains(fields, "organization_id") || slices.Contains(fields, "team_id") {
+		return nil, status.Errorf(codes.InvalidArgument, "permission scope cannot be changed")
 	}
-
-	// 5. Обрабатываем Read/Write
-	if req.Read != nil {
-		updatedPerm.Read = *req.Read
-	} else {
-		updatedPerm.Read = currentPerm.Read
+	if len(fields) == 0 {
+		perm, err := s.GetPermission(ctx, &GetPermissionRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		if err := checkEtag(perm.Etag, revision, "update permission"); err != nil {
+			return nil, err
+		}
+		return perm, nil
 	}
 
-	if req.Write != nil {
-		updatedPerm.Write = *req.Write
-	} else {
-		updatedPerm.Write = currentPerm.Write
+	perm := &common.Permission{
+		ID:          int(req.Id),
+		Revision:    revision,
+		Name:        req.GetName(),
+		Description: req.Description,
+		Read:        req.GetRead(),
+		Write:       req.GetWrite(),
 	}
 
-	// 6. Обрабатываем Scope
-	switch scope := req.Scope.(type) {
-	case *UpdatePermissionRequest_OrganizationId:
-		orgID := int(scope.OrganizationId)
-		// Проверяем существование организации
-		if _, err := s.repositories.GetOrganizationByID(ctx, orgID); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "organization with id %d not found", orgID)
+	// Проверяем уникальность имени permission
+	if slices.Contains(fields, "name") {
+		if existingPerm, err := s.repositories.GetPermissionByName(ctx, perm.Name); err == nil && existingPerm.ID != perm.ID {
+			return nil, status.Errorf(codes.AlreadyExists, "permission with name '%s' already exists", perm.Name)
+		} else if err != nil && !common.IsNotFound(err) {
+			return nil, repoError(err, "check permission uniqueness")
 		}
-		updatedPerm.OrganizationID = &orgID
-		updatedPerm.TeamID = nil
-	case *UpdatePermissionRequest_TeamId:
-		teamID := int(scope.TeamId)
-		// Проверяем существование команды
-		if _, err := s.repositories.GetTeamByID(ctx, teamID); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "team with id %d not found", teamID)
-		}
-		updatedPerm.TeamID = &teamID
-		updatedPerm.OrganizationID = nil
-	default:
-		// Сохраняем текущий scope если не указан новый
-		updatedPerm.OrganizationID = currentPerm.OrganizationID
-		updatedPerm.TeamID = currentPerm.TeamID
 	}
 
-	// 7. Проверяем уникальность имени permission
-	if existingPerm, err := s.repositories.GetPermissionByName(ctx, updatedPerm.Name); err == nil && existingPerm != nil && existingPerm.ID != updatedPerm.ID {
-		return nil, status.Errorf(codes.AlreadyExists, "permission with name '%s' already exists", updatedPerm.Name)
-	} else if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check permission uniqueness: %v", err)
-	}
-
-	// 8. Обновляем permission в репозитории
-	if err := s.repositories.UpdatePermission(ctx, updatedPerm); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update permission: %v", err)
+	updatedPerm, err := s.repositories.UpdatePermission(ctx, perm, fields)
+	if err != nil {
+		return nil, repoError(err, "update permission")
 	}
 
-	// 9. Формируем ответ
 	resp := &Permission{
 		Id:          int32(updatedPerm.ID),
 		Name:        updatedPerm.Name,
 		Description: updatedPerm.Description,
 		Read:        updatedPerm.Read,
 		Write:       updatedPerm.Write,
+		Etag:        etag(updatedPerm.Revision),
 	}
 
 	if updatedPerm.OrganizationID != nil {
@@ -254,19 +221,35 @@ func (s *Server) UpdatePermission(ctx context.Context, req *UpdatePermissionRequ
 }
 
 func (s *Server) DeletePermission(ctx context.Context, req *DeletePermissionRequest) (*emptypb.Empty, error) {
-	if err := s.repositories.DeletePermission(ctx, int(req.Id)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete permission: %v", err)
+	revision, err := revisionFromEtag(req.Etag)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repositories.DeletePermission(ctx, int(req.Id), revision); err != nil {
+		return nil, repoError(err, "delete permission")
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) ListPermissions(ctx context.Context, req *ListPermissionsRequest) (*ListPermissionsResponse, error) {
-	perms, err := s.repositories.ListPermissions(ctx)
+	page, err := s.pageFromRequest(req, "permissions")
+	if err != nil {
+		return nil, err
+	}
+
+	perms, total, err := s.repositories.ListPermissions(ctx, page.Page)
+	if err != nil {
+		return nil, listError(err, "permissions")
+	}
+
+	perms, nextPageToken, err := nextPage(s, perms, page, func(item *common.Permission) common.PageKey {
+		return common.PageKey{ID: item.ID}
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list permissions: %v", err)
+		return nil, err
 	}
 
-	resp := &ListPermissionsResponse{}
+	resp := &ListPermissionsResponse{TotalCount: int32(total), NextPageToken: nextPageToken}
 	for _, perm := range perms {
 		p := &Permission{
 			Id:          int32(perm.ID),
@@ -274,6 +257,7 @@ func (s *Server) ListPermissions(ctx context.Context, req *ListPermissionsReques
 			Description: perm.Description,
 			Read:        perm.Read,
 			Write:       perm.Write,
+			Etag:        etag(perm.Revision),
 		}
 
 		if perm.OrganizationID != nil {
@@ -287,3 +271,140 @@ func (s *Server) ListPermissions(ctx context.Context, req *ListPermissionsReques
 
 	return resp, nil
 }
+
+func (s *Server) GetEffectivePermissions(ctx context.Context, req *GetEffectivePermissionsRequest) (*EffectivePermissions, error) {
+	resourceType, ok := resourceTypesFromProto[req.ResourceType]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported resource type %s", req.ResourceType)
+	}
+	ref := common.ResourceRef{Type: resourceType, ID: int(req.ResourceId)}
+
+	eff, err := s.repositories.GetEffectivePermissions(ctx, common.UserID(req.UserId), ref)
+	if err != nil {
+		return nil, repoError(err, "get effective permissions")
+	}
+	// Без права чтения не раскрываем, существует ли ресурс и где он находится
+	if principal, ok := auth.PrincipalFromContext(ctx); ok && !principal.IsAdmin && (eff == nil || !eff.Read) {
+		return nil, status.Errorf(codes.PermissionDenied, "read access to %s %d denied", ref.Type, ref.ID)
+	}
+	if eff == nil {
+		return nil, status.Errorf(codes.NotFound, "%s not found", ref.Type)
+	}
+
+	return convertEffectivePermissionsToProto(eff), nil
+}
+
+// maxPermissionChecks ограничивает размер одного пакета проверок
+const maxPermissionChecks = 1000
+
+func (s *Server) CheckPermissions(ctx context.Context, req *CheckPermissionsRequest) (*CheckPermissionsResponse, error) {
+	if len(req.Checks) > maxPermissionChecks {
+		return nil, status.Errorf(codes.InvalidArgument, "too many checks: %d, at most %d allowed", len(req.Checks), maxPermissionChecks)
+	}
+
+	refs := make([]common.ResourceRef, len(req.Checks))
+	for i, check := range req.Checks {
+		resourceType, ok := resourceTypesFromProto[check.ResourceType]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "checks[%d]: unsupported resource type %s", i, check.ResourceType)
+		}
+		if check.Action != Action_ACTION_READ && check.Action != Action_ACTION_WRITE {
+			return nil, status.Errorf(codes.InvalidArgument, "checks[%d]: unsupported action %s", i, check.Action)
+		}
+		refs[i] = common.ResourceRef{Type: resourceType, ID: int(check.ResourceId)}
+	}
+
+	rights, err := s.resourceRights(ctx, common.UserID(req.UserId), refs)
+	if err != nil {
+		return nil, repoError(err, "check permissions")
+	}
+
+	resp := &CheckPermissionsResponse{}
+	for i, check := range req.Checks {
+		acc := accessRead
+		if check.Action == Action_ACTION_WRITE {
+			acc = accessWrite
+		}
+		resp.Results = append(resp.Results, &PermissionCheckResult{
+			Check:   check,
+			Allowed: allows(rights[i], acc),
+		})
+	}
+
+	return resp, nil
+}
+
+var resourceTypesFromProto = map[ResourceType]common.ResourceType{
+	ResourceType_RESOURCE_TYPE_ORGANIZATION: common.ResourceOrganization,
+	ResourceType_RESOURCE_TYPE_TEAM:         common.ResourceTeam,
+	ResourceType_RESOURCE_TYPE_APPLICATION:  common.ResourceApplication,
+	ResourceType_RESOURCE_TYPE_VERSION:      common.ResourceVersion,
+	ResourceType_RESOURCE_TYPE_SCAN:         common.ResourceScan,
+	ResourceType_RESOURCE_TYPE_SCAN_INFO:    common.ResourceScanInfo,
+	ResourceType_RESOURCE_TYPE_SCAN_RULE:    common.ResourceScanRule,
+}
+
+func resourceTypeToProto(resourceType common.ResourceType) ResourceType {
+	for protoType, t := range resourceTypesFromProto {
+		if t == resourceType {
+			return protoType
+		}
+	}
+	return ResourceType_RESOURCE_TYPE_UNSPECIFIED
+}
+
+var grantSourcesToProto = map[common.GrantSource]GrantSource{
+	common.GrantSourceAdministrator:     GrantSource_GRANT_SOURCE_ADMINISTRATOR,
+	common.GrantSourceOrganizationOwner: GrantSource_GRANT_SOURCE_ORGANIZATION_OWNER,
+	common.GrantSourceTeamOwner:         GrantSource_GRANT_SOURCE_TEAM_OWNER,
+	common.GrantSourceOrganizationRole:  GrantSource_GRANT_SOURCE_ORGANIZATION_ROLE,
+	common.GrantSourceTeamRole:          GrantSource_GRANT_SOURCE_TEAM_ROLE,
+	common.GrantSourceTeamMember:        GrantSource_GRANT_SOURCE_TEAM_MEMBER,
+}
+
+func convertEffectivePermissionsToProto(eff *common.EffectivePermissions) *EffectivePermissions {
+	resp := &EffectivePermissions{
+		UserId:         int32(eff.UserID),
+		ResourceType:   resourceTypeToProto(eff.Resource.Type),
+		ResourceId:     int32(eff.Resource.ID),
+		OrganizationId: int32(eff.Scope.OrganizationID),
+		TeamId:         int32Ptr(eff.Scope.TeamID),
+		ApplicationId:  int32Ptr(eff.Scope.ApplicationID),
+		Read:           eff.Read,
+		Write:          eff.Write,
+	}
+	for _, g := range eff.Grants {
+		grant := &PermissionGrant{
+			Source:         grantSourcesToProto[g.Source],
+			Read:           g.Read,
+			Write:          g.Write,
+			RoleId:         int32Ptr(g.RoleID),
+			RoleName:       g.RoleName,
+			PermissionId:   int32Ptr(g.PermissionID),
+			PermissionName: g.PermissionName,
+			OrganizationId: int32Ptr(g.OrganizationID),
+			TeamId:         int32Ptr(g.TeamID),
+		}
+		if g.MemberRole != nil {
+			grant.MemberRole = teamMemberRoleToProto(*g.MemberRole)
+		}
+		resp.Grants = append(resp.Grants, grant)
+	}
+	return resp
+}
+
+func int32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
+
+func intPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
@@ -20,12 +20,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_CreateUser_FullMethodName    = "/data_processor.UserService/CreateUser"
-	UserService_GetUser_FullMethodName       = "/data_processor.UserService/GetUser"
-	UserService_GetUserByName_FullMethodName = "/data_processor.UserService/GetUserByName"
-	UserService_UpdateUser_FullMethodName    = "/data_processor.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName    = "/data_processor.UserService/DeleteUser"
-	UserService_ListUsers_FullMethodName     = "/data_processor.UserService/ListUsers"
+	UserService_CreateUser_FullMethodName        = "/data_processor.UserService/CreateUser"
+	UserService_GetUser_FullMethodName           = "/data_processor.UserService/GetUser"
+	UserService_GetUserByName_FullMethodName     = "/data_processor.UserService/GetUserByName"
+	UserService_UpdateUser_FullMethodName        = "/data_processor.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName        = "/data_processor.UserService/DeleteUser"
+	UserService_ListUsers_FullMethodName         = "/data_processor.UserService/ListUsers"
+	UserService_VerifyCredentials_FullMethodName = "/data_processor.UserService/VerifyCredentials"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -40,6 +41,7 @@ type UserServiceClient interface {
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	VerifyCredentials(ctx context.Context, in *VerifyCredentialsRequest, opts ...grpc.CallOption) (*User, error)
 }
 
 type userServiceClient struct {
@@ -110,6 +112,16 @@ func (c *userServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest,
 	return out, nil
 }
 
+func (c *userServiceClient) VerifyCredentials(ctx context.Context, in *VerifyCredentialsRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_VerifyCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -122,6 +134,7 @@ type UserServiceServer interface {
 	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	VerifyCredentials(context.Context, *VerifyCredentialsRequest) (*User, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -150,6 +163,9 @@ func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserReq
 func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
 }
+func (UnimplementedUserServiceServer) VerifyCredentials(context.Context, *VerifyCredentialsRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredentials not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -279,6 +295,24 @@ func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_VerifyCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VerifyCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyCredentials(ctx, req.(*VerifyCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -310,6 +344,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUsers",
 			Handler:    _UserService_ListUsers_Handler,
 		},
+		{
+			MethodName: "VerifyCredentials",
+			Handler:    _UserService_VerifyCredentials_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "processor.proto",
@@ -658,6 +696,10 @@ const (
 	TeamService_ListTeams_FullMethodName               = "/data_processor.TeamService/ListTeams"
 	TeamService_ListTeamsByOrganization_FullMethodName = "/data_processor.TeamService/ListTeamsByOrganization"
 	TeamService_ListTeamsByOwner_FullMethodName        = "/data_processor.TeamService/ListTeamsByOwner"
+	TeamService_AddMember_FullMethodName               = "/data_processor.TeamService/AddMember"
+	TeamService_RemoveMember_FullMethodName            = "/data_processor.TeamService/RemoveMember"
+	TeamService_ListMembers_FullMethodName             = "/data_processor.TeamService/ListMembers"
+	TeamService_ListTeamsForUser_FullMethodName        = "/data_processor.TeamService/ListTeamsForUser"
 )
 
 // TeamServiceClient is the client API for TeamService service.
@@ -674,6 +716,10 @@ type TeamServiceClient interface {
 	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
 	ListTeamsByOrganization(ctx context.Context, in *ListByParentRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
 	ListTeamsByOwner(ctx context.Context, in *ListByOwnerRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	AddMember(ctx context.Context, in *AddTeamMemberRequest, opts ...grpc.CallOption) (*TeamMember, error)
+	RemoveMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListMembers(ctx context.Context, in *ListTeamMembersRequest, opts ...grpc.CallOption) (*ListTeamMembersResponse, error)
+	ListTeamsForUser(ctx context.Context, in *ListTeamsForUserRequest, opts ...grpc.CallOption) (*ListTeamsForUserResponse, error)
 }
 
 type teamServiceClient struct {
@@ -764,6 +810,46 @@ func (c *teamServiceClient) ListTeamsByOwner(ctx context.Context, in *ListByOwne
 	return out, nil
 }
 
+func (c *teamServiceClient) AddMember(ctx context.Context, in *AddTeamMemberRequest, opts ...grpc.CallOption) (*TeamMember, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamMember)
+	err := c.cc.Invoke(ctx, TeamService_AddMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) RemoveMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TeamService_RemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) ListMembers(ctx context.Context, in *ListTeamMembersRequest, opts ...grpc.CallOption) (*ListTeamMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTeamMembersResponse)
+	err := c.cc.Invoke(ctx, TeamService_ListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) ListTeamsForUser(ctx context.Context, in *ListTeamsForUserRequest, opts ...grpc.CallOption) (*ListTeamsForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTeamsForUserResponse)
+	err := c.cc.Invoke(ctx, TeamService_ListTeamsForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TeamServiceServer is the server API for TeamService service.
 // All implementations must embed UnimplementedTeamServiceServer
 // for forward compatibility.
@@ -778,6 +864,10 @@ type TeamServiceServer interface {
 	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
 	ListTeamsByOrganization(context.Context, *ListByParentRequest) (*ListTeamsResponse, error)
 	ListTeamsByOwner(context.Context, *ListByOwnerRequest) (*ListTeamsResponse, error)
+	AddMember(context.Context, *AddTeamMemberRequest) (*TeamMember, error)
+	RemoveMember(context.Context, *RemoveTeamMemberRequest) (*emptypb.Empty, error)
+	ListMembers(context.Context, *ListTeamMembersRequest) (*ListTeamMembersResponse, error)
+	ListTeamsForUser(context.Context, *ListTeamsForUserRequest) (*ListTeamsForUserResponse, error)
 	mustEmbedUnimplementedTeamServiceServer()
 }
 
@@ -812,6 +902,18 @@ func (UnimplementedTeamServiceServer) ListTeamsByOrganization(context.Context, *
 func (UnimplementedTeamServiceServer) ListTeamsByOwner(context.Context, *ListByOwnerRequest) (*ListTeamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTeamsByOwner not implemented")
 }
+func (UnimplementedTeamServiceServer) AddMember(context.Context, *AddTeamMemberRequest) (*TeamMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMember not implemented")
+}
+func (UnimplementedTeamServiceServer) RemoveMember(context.Context, *RemoveTeamMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedTeamServiceServer) ListMembers(context.Context, *ListTeamMembersRequest) (*ListTeamMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedTeamServiceServer) ListTeamsForUser(context.Context, *ListTeamsForUserRequest) (*ListTeamsForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeamsForUser not implemented")
+}
 func (UnimplementedTeamServiceServer) mustEmbedUnimplementedTeamServiceServer() {}
 func (UnimplementedTeamServiceServer) testEmbeddedByValue()                     {}
 
@@ -977,6 +1079,78 @@ func _TeamService_ListTeamsByOwner_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TeamService_AddMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).AddMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_AddMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).AddMember(ctx, req.(*AddTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).RemoveMember(ctx, req.(*RemoveTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_ListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).ListMembers(ctx, req.(*ListTeamMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_ListTeamsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).ListTeamsForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_ListTeamsForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).ListTeamsForUser(ctx, req.(*ListTeamsForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TeamService_ServiceDesc is the grpc.ServiceDesc for TeamService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1016,6 +1190,22 @@ var TeamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTeamsByOwner",
 			Handler:    _TeamService_ListTeamsByOwner_Handler,
 		},
+		{
+			MethodName: "AddMember",
+			Handler:    _TeamService_AddMember_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _TeamService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _TeamService_ListMembers_Handler,
+		},
+		{
+			MethodName: "ListTeamsForUser",
+			Handler:    _TeamService_ListTeamsForUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "processor.proto",
@@ -1362,6 +1552,8 @@ const (
 	VersionService_UpdateVersion_FullMethodName      = "/data_processor.VersionService/UpdateVersion"
 	VersionService_DeleteVersion_FullMethodName      = "/data_processor.VersionService/DeleteVersion"
 	VersionService_ListVersions_FullMethodName       = "/data_processor.VersionService/ListVersions"
+	VersionService_ListComponents_FullMethodName     = "/data_processor.VersionService/ListComponents"
+	VersionService_ExportSbom_FullMethodName         = "/data_processor.VersionService/ExportSbom"
 )
 
 // VersionServiceClient is the client API for VersionService service.
@@ -1376,6 +1568,15 @@ type VersionServiceClient interface {
 	UpdateVersion(ctx context.Context, in *UpdateVersionRequest, opts ...grpc.CallOption) (*Version, error)
 	DeleteVersion(ctx context.Context, in *DeleteVersionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsResponse, error)
+	// ListComponents lists the components of the latest succeeded SCA scan of a version. A version
+	// without such a scan fails with NotFound.
+	ListComponents(ctx context.Context, in *ListComponentsRequest, opts ...grpc.CallOption) (*ListComponentsResponse, error)
+	// ExportSbom streams the components of the latest succeeded SCA scan of a version as a
+	// CycloneDX 1.6 or SPDX 2.3 JSON document in chunks to be concatenated. The document lists
+	// licenses, hashes and the dependency graph, and names this service as the tool that created
+	// it. Components and everything in them come sorted, so the documents of consecutive versions
+	// differ only where their components do.
+	ExportSbom(ctx context.Context, in *ExportSbomRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportSbomResponse], error)
 }
 
 type versionServiceClient struct {
@@ -1446,6 +1647,35 @@ func (c *versionServiceClient) ListVersions(ctx context.Context, in *ListVersion
 	return out, nil
 }
 
+func (c *versionServiceClient) ListComponents(ctx context.Context, in *ListComponentsRequest, opts ...grpc.CallOption) (*ListComponentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListComponentsResponse)
+	err := c.cc.Invoke(ctx, VersionService_ListComponents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionServiceClient) ExportSbom(ctx context.Context, in *ExportSbomRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportSbomResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VersionService_ServiceDesc.Streams[0], VersionService_ExportSbom_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportSbomRequest, ExportSbomResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VersionService_ExportSbomClient = grpc.ServerStreamingClient[ExportSbomResponse]
+
 // VersionServiceServer is the server API for VersionService service.
 // All implementations must embed UnimplementedVersionServiceServer
 // for forward compatibility.
@@ -1458,6 +1688,15 @@ type VersionServiceServer interface {
 	UpdateVersion(context.Context, *UpdateVersionRequest) (*Version, error)
 	DeleteVersion(context.Context, *DeleteVersionRequest) (*emptypb.Empty, error)
 	ListVersions(context.Context, *ListVersionsRequest) (*ListVersionsResponse, error)
+	// ListComponents lists the components of the latest succeeded SCA scan of a version. A version
+	// without such a scan fails with NotFound.
+	ListComponents(context.Context, *ListComponentsRequest) (*ListComponentsResponse, error)
+	// ExportSbom streams the components of the latest succeeded SCA scan of a version as a
+	// CycloneDX 1.6 or SPDX 2.3 JSON document in chunks to be concatenated. The document lists
+	// licenses, hashes and the dependency graph, and names this service as the tool that created
+	// it. Components and everything in them come sorted, so the documents of consecutive versions
+	// differ only where their components do.
+	ExportSbom(*ExportSbomRequest, grpc.ServerStreamingServer[ExportSbomResponse]) error
 	mustEmbedUnimplementedVersionServiceServer()
 }
 
@@ -1486,6 +1725,12 @@ func (UnimplementedVersionServiceServer) DeleteVersion(context.Context, *DeleteV
 func (UnimplementedVersionServiceServer) ListVersions(context.Context, *ListVersionsRequest) (*ListVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVersions not implemented")
 }
+func (UnimplementedVersionServiceServer) ListComponents(context.Context, *ListComponentsRequest) (*ListComponentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComponents not implemented")
+}
+func (UnimplementedVersionServiceServer) ExportSbom(*ExportSbomRequest, grpc.ServerStreamingServer[ExportSbomResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSbom not implemented")
+}
 func (UnimplementedVersionServiceServer) mustEmbedUnimplementedVersionServiceServer() {}
 func (UnimplementedVersionServiceServer) testEmbeddedByValue()                        {}
 
@@ -1615,6 +1860,35 @@ func _VersionService_ListVersions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VersionService_ListComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListComponentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionServiceServer).ListComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VersionService_ListComponents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionServiceServer).ListComponents(ctx, req.(*ListComponentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VersionService_ExportSbom_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSbomRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VersionServiceServer).ExportSbom(m, &grpc.GenericServerStream[ExportSbomRequest, ExportSbomResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VersionService_ExportSbomServer = grpc.ServerStreamingServer[ExportSbomResponse]
+
 // VersionService_ServiceDesc is the grpc.ServiceDesc for VersionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1646,17 +1920,35 @@ var VersionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListVersions",
 			Handler:    _VersionService_ListVersions_Handler,
 		},
+		{
+			MethodName: "ListComponents",
+			Handler:    _VersionService_ListComponents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportSbom",
+			Handler:       _VersionService_ExportSbom_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "processor.proto",
 }
 
 const (
-	ScanService_CreateScan_FullMethodName = "/data_processor.ScanService/CreateScan"
-	ScanService_GetScan_FullMethodName    = "/data_processor.ScanService/GetScan"
-	ScanService_UpdateScan_FullMethodName = "/data_processor.ScanService/UpdateScan"
-	ScanService_DeleteScan_FullMethodName = "/data_processor.ScanService/DeleteScan"
-	ScanService_ListScans_FullMethodName  = "/data_processor.ScanService/ListScans"
+	ScanService_CreateScan_FullMethodName     = "/data_processor.ScanService/CreateScan"
+	ScanService_GetScan_FullMethodName        = "/data_processor.ScanService/GetScan"
+	ScanService_UpdateScan_FullMethodName     = "/data_processor.ScanService/UpdateScan"
+	ScanService_DeleteScan_FullMethodName     = "/data_processor.ScanService/DeleteScan"
+	ScanService_ListScans_FullMethodName      = "/data_processor.ScanService/ListScans"
+	ScanService_StartScan_FullMethodName      = "/data_processor.ScanService/StartScan"
+	ScanService_CompleteScan_FullMethodName   = "/data_processor.ScanService/CompleteScan"
+	ScanService_FailScan_FullMethodName       = "/data_processor.ScanService/FailScan"
+	ScanService_CancelScan_FullMethodName     = "/data_processor.ScanService/CancelScan"
+	ScanService_LeaseScan_FullMethodName      = "/data_processor.ScanService/LeaseScan"
+	ScanService_HeartbeatScan_FullMethodName  = "/data_processor.ScanService/HeartbeatScan"
+	ScanService_ReportProgress_FullMethodName = "/data_processor.ScanService/ReportProgress"
+	ScanService_WatchScan_FullMethodName      = "/data_processor.ScanService/WatchScan"
 )
 
 // ScanServiceClient is the client API for ScanService service.
@@ -1670,6 +1962,26 @@ type ScanServiceClient interface {
 	UpdateScan(ctx context.Context, in *UpdateScanRequest, opts ...grpc.CallOption) (*Scan, error)
 	DeleteScan(ctx context.Context, in *DeleteScanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListScans(ctx context.Context, in *ListScansRequest, opts ...grpc.CallOption) (*ListScansResponse, error)
+	// A scan is created queued. StartScan moves a queued scan to running, CompleteScan and FailScan
+	// finish a running scan, and CancelScan stops a queued or running one. Any other transition
+	// fails with FailedPrecondition.
+	StartScan(ctx context.Context, in *StartScanRequest, opts ...grpc.CallOption) (*Scan, error)
+	CompleteScan(ctx context.Context, in *CompleteScanRequest, opts ...grpc.CallOption) (*Scan, error)
+	FailScan(ctx context.Context, in *FailScanRequest, opts ...grpc.CallOption) (*Scan, error)
+	CancelScan(ctx context.Context, in *CancelScanRequest, opts ...grpc.CallOption) (*Scan, error)
+	// Workers take queued scans with LeaseScan and keep them with HeartbeatScan. A lease that is
+	// not renewed expires: the scan is queued again after a backoff that doubles with every
+	// attempt, or fails once it has used up its attempts. A leased scan is finished with
+	// CompleteScan or FailScan by the worker holding the lease. These methods require a user with
+	// is_worker set or an administrator.
+	LeaseScan(ctx context.Context, in *LeaseScanRequest, opts ...grpc.CallOption) (*LeaseScanResponse, error)
+	HeartbeatScan(ctx context.Context, in *HeartbeatScanRequest, opts ...grpc.CallOption) (*Scan, error)
+	// ReportProgress records the progress of a leased scan and renews the lease like HeartbeatScan.
+	ReportProgress(ctx context.Context, in *ReportProgressRequest, opts ...grpc.CallOption) (*Scan, error)
+	// WatchScan sends the current state of the scan and then every change of its status or
+	// progress, made through any replica. The stream ends after the scan has finished. Changes
+	// that follow each other quickly may be merged into a single message.
+	WatchScan(ctx context.Context, in *WatchScanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Scan], error)
 }
 
 type scanServiceClient struct {
@@ -1730,6 +2042,95 @@ func (c *scanServiceClient) ListScans(ctx context.Context, in *ListScansRequest,
 	return out, nil
 }
 
+func (c *scanServiceClient) StartScan(ctx context.Context, in *StartScanRequest, opts ...grpc.CallOption) (*Scan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scan)
+	err := c.cc.Invoke(ctx, ScanService_StartScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) CompleteScan(ctx context.Context, in *CompleteScanRequest, opts ...grpc.CallOption) (*Scan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scan)
+	err := c.cc.Invoke(ctx, ScanService_CompleteScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) FailScan(ctx context.Context, in *FailScanRequest, opts ...grpc.CallOption) (*Scan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scan)
+	err := c.cc.Invoke(ctx, ScanService_FailScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) CancelScan(ctx context.Context, in *CancelScanRequest, opts ...grpc.CallOption) (*Scan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scan)
+	err := c.cc.Invoke(ctx, ScanService_CancelScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) LeaseScan(ctx context.Context, in *LeaseScanRequest, opts ...grpc.CallOption) (*LeaseScanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaseScanResponse)
+	err := c.cc.Invoke(ctx, ScanService_LeaseScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) HeartbeatScan(ctx context.Context, in *HeartbeatScanRequest, opts ...grpc.CallOption) (*Scan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scan)
+	err := c.cc.Invoke(ctx, ScanService_HeartbeatScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) ReportProgress(ctx context.Context, in *ReportProgressRequest, opts ...grpc.CallOption) (*Scan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scan)
+	err := c.cc.Invoke(ctx, ScanService_ReportProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) WatchScan(ctx context.Context, in *WatchScanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Scan], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScanService_ServiceDesc.Streams[0], ScanService_WatchScan_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchScanRequest, Scan]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScanService_WatchScanClient = grpc.ServerStreamingClient[Scan]
+
 // ScanServiceServer is the server API for ScanService service.
 // All implementations must embed UnimplementedScanServiceServer
 // for forward compatibility.
@@ -1741,6 +2142,26 @@ type ScanServiceServer interface {
 	UpdateScan(context.Context, *UpdateScanRequest) (*Scan, error)
 	DeleteScan(context.Context, *DeleteScanRequest) (*emptypb.Empty, error)
 	ListScans(context.Context, *ListScansRequest) (*ListScansResponse, error)
+	// A scan is created queued. StartScan moves a queued scan to running, CompleteScan and FailScan
+	// finish a running scan, and CancelScan stops a queued or running one. Any other transition
+	// fails with FailedPrecondition.
+	StartScan(context.Context, *StartScanRequest) (*Scan, error)
+	CompleteScan(context.Context, *CompleteScanRequest) (*Scan, error)
+	FailScan(context.Context, *FailScanRequest) (*Scan, error)
+	CancelScan(context.Context, *CancelScanRequest) (*Scan, error)
+	// Workers take queued scans with LeaseScan and keep them with HeartbeatScan. A lease that is
+	// not renewed expires: the scan is queued again after a backoff that doubles with every
+	// attempt, or fails once it has used up its attempts. A leased scan is finished with
+	// CompleteScan or FailScan by the worker holding the lease. These methods require a user with
+	// is_worker set or an administrator.
+	LeaseScan(context.Context, *LeaseScanRequest) (*LeaseScanResponse, error)
+	HeartbeatScan(context.Context, *HeartbeatScanRequest) (*Scan, error)
+	// ReportProgress records the progress of a leased scan and renews the lease like HeartbeatScan.
+	ReportProgress(context.Context, *ReportProgressRequest) (*Scan, error)
+	// WatchScan sends the current state of the scan and then every change of its status or
+	// progress, made through any replica. The stream ends after the scan has finished. Changes
+	// that follow each other quickly may be merged into a single message.
+	WatchScan(*WatchScanRequest, grpc.ServerStreamingServer[Scan]) error
 	mustEmbedUnimplementedScanServiceServer()
 }
 
@@ -1766,6 +2187,30 @@ func (UnimplementedScanServiceServer) DeleteScan(context.Context, *DeleteScanReq
 func (UnimplementedScanServiceServer) ListScans(context.Context, *ListScansRequest) (*ListScansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListScans not implemented")
 }
+func (UnimplementedScanServiceServer) StartScan(context.Context, *StartScanRequest) (*Scan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartScan not implemented")
+}
+func (UnimplementedScanServiceServer) CompleteScan(context.Context, *CompleteScanRequest) (*Scan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteScan not implemented")
+}
+func (UnimplementedScanServiceServer) FailScan(context.Context, *FailScanRequest) (*Scan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailScan not implemented")
+}
+func (UnimplementedScanServiceServer) CancelScan(context.Context, *CancelScanRequest) (*Scan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScan not implemented")
+}
+func (UnimplementedScanServiceServer) LeaseScan(context.Context, *LeaseScanRequest) (*LeaseScanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseScan not implemented")
+}
+func (UnimplementedScanServiceServer) HeartbeatScan(context.Context, *HeartbeatScanRequest) (*Scan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeartbeatScan not implemented")
+}
+func (UnimplementedScanServiceServer) ReportProgress(context.Context, *ReportProgressRequest) (*Scan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportProgress not implemented")
+}
+func (UnimplementedScanServiceServer) WatchScan(*WatchScanRequest, grpc.ServerStreamingServer[Scan]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchScan not implemented")
+}
 func (UnimplementedScanServiceServer) mustEmbedUnimplementedScanServiceServer() {}
 func (UnimplementedScanServiceServer) testEmbeddedByValue()                     {}
 
@@ -1877,35 +2322,206 @@ func _ScanService_ListScans_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-// ScanService_ServiceDesc is the grpc.ServiceDesc for ScanService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ScanService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "data_processor.ScanService",
-	HandlerType: (*ScanServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateScan",
-			Handler:    _ScanService_CreateScan_Handler,
-		},
-		{
-			MethodName: "GetScan",
-			Handler:    _ScanService_GetScan_Handler,
-		},
-		{
-			MethodName: "UpdateScan",
-			Handler:    _ScanService_UpdateScan_Handler,
-		},
-		{
-			MethodName: "DeleteScan",
-			Handler:    _ScanService_DeleteScan_Handler,
+func _ScanService_StartScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).StartScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_StartScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).StartScan(ctx, req.(*StartScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_CompleteScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).CompleteScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_CompleteScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).CompleteScan(ctx, req.(*CompleteScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_FailScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).FailScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_FailScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).FailScan(ctx, req.(*FailScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_CancelScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).CancelScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_CancelScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).CancelScan(ctx, req.(*CancelScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_LeaseScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).LeaseScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_LeaseScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).LeaseScan(ctx, req.(*LeaseScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_HeartbeatScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).HeartbeatScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_HeartbeatScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).HeartbeatScan(ctx, req.(*HeartbeatScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_ReportProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).ReportProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_ReportProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).ReportProgress(ctx, req.(*ReportProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_WatchScan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchScanRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ScanServiceServer).WatchScan(m, &grpc.GenericServerStream[WatchScanRequest, Scan]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScanService_WatchScanServer = grpc.ServerStreamingServer[Scan]
+
+// ScanService_ServiceDesc is the grpc.ServiceDesc for ScanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "data_processor.ScanService",
+	HandlerType: (*ScanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateScan",
+			Handler:    _ScanService_CreateScan_Handler,
+		},
+		{
+			MethodName: "GetScan",
+			Handler:    _ScanService_GetScan_Handler,
+		},
+		{
+			MethodName: "UpdateScan",
+			Handler:    _ScanService_UpdateScan_Handler,
+		},
+		{
+			MethodName: "DeleteScan",
+			Handler:    _ScanService_DeleteScan_Handler,
 		},
 		{
 			MethodName: "ListScans",
 			Handler:    _ScanService_ListScans_Handler,
 		},
+		{
+			MethodName: "StartScan",
+			Handler:    _ScanService_StartScan_Handler,
+		},
+		{
+			MethodName: "CompleteScan",
+			Handler:    _ScanService_CompleteScan_Handler,
+		},
+		{
+			MethodName: "FailScan",
+			Handler:    _ScanService_FailScan_Handler,
+		},
+		{
+			MethodName: "CancelScan",
+			Handler:    _ScanService_CancelScan_Handler,
+		},
+		{
+			MethodName: "LeaseScan",
+			Handler:    _ScanService_LeaseScan_Handler,
+		},
+		{
+			MethodName: "HeartbeatScan",
+			Handler:    _ScanService_HeartbeatScan_Handler,
+		},
+		{
+			MethodName: "ReportProgress",
+			Handler:    _ScanService_ReportProgress_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchScan",
+			Handler:       _ScanService_WatchScan_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "processor.proto",
 }
 
@@ -1915,6 +2531,12 @@ const (
 	ScanInfoService_GetScanInfoByScan_FullMethodName = "/data_processor.ScanInfoService/GetScanInfoByScan"
 	ScanInfoService_UpdateScanInfo_FullMethodName    = "/data_processor.ScanInfoService/UpdateScanInfo"
 	ScanInfoService_DeleteScanInfo_FullMethodName    = "/data_processor.ScanInfoService/DeleteScanInfo"
+	ScanInfoService_UploadFindings_FullMethodName    = "/data_processor.ScanInfoService/UploadFindings"
+	ScanInfoService_UploadSarif_FullMethodName       = "/data_processor.ScanInfoService/UploadSarif"
+	ScanInfoService_ListFindings_FullMethodName      = "/data_processor.ScanInfoService/ListFindings"
+	ScanInfoService_ListFindingRules_FullMethodName  = "/data_processor.ScanInfoService/ListFindingRules"
+	ScanInfoService_ExportSarif_FullMethodName       = "/data_processor.ScanInfoService/ExportSarif"
+	ScanInfoService_UploadSbom_FullMethodName        = "/data_processor.ScanInfoService/UploadSbom"
 )
 
 // ScanInfoServiceClient is the client API for ScanInfoService service.
@@ -1928,6 +2550,29 @@ type ScanInfoServiceClient interface {
 	GetScanInfoByScan(ctx context.Context, in *GetScanInfoByScanRequest, opts ...grpc.CallOption) (*ScanInfo, error)
 	UpdateScanInfo(ctx context.Context, in *UpdateScanInfoRequest, opts ...grpc.CallOption) (*ScanInfo, error)
 	DeleteScanInfo(ctx context.Context, in *DeleteScanInfoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UploadFindings replaces the findings of a scan with the ones sent in the stream. Nothing is
+	// replaced unless the whole stream is valid and has been received. An SCA scan fails with
+	// FailedPrecondition.
+	UploadFindings(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadFindingsRequest, UploadFindingsResponse], error)
+	// UploadSarif replaces the findings of a scan with the results of a SARIF 2.1.0 log sent in
+	// chunks, and its finding rules with the rules those results refer to. An SCA scan fails with
+	// FailedPrecondition, an invalid log with InvalidArgument naming the offending element, e.g.
+	// runs[0].results[3].message.
+	UploadSarif(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadSarifRequest, UploadFindingsResponse], error)
+	ListFindings(ctx context.Context, in *ListFindingsRequest, opts ...grpc.CallOption) (*ListFindingsResponse, error)
+	ListFindingRules(ctx context.Context, in *ListFindingRulesRequest, opts ...grpc.CallOption) (*ListFindingRulesResponse, error)
+	// ExportSarif streams the findings of a scan as a SARIF 2.1.0 log with a run per tool, in
+	// chunks to be concatenated. Each result carries its suppression and its baseline state
+	// against the latest succeeded scan of the same type created before this one in any version
+	// of the application, by creation order rather than scan_date: new, unchanged, or absent for
+	// findings that scan reported and this one does not. Without such a scan results have no
+	// baseline state.
+	ExportSarif(ctx context.Context, in *ExportSarifRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportSarifResponse], error)
+	// UploadSbom replaces the components of an SCA scan with the packages of a CycloneDX 1.5 or 1.6
+	// (JSON or XML) or SPDX 2.3 (JSON or tag-value) document sent in chunks. A scan of another
+	// type fails with FailedPrecondition, an invalid document with InvalidArgument naming the
+	// offending element, e.g. components[2].purl.
+	UploadSbom(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadSbomRequest, UploadSbomResponse], error)
 }
 
 type scanInfoServiceClient struct {
@@ -1988,6 +2633,84 @@ func (c *scanInfoServiceClient) DeleteScanInfo(ctx context.Context, in *DeleteSc
 	return out, nil
 }
 
+func (c *scanInfoServiceClient) UploadFindings(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadFindingsRequest, UploadFindingsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScanInfoService_ServiceDesc.Streams[0], ScanInfoService_UploadFindings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadFindingsRequest, UploadFindingsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScanInfoService_UploadFindingsClient = grpc.ClientStreamingClient[UploadFindingsRequest, UploadFindingsResponse]
+
+func (c *scanInfoServiceClient) UploadSarif(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadSarifRequest, UploadFindingsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScanInfoService_ServiceDesc.Streams[1], ScanInfoService_UploadSarif_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadSarifRequest, UploadFindingsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScanInfoService_UploadSarifClient = grpc.ClientStreamingClient[UploadSarifRequest, UploadFindingsResponse]
+
+func (c *scanInfoServiceClient) ListFindings(ctx context.Context, in *ListFindingsRequest, opts ...grpc.CallOption) (*ListFindingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFindingsResponse)
+	err := c.cc.Invoke(ctx, ScanInfoService_ListFindings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanInfoServiceClient) ListFindingRules(ctx context.Context, in *ListFindingRulesRequest, opts ...grpc.CallOption) (*ListFindingRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFindingRulesResponse)
+	err := c.cc.Invoke(ctx, ScanInfoService_ListFindingRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanInfoServiceClient) ExportSarif(ctx context.Context, in *ExportSarifRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportSarifResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScanInfoService_ServiceDesc.Streams[2], ScanInfoService_ExportSarif_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportSarifRequest, ExportSarifResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScanInfoService_ExportSarifClient = grpc.ServerStreamingClient[ExportSarifResponse]
+
+func (c *scanInfoServiceClient) UploadSbom(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadSbomRequest, UploadSbomResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScanInfoService_ServiceDesc.Streams[3], ScanInfoService_UploadSbom_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadSbomRequest, UploadSbomResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScanInfoService_UploadSbomClient = grpc.ClientStreamingClient[UploadSbomRequest, UploadSbomResponse]
+
 // ScanInfoServiceServer is the server API for ScanInfoService service.
 // All implementations must embed UnimplementedScanInfoServiceServer
 // for forward compatibility.
@@ -1999,6 +2722,29 @@ type ScanInfoServiceServer interface {
 	GetScanInfoByScan(context.Context, *GetScanInfoByScanRequest) (*ScanInfo, error)
 	UpdateScanInfo(context.Context, *UpdateScanInfoRequest) (*ScanInfo, error)
 	DeleteScanInfo(context.Context, *DeleteScanInfoRequest) (*emptypb.Empty, error)
+	// UploadFindings replaces the findings of a scan with the ones sent in the stream. Nothing is
+	// replaced unless the whole stream is valid and has been received. An SCA scan fails with
+	// FailedPrecondition.
+	UploadFindings(grpc.ClientStreamingServer[UploadFindingsRequest, UploadFindingsResponse]) error
+	// UploadSarif replaces the findings of a scan with the results of a SARIF 2.1.0 log sent in
+	// chunks, and its finding rules with the rules those results refer to. An SCA scan fails with
+	// FailedPrecondition, an invalid log with InvalidArgument naming the offending element, e.g.
+	// runs[0].results[3].message.
+	UploadSarif(grpc.ClientStreamingServer[UploadSarifRequest, UploadFindingsResponse]) error
+	ListFindings(context.Context, *ListFindingsRequest) (*ListFindingsResponse, error)
+	ListFindingRules(context.Context, *ListFindingRulesRequest) (*ListFindingRulesResponse, error)
+	// ExportSarif streams the findings of a scan as a SARIF 2.1.0 log with a run per tool, in
+	// chunks to be concatenated. Each result carries its suppression and its baseline state
+	// against the latest succeeded scan of the same type created before this one in any version
+	// of the application, by creation order rather than scan_date: new, unchanged, or absent for
+	// findings that scan reported and this one does not. Without such a scan results have no
+	// baseline state.
+	ExportSarif(*ExportSarifRequest, grpc.ServerStreamingServer[ExportSarifResponse]) error
+	// UploadSbom replaces the components of an SCA scan with the packages of a CycloneDX 1.5 or 1.6
+	// (JSON or XML) or SPDX 2.3 (JSON or tag-value) document sent in chunks. A scan of another
+	// type fails with FailedPrecondition, an invalid document with InvalidArgument naming the
+	// offending element, e.g. components[2].purl.
+	UploadSbom(grpc.ClientStreamingServer[UploadSbomRequest, UploadSbomResponse]) error
 	mustEmbedUnimplementedScanInfoServiceServer()
 }
 
@@ -2024,6 +2770,24 @@ func (UnimplementedScanInfoServiceServer) UpdateScanInfo(context.Context, *Updat
 func (UnimplementedScanInfoServiceServer) DeleteScanInfo(context.Context, *DeleteScanInfoRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteScanInfo not implemented")
 }
+func (UnimplementedScanInfoServiceServer) UploadFindings(grpc.ClientStreamingServer[UploadFindingsRequest, UploadFindingsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFindings not implemented")
+}
+func (UnimplementedScanInfoServiceServer) UploadSarif(grpc.ClientStreamingServer[UploadSarifRequest, UploadFindingsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadSarif not implemented")
+}
+func (UnimplementedScanInfoServiceServer) ListFindings(context.Context, *ListFindingsRequest) (*ListFindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFindings not implemented")
+}
+func (UnimplementedScanInfoServiceServer) ListFindingRules(context.Context, *ListFindingRulesRequest) (*ListFindingRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFindingRules not implemented")
+}
+func (UnimplementedScanInfoServiceServer) ExportSarif(*ExportSarifRequest, grpc.ServerStreamingServer[ExportSarifResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSarif not implemented")
+}
+func (UnimplementedScanInfoServiceServer) UploadSbom(grpc.ClientStreamingServer[UploadSbomRequest, UploadSbomResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadSbom not implemented")
+}
 func (UnimplementedScanInfoServiceServer) mustEmbedUnimplementedScanInfoServiceServer() {}
 func (UnimplementedScanInfoServiceServer) testEmbeddedByValue()                         {}
 
@@ -2135,6 +2899,74 @@ func _ScanInfoService_DeleteScanInfo_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScanInfoService_UploadFindings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ScanInfoServiceServer).UploadFindings(&grpc.GenericServerStream[UploadFindingsRequest, UploadFindingsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScanInfoService_UploadFindingsServer = grpc.ClientStreamingServer[UploadFindingsRequest, UploadFindingsResponse]
+
+func _ScanInfoService_UploadSarif_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ScanInfoServiceServer).UploadSarif(&grpc.GenericServerStream[UploadSarifRequest, UploadFindingsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScanInfoService_UploadSarifServer = grpc.ClientStreamingServer[UploadSarifRequest, UploadFindingsResponse]
+
+func _ScanInfoService_ListFindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanInfoServiceServer).ListFindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanInfoService_ListFindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanInfoServiceServer).ListFindings(ctx, req.(*ListFindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanInfoService_ListFindingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFindingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanInfoServiceServer).ListFindingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanInfoService_ListFindingRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanInfoServiceServer).ListFindingRules(ctx, req.(*ListFindingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanInfoService_ExportSarif_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSarifRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ScanInfoServiceServer).ExportSarif(m, &grpc.GenericServerStream[ExportSarifRequest, ExportSarifResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScanInfoService_ExportSarifServer = grpc.ServerStreamingServer[ExportSarifResponse]
+
+func _ScanInfoService_UploadSbom_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ScanInfoServiceServer).UploadSbom(&grpc.GenericServerStream[UploadSbomRequest, UploadSbomResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScanInfoService_UploadSbomServer = grpc.ClientStreamingServer[UploadSbomRequest, UploadSbomResponse]
+
 // ScanInfoService_ServiceDesc is the grpc.ServiceDesc for ScanInfoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2162,18 +2994,54 @@ var ScanInfoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteScanInfo",
 			Handler:    _ScanInfoService_DeleteScanInfo_Handler,
 		},
+		{
+			MethodName: "ListFindings",
+			Handler:    _ScanInfoService_ListFindings_Handler,
+		},
+		{
+			MethodName: "ListFindingRules",
+			Handler:    _ScanInfoService_ListFindingRules_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadFindings",
+			Handler:       _ScanInfoService_UploadFindings_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadSarif",
+			Handler:       _ScanInfoService_UploadSarif_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportSarif",
+			Handler:       _ScanInfoService_ExportSarif_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadSbom",
+			Handler:       _ScanInfoService_UploadSbom_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "processor.proto",
 }
 
 const (
-	ScanRuleService_CreateScanRule_FullMethodName         = "/data_processor.ScanRuleService/CreateScanRule"
-	ScanRuleService_GetScanRule_FullMethodName            = "/data_processor.ScanRuleService/GetScanRule"
-	ScanRuleService_UpdateScanRule_FullMethodName         = "/data_processor.ScanRuleService/UpdateScanRule"
-	ScanRuleService_DeleteScanRule_FullMethodName         = "/data_processor.ScanRuleService/DeleteScanRule"
-	ScanRuleService_ListScanRules_FullMethodName          = "/data_processor.ScanRuleService/ListScanRules"
-	ScanRuleService_GetScanRuleByComposite_FullMethodName = "/data_processor.ScanRuleService/GetScanRuleByComposite"
+	ScanRuleService_CreateScanRule_FullMethodName          = "/data_processor.ScanRuleService/CreateScanRule"
+	ScanRuleService_GetScanRule_FullMethodName             = "/data_processor.ScanRuleService/GetScanRule"
+	ScanRuleService_UpdateScanRule_FullMethodName          = "/data_processor.ScanRuleService/UpdateScanRule"
+	ScanRuleService_DeleteScanRule_FullMethodName          = "/data_processor.ScanRuleService/DeleteScanRule"
+	ScanRuleService_ListScanRules_FullMethodName           = "/data_processor.ScanRuleService/ListScanRules"
+	ScanRuleService_GetScanRuleByComposite_FullMethodName  = "/data_processor.ScanRuleService/GetScanRuleByComposite"
+	ScanRuleService_GetEffectiveScanRule_FullMethodName    = "/data_processor.ScanRuleService/GetEffectiveScanRule"
+	ScanRuleService_TestExcludes_FullMethodName            = "/data_processor.ScanRuleService/TestExcludes"
+	ScanRuleService_ListScanRuleRevisions_FullMethodName   = "/data_processor.ScanRuleService/ListScanRuleRevisions"
+	ScanRuleService_DiffScanRuleRevisions_FullMethodName   = "/data_processor.ScanRuleService/DiffScanRuleRevisions"
+	ScanRuleService_RestoreScanRuleRevision_FullMethodName = "/data_processor.ScanRuleService/RestoreScanRuleRevision"
+	ScanRuleService_ExportScanRules_FullMethodName         = "/data_processor.ScanRuleService/ExportScanRules"
+	ScanRuleService_ApplyScanRules_FullMethodName          = "/data_processor.ScanRuleService/ApplyScanRules"
 )
 
 // ScanRuleServiceClient is the client API for ScanRuleService service.
@@ -2188,6 +3056,25 @@ type ScanRuleServiceClient interface {
 	DeleteScanRule(ctx context.Context, in *DeleteScanRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListScanRules(ctx context.Context, in *ListScanRulesRequest, opts ...grpc.CallOption) (*ListScanRulesResponse, error)
 	GetScanRuleByComposite(ctx context.Context, in *GetScanRuleByCompositeRequest, opts ...grpc.CallOption) (*ScanRule, error)
+	// GetEffectiveScanRule merges the organization, team and application rules of an application.
+	GetEffectiveScanRule(ctx context.Context, in *GetEffectiveScanRuleRequest, opts ...grpc.CallOption) (*EffectiveScanRule, error)
+	// TestExcludes reports which of the given paths a rule's exclude_dir_regexp_queue excludes.
+	TestExcludes(ctx context.Context, in *TestExcludesRequest, opts ...grpc.CallOption) (*TestExcludesResponse, error)
+	// Every change of a rule is recorded in its history, including deletion. The history of a
+	// deleted rule stays available to administrators.
+	ListScanRuleRevisions(ctx context.Context, in *ListScanRuleRevisionsRequest, opts ...grpc.CallOption) (*ListScanRuleRevisionsResponse, error)
+	DiffScanRuleRevisions(ctx context.Context, in *DiffScanRuleRevisionsRequest, opts ...grpc.CallOption) (*DiffScanRuleRevisionsResponse, error)
+	// RestoreScanRuleRevision returns the rule to its state after the given revision; a deleted
+	// rule is recreated with its former id. The restore is recorded as a new revision. A revision
+	// with exclude patterns that no longer compile fails with InvalidArgument.
+	RestoreScanRuleRevision(ctx context.Context, in *RestoreScanRuleRevisionRequest, opts ...grpc.CallOption) (*ScanRule, error)
+	// ExportScanRules describes the scan rules as a document keyed by organization, team and
+	// application names, suitable for version control.
+	ExportScanRules(ctx context.Context, in *ExportScanRulesRequest, opts ...grpc.CallOption) (*ExportScanRulesResponse, error)
+	// ApplyScanRules makes the scan rules of the organizations listed in a document match it:
+	// missing rules are created, differing ones updated and rules the document does not mention
+	// deleted, all in one transaction. With dry_run only the plan is returned.
+	ApplyScanRules(ctx context.Context, in *ApplyScanRulesRequest, opts ...grpc.CallOption) (*ApplyScanRulesResponse, error)
 }
 
 type scanRuleServiceClient struct {
@@ -2258,6 +3145,76 @@ func (c *scanRuleServiceClient) GetScanRuleByComposite(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *scanRuleServiceClient) GetEffectiveScanRule(ctx context.Context, in *GetEffectiveScanRuleRequest, opts ...grpc.CallOption) (*EffectiveScanRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EffectiveScanRule)
+	err := c.cc.Invoke(ctx, ScanRuleService_GetEffectiveScanRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanRuleServiceClient) TestExcludes(ctx context.Context, in *TestExcludesRequest, opts ...grpc.CallOption) (*TestExcludesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestExcludesResponse)
+	err := c.cc.Invoke(ctx, ScanRuleService_TestExcludes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanRuleServiceClient) ListScanRuleRevisions(ctx context.Context, in *ListScanRuleRevisionsRequest, opts ...grpc.CallOption) (*ListScanRuleRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScanRuleRevisionsResponse)
+	err := c.cc.Invoke(ctx, ScanRuleService_ListScanRuleRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanRuleServiceClient) DiffScanRuleRevisions(ctx context.Context, in *DiffScanRuleRevisionsRequest, opts ...grpc.CallOption) (*DiffScanRuleRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffScanRuleRevisionsResponse)
+	err := c.cc.Invoke(ctx, ScanRuleService_DiffScanRuleRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanRuleServiceClient) RestoreScanRuleRevision(ctx context.Context, in *RestoreScanRuleRevisionRequest, opts ...grpc.CallOption) (*ScanRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScanRule)
+	err := c.cc.Invoke(ctx, ScanRuleService_RestoreScanRuleRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanRuleServiceClient) ExportScanRules(ctx context.Context, in *ExportScanRulesRequest, opts ...grpc.CallOption) (*ExportScanRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportScanRulesResponse)
+	err := c.cc.Invoke(ctx, ScanRuleService_ExportScanRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanRuleServiceClient) ApplyScanRules(ctx context.Context, in *ApplyScanRulesRequest, opts ...grpc.CallOption) (*ApplyScanRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyScanRulesResponse)
+	err := c.cc.Invoke(ctx, ScanRuleService_ApplyScanRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScanRuleServiceServer is the server API for ScanRuleService service.
 // All implementations must embed UnimplementedScanRuleServiceServer
 // for forward compatibility.
@@ -2270,6 +3227,25 @@ type ScanRuleServiceServer interface {
 	DeleteScanRule(context.Context, *DeleteScanRuleRequest) (*emptypb.Empty, error)
 	ListScanRules(context.Context, *ListScanRulesRequest) (*ListScanRulesResponse, error)
 	GetScanRuleByComposite(context.Context, *GetScanRuleByCompositeRequest) (*ScanRule, error)
+	// GetEffectiveScanRule merges the organization, team and application rules of an application.
+	GetEffectiveScanRule(context.Context, *GetEffectiveScanRuleRequest) (*EffectiveScanRule, error)
+	// TestExcludes reports which of the given paths a rule's exclude_dir_regexp_queue excludes.
+	TestExcludes(context.Context, *TestExcludesRequest) (*TestExcludesResponse, error)
+	// Every change of a rule is recorded in its history, including deletion. The history of a
+	// deleted rule stays available to administrators.
+	ListScanRuleRevisions(context.Context, *ListScanRuleRevisionsRequest) (*ListScanRuleRevisionsResponse, error)
+	DiffScanRuleRevisions(context.Context, *DiffScanRuleRevisionsRequest) (*DiffScanRuleRevisionsResponse, error)
+	// RestoreScanRuleRevision returns the rule to its state after the given revision; a deleted
+	// rule is recreated with its former id. The restore is recorded as a new revision. A revision
+	// with exclude patterns that no longer compile fails with InvalidArgument.
+	RestoreScanRuleRevision(context.Context, *RestoreScanRuleRevisionRequest) (*ScanRule, error)
+	// ExportScanRules describes the scan rules as a document keyed by organization, team and
+	// application names, suitable for version control.
+	ExportScanRules(context.Context, *ExportScanRulesRequest) (*ExportScanRulesResponse, error)
+	// ApplyScanRules makes the scan rules of the organizations listed in a document match it:
+	// missing rules are created, differing ones updated and rules the document does not mention
+	// deleted, all in one transaction. With dry_run only the plan is returned.
+	ApplyScanRules(context.Context, *ApplyScanRulesRequest) (*ApplyScanRulesResponse, error)
 	mustEmbedUnimplementedScanRuleServiceServer()
 }
 
@@ -2298,6 +3274,27 @@ func (UnimplementedScanRuleServiceServer) ListScanRules(context.Context, *ListSc
 func (UnimplementedScanRuleServiceServer) GetScanRuleByComposite(context.Context, *GetScanRuleByCompositeRequest) (*ScanRule, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetScanRuleByComposite not implemented")
 }
+func (UnimplementedScanRuleServiceServer) GetEffectiveScanRule(context.Context, *GetEffectiveScanRuleRequest) (*EffectiveScanRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveScanRule not implemented")
+}
+func (UnimplementedScanRuleServiceServer) TestExcludes(context.Context, *TestExcludesRequest) (*TestExcludesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestExcludes not implemented")
+}
+func (UnimplementedScanRuleServiceServer) ListScanRuleRevisions(context.Context, *ListScanRuleRevisionsRequest) (*ListScanRuleRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScanRuleRevisions not implemented")
+}
+func (UnimplementedScanRuleServiceServer) DiffScanRuleRevisions(context.Context, *DiffScanRuleRevisionsRequest) (*DiffScanRuleRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffScanRuleRevisions not implemented")
+}
+func (UnimplementedScanRuleServiceServer) RestoreScanRuleRevision(context.Context, *RestoreScanRuleRevisionRequest) (*ScanRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreScanRuleRevision not implemented")
+}
+func (UnimplementedScanRuleServiceServer) ExportScanRules(context.Context, *ExportScanRulesRequest) (*ExportScanRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportScanRules not implemented")
+}
+func (UnimplementedScanRuleServiceServer) ApplyScanRules(context.Context, *ApplyScanRulesRequest) (*ApplyScanRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyScanRules not implemented")
+}
 func (UnimplementedScanRuleServiceServer) mustEmbedUnimplementedScanRuleServiceServer() {}
 func (UnimplementedScanRuleServiceServer) testEmbeddedByValue()                         {}
 
@@ -2316,113 +3313,239 @@ func RegisterScanRuleServiceServer(s grpc.ServiceRegistrar, srv ScanRuleServiceS
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&ScanRuleService_ServiceDesc, srv)
+	s.RegisterService(&ScanRuleService_ServiceDesc, srv)
+}
+
+func _ScanRuleService_CreateScanRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScanRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanRuleServiceServer).CreateScanRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanRuleService_CreateScanRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanRuleServiceServer).CreateScanRule(ctx, req.(*CreateScanRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanRuleService_GetScanRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScanRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanRuleServiceServer).GetScanRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanRuleService_GetScanRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanRuleServiceServer).GetScanRule(ctx, req.(*GetScanRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanRuleService_UpdateScanRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScanRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanRuleServiceServer).UpdateScanRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanRuleService_UpdateScanRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanRuleServiceServer).UpdateScanRule(ctx, req.(*UpdateScanRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanRuleService_DeleteScanRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScanRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanRuleServiceServer).DeleteScanRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanRuleService_DeleteScanRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanRuleServiceServer).DeleteScanRule(ctx, req.(*DeleteScanRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanRuleService_ListScanRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScanRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanRuleServiceServer).ListScanRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanRuleService_ListScanRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanRuleServiceServer).ListScanRules(ctx, req.(*ListScanRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanRuleService_GetScanRuleByComposite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScanRuleByCompositeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanRuleServiceServer).GetScanRuleByComposite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanRuleService_GetScanRuleByComposite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanRuleServiceServer).GetScanRuleByComposite(ctx, req.(*GetScanRuleByCompositeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanRuleService_GetEffectiveScanRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectiveScanRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanRuleServiceServer).GetEffectiveScanRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanRuleService_GetEffectiveScanRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanRuleServiceServer).GetEffectiveScanRule(ctx, req.(*GetEffectiveScanRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ScanRuleService_CreateScanRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateScanRuleRequest)
+func _ScanRuleService_TestExcludes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestExcludesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ScanRuleServiceServer).CreateScanRule(ctx, in)
+		return srv.(ScanRuleServiceServer).TestExcludes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ScanRuleService_CreateScanRule_FullMethodName,
+		FullMethod: ScanRuleService_TestExcludes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ScanRuleServiceServer).CreateScanRule(ctx, req.(*CreateScanRuleRequest))
+		return srv.(ScanRuleServiceServer).TestExcludes(ctx, req.(*TestExcludesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ScanRuleService_GetScanRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetScanRuleRequest)
+func _ScanRuleService_ListScanRuleRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScanRuleRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ScanRuleServiceServer).GetScanRule(ctx, in)
+		return srv.(ScanRuleServiceServer).ListScanRuleRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ScanRuleService_GetScanRule_FullMethodName,
+		FullMethod: ScanRuleService_ListScanRuleRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ScanRuleServiceServer).GetScanRule(ctx, req.(*GetScanRuleRequest))
+		return srv.(ScanRuleServiceServer).ListScanRuleRevisions(ctx, req.(*ListScanRuleRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ScanRuleService_UpdateScanRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateScanRuleRequest)
+func _ScanRuleService_DiffScanRuleRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffScanRuleRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ScanRuleServiceServer).UpdateScanRule(ctx, in)
+		return srv.(ScanRuleServiceServer).DiffScanRuleRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ScanRuleService_UpdateScanRule_FullMethodName,
+		FullMethod: ScanRuleService_DiffScanRuleRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ScanRuleServiceServer).UpdateScanRule(ctx, req.(*UpdateScanRuleRequest))
+		return srv.(ScanRuleServiceServer).DiffScanRuleRevisions(ctx, req.(*DiffScanRuleRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ScanRuleService_DeleteScanRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteScanRuleRequest)
+func _ScanRuleService_RestoreScanRuleRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreScanRuleRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ScanRuleServiceServer).DeleteScanRule(ctx, in)
+		return srv.(ScanRuleServiceServer).RestoreScanRuleRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ScanRuleService_DeleteScanRule_FullMethodName,
+		FullMethod: ScanRuleService_RestoreScanRuleRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ScanRuleServiceServer).DeleteScanRule(ctx, req.(*DeleteScanRuleRequest))
+		return srv.(ScanRuleServiceServer).RestoreScanRuleRevision(ctx, req.(*RestoreScanRuleRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ScanRuleService_ListScanRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListScanRulesRequest)
+func _ScanRuleService_ExportScanRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportScanRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ScanRuleServiceServer).ListScanRules(ctx, in)
+		return srv.(ScanRuleServiceServer).ExportScanRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ScanRuleService_ListScanRules_FullMethodName,
+		FullMethod: ScanRuleService_ExportScanRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ScanRuleServiceServer).ListScanRules(ctx, req.(*ListScanRulesRequest))
+		return srv.(ScanRuleServiceServer).ExportScanRules(ctx, req.(*ExportScanRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ScanRuleService_GetScanRuleByComposite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetScanRuleByCompositeRequest)
+func _ScanRuleService_ApplyScanRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyScanRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ScanRuleServiceServer).GetScanRuleByComposite(ctx, in)
+		return srv.(ScanRuleServiceServer).ApplyScanRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ScanRuleService_GetScanRuleByComposite_FullMethodName,
+		FullMethod: ScanRuleService_ApplyScanRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ScanRuleServiceServer).GetScanRuleByComposite(ctx, req.(*GetScanRuleByCompositeRequest))
+		return srv.(ScanRuleServiceServer).ApplyScanRules(ctx, req.(*ApplyScanRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2458,6 +3581,34 @@ var ScanRuleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetScanRuleByComposite",
 			Handler:    _ScanRuleService_GetScanRuleByComposite_Handler,
 		},
+		{
+			MethodName: "GetEffectiveScanRule",
+			Handler:    _ScanRuleService_GetEffectiveScanRule_Handler,
+		},
+		{
+			MethodName: "TestExcludes",
+			Handler:    _ScanRuleService_TestExcludes_Handler,
+		},
+		{
+			MethodName: "ListScanRuleRevisions",
+			Handler:    _ScanRuleService_ListScanRuleRevisions_Handler,
+		},
+		{
+			MethodName: "DiffScanRuleRevisions",
+			Handler:    _ScanRuleService_DiffScanRuleRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreScanRuleRevision",
+			Handler:    _ScanRuleService_RestoreScanRuleRevision_Handler,
+		},
+		{
+			MethodName: "ExportScanRules",
+			Handler:    _ScanRuleService_ExportScanRules_Handler,
+		},
+		{
+			MethodName: "ApplyScanRules",
+			Handler:    _ScanRuleService_ApplyScanRules_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "processor.proto",
@@ -2472,6 +3623,8 @@ const (
 	PermissionService_ListPermissions_FullMethodName            = "/data_processor.PermissionService/ListPermissions"
 	PermissionService_GetTeamPermissions_FullMethodName         = "/data_processor.PermissionService/GetTeamPermissions"
 	PermissionService_GetOrganizationPermissions_FullMethodName = "/data_processor.PermissionService/GetOrganizationPermissions"
+	PermissionService_GetEffectivePermissions_FullMethodName    = "/data_processor.PermissionService/GetEffectivePermissions"
+	PermissionService_CheckPermissions_FullMethodName           = "/data_processor.PermissionService/CheckPermissions"
 )
 
 // PermissionServiceClient is the client API for PermissionService service.
@@ -2488,6 +3641,8 @@ type PermissionServiceClient interface {
 	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
 	GetTeamPermissions(ctx context.Context, in *GetTeamPermissionsRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error)
 	GetOrganizationPermissions(ctx context.Context, in *GetOrganizationPermissionsRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error)
+	GetEffectivePermissions(ctx context.Context, in *GetEffectivePermissionsRequest, opts ...grpc.CallOption) (*EffectivePermissions, error)
+	CheckPermissions(ctx context.Context, in *CheckPermissionsRequest, opts ...grpc.CallOption) (*CheckPermissionsResponse, error)
 }
 
 type permissionServiceClient struct {
@@ -2578,6 +3733,26 @@ func (c *permissionServiceClient) GetOrganizationPermissions(ctx context.Context
 	return out, nil
 }
 
+func (c *permissionServiceClient) GetEffectivePermissions(ctx context.Context, in *GetEffectivePermissionsRequest, opts ...grpc.CallOption) (*EffectivePermissions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EffectivePermissions)
+	err := c.cc.Invoke(ctx, PermissionService_GetEffectivePermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionServiceClient) CheckPermissions(ctx context.Context, in *CheckPermissionsRequest, opts ...grpc.CallOption) (*CheckPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPermissionsResponse)
+	err := c.cc.Invoke(ctx, PermissionService_CheckPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PermissionServiceServer is the server API for PermissionService service.
 // All implementations must embed UnimplementedPermissionServiceServer
 // for forward compatibility.
@@ -2592,6 +3767,8 @@ type PermissionServiceServer interface {
 	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
 	GetTeamPermissions(context.Context, *GetTeamPermissionsRequest) (*GetPermissionsResponse, error)
 	GetOrganizationPermissions(context.Context, *GetOrganizationPermissionsRequest) (*GetPermissionsResponse, error)
+	GetEffectivePermissions(context.Context, *GetEffectivePermissionsRequest) (*EffectivePermissions, error)
+	CheckPermissions(context.Context, *CheckPermissionsRequest) (*CheckPermissionsResponse, error)
 	mustEmbedUnimplementedPermissionServiceServer()
 }
 
@@ -2626,6 +3803,12 @@ func (UnimplementedPermissionServiceServer) GetTeamPermissions(context.Context,
 func (UnimplementedPermissionServiceServer) GetOrganizationPermissions(context.Context, *GetOrganizationPermissionsRequest) (*GetPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationPermissions not implemented")
 }
+func (UnimplementedPermissionServiceServer) GetEffectivePermissions(context.Context, *GetEffectivePermissionsRequest) (*EffectivePermissions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectivePermissions not implemented")
+}
+func (UnimplementedPermissionServiceServer) CheckPermissions(context.Context, *CheckPermissionsRequest) (*CheckPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPermissions not implemented")
+}
 func (UnimplementedPermissionServiceServer) mustEmbedUnimplementedPermissionServiceServer() {}
 func (UnimplementedPermissionServiceServer) testEmbeddedByValue()                           {}
 
@@ -2791,6 +3974,42 @@ func _PermissionService_GetOrganizationPermissions_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PermissionService_GetEffectivePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectivePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServiceServer).GetEffectivePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionService_GetEffectivePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServiceServer).GetEffectivePermissions(ctx, req.(*GetEffectivePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionService_CheckPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServiceServer).CheckPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionService_CheckPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServiceServer).CheckPermissions(ctx, req.(*CheckPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PermissionService_ServiceDesc is the grpc.ServiceDesc for PermissionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2830,6 +4049,14 @@ var PermissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrganizationPermissions",
 			Handler:    _PermissionService_GetOrganizationPermissions_Handler,
 		},
+		{
+			MethodName: "GetEffectivePermissions",
+			Handler:    _PermissionService_GetEffectivePermissions_Handler,
+		},
+		{
+			MethodName: "CheckPermissions",
+			Handler:    _PermissionService_CheckPermissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "processor.proto",
@@ -3358,3 +4585,261 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "processor.proto",
 }
+
+const (
+	AuthService_Login_FullMethodName         = "/data_processor.AuthService/Login"
+	AuthService_Refresh_FullMethodName       = "/data_processor.AuthService/Refresh"
+	AuthService_Logout_FullMethodName        = "/data_processor.AuthService/Logout"
+	AuthService_ListSessions_FullMethodName  = "/data_processor.AuthService/ListSessions"
+	AuthService_RevokeSession_FullMethodName = "/data_processor.AuthService/RevokeSession"
+)
+
+// AuthServiceClient is the client API for AuthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Auth Service
+type AuthServiceClient interface {
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*TokenPair, error)
+	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*TokenPair, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type authServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthServiceClient(cc grpc.ClientConnInterface) AuthServiceClient {
+	return &authServiceClient{cc}
+}
+
+func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*TokenPair, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenPair)
+	err := c.cc.Invoke(ctx, AuthService_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*TokenPair, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenPair)
+	err := c.cc.Invoke(ctx, AuthService_Refresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServiceServer is the server API for AuthService service.
+// All implementations must embed UnimplementedAuthServiceServer
+// for forward compatibility.
+//
+// Auth Service
+type AuthServiceServer interface {
+	Login(context.Context, *LoginRequest) (*TokenPair, error)
+	Refresh(context.Context, *RefreshRequest) (*TokenPair, error)
+	Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+// UnimplementedAuthServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuthServiceServer struct{}
+
+func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*TokenPair, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedAuthServiceServer) Refresh(context.Context, *RefreshRequest) (*TokenPair, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedAuthServiceServer) Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedAuthServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
+func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthServiceServer will
+// result in compilation errors.
+type UnsafeAuthServiceServer interface {
+	mustEmbedUnimplementedAuthServiceServer()
+}
+
+func RegisterAuthServiceServer(s grpc.ServiceRegistrar, srv AuthServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuthServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuthService_ServiceDesc, srv)
+}
+
+func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Refresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Refresh(ctx, req.(*RefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "data_processor.AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _AuthService_Login_Handler,
+		},
+		{
+			MethodName: "Refresh",
+			Handler:    _AuthService_Refresh_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AuthService_Logout_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _AuthService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _AuthService_RevokeSession_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "processor.proto",
+}
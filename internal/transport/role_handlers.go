@@ -3,8 +3,6 @@ package data_processor
 import (
 	"context"
 	"data_processor/internal/common"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -14,12 +12,12 @@ func (s *Server) CreateRole(ctx context.Context, req *CreateRoleRequest) (*RoleW
 		Name:        req.Name,
 		Description: req.Description,
 		IsActive:    req.IsActive,
-		OwnerID:     int(req.OwnerId),
+		OwnerID:     common.UserID(req.OwnerId),
 	}
 
 	roleWithPerms, err := s.repositories.CreateRole(ctx, role)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create role: %v", err)
+		return nil, repoError(err, "create role")
 	}
 
 	return convertRoleWithPermissions(roleWithPerms), nil
@@ -29,10 +27,7 @@ func (s *Server) AddPermission(ctx context.Context, req *AddPermissionRequest) (
 	// Получаем permission для определения его scope
 	perm, err := s.repositories.GetPermissionByID(ctx, int(req.PermissionId))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get permission: %v", err)
-	}
-	if perm == nil {
-		return nil, status.Errorf(codes.NotFound, "permission not found")
+		return nil, repoError(err, "get permission")
 	}
 
 	// Создаем временный permission для передачи в AddPermission
@@ -44,13 +39,13 @@ func (s *Server) AddPermission(ctx context.Context, req *AddPermissionRequest) (
 	}
 
 	if err := s.repositories.AddPermission(ctx, int(req.RoleId), tmpPerm); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to add permission: %v", err)
+		return nil, repoError(err, "add permission")
 	}
 
 	// Возвращаем обновленную роль
 	roleWithPerms, err := s.repositories.GetRole(ctx, int(req.RoleId))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get role: %v", err)
+		return nil, repoError(err, "get role")
 	}
 
 	return convertRoleWithPermissions(roleWithPerms), nil
@@ -59,10 +54,7 @@ func (s *Server) AddPermission(ctx context.Context, req *AddPermissionRequest) (
 func (s *Server) GetRole(ctx context.Context, req *GetRoleRequest) (*RoleWithPermissions, error) {
 	role, err := s.repositories.GetRole(ctx, int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get role: %v", err)
-	}
-	if role == nil {
-		return nil, status.Errorf(codes.NotFound, "role not found")
+		return nil, repoError(err, "get role")
 	}
 
 	return convertRoleWithPermissions(role), nil
@@ -71,10 +63,7 @@ func (s *Server) GetRole(ctx context.Context, req *GetRoleRequest) (*RoleWithPer
 func (s *Server) GetRoleByName(ctx context.Context, req *GetRoleByNameRequest) (*Role, error) {
 	role, err := s.repositories.GetRoleByName(ctx, req.Name)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get role: %v", err)
-	}
-	if role == nil {
-		return nil, status.Errorf(codes.NotFound, "role not found")
+		return nil, repoError(err, "get role")
 	}
 
 	return &Role{
@@ -85,29 +74,41 @@ func (s *Server) GetRoleByName(ctx context.Context, req *GetRoleByNameRequest) (
 		CreatedAt:   timestamppb.New(role.CreatedAt),
 		UpdatedAt:   timestamppb.New(role.UpdatedAt),
 		OwnerId:     int32(role.OwnerID),
+		Etag:        etag(role.Revision),
 	}, nil
 }
 
 func (s *Server) UpdateRole(ctx context.Context, req *UpdateRoleRequest) (*Role, error) {
-	var (
-		name        *string
-		description *string
-		isActive    *bool
-	)
-
-	if req.Name != nil {
-		name = req.Name
-	}
-	if req.Description != nil {
-		description = req.Description
+	fields, err := updatePaths(req)
+	if err != nil {
+		return nil, err
 	}
-	if req.IsActive != nil {
-		isActive = req.IsActive
+	revision, err := revisionFromEtag(req.Etag)
+	if err != nil {
+		return nil, err
 	}
 
-	role, err := s.repositories.UpdateRole(ctx, int(req.Id), name, description, isActive)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update role: %v", err)
+	var role *common.Role
+	if len(fields) == 0 {
+		current, err := s.repositories.GetRole(ctx, int(req.Id))
+		if err != nil {
+			return nil, repoError(err, "get role")
+		}
+		if err := checkEtag(etag(current.Role.Revision), revision, "update role"); err != nil {
+			return nil, err
+		}
+		role = current.Role
+	} else {
+		role, err = s.repositories.UpdateRole(ctx, &common.Role{
+			ID:          int(req.Id),
+			Revision:    revision,
+			Name:        req.GetName(),
+			Description: req.Description,
+			IsActive:    req.IsActive,
+		}, fields)
+		if err != nil {
+			return nil, repoError(err, "update role")
+		}
 	}
 
 	return &Role{
@@ -118,12 +119,17 @@ func (s *Server) UpdateRole(ctx context.Context, req *UpdateRoleRequest) (*Role,
 		CreatedAt:   timestamppb.New(role.CreatedAt),
 		UpdatedAt:   timestamppb.New(role.UpdatedAt),
 		OwnerId:     int32(role.OwnerID),
+		Etag:        etag(role.Revision),
 	}, nil
 }
 
 func (s *Server) DeleteRole(ctx context.Context, req *DeleteRoleRequest) (*emptypb.Empty, error) {
-	if err := s.repositories.DeleteRole(ctx, int(req.Id)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete role: %v", err)
+	revision, err := revisionFromEtag(req.Etag)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repositories.DeleteRole(ctx, int(req.Id), revision); err != nil {
+		return nil, repoError(err, "delete role")
 	}
 	return &emptypb.Empty{}, nil
 }
@@ -142,12 +148,24 @@ func (s *Server) DeleteRole(ctx context.Context, req *DeleteRoleRequest) (*empty
 //}
 
 func (s *Server) ListRoles(ctx context.Context, req *ListRolesRequest) (*ListRolesResponse, error) {
-	roles, err := s.repositories.ListRoles(ctx)
+	page, err := s.pageFromRequest(req, "roles")
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list roles: %v", err)
+		return nil, err
 	}
 
-	resp := &ListRolesResponse{}
+	roles, total, err := s.repositories.ListRoles(ctx, page.Page)
+	if err != nil {
+		return nil, listError(err, "roles")
+	}
+
+	roles, nextPageToken, err := nextPage(s, roles, page, func(item *common.Role) common.PageKey {
+		return common.PageKey{ID: item.ID}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListRolesResponse{TotalCount: int32(total), NextPageToken: nextPageToken}
 	for _, role := range roles {
 		resp.Roles = append(resp.Roles, &Role{
 			Id:          int32(role.ID),
@@ -157,6 +175,7 @@ func (s *Server) ListRoles(ctx context.Context, req *ListRolesRequest) (*ListRol
 			CreatedAt:   timestamppb.New(role.CreatedAt),
 			UpdatedAt:   timestamppb.New(role.UpdatedAt),
 			OwnerId:     int32(role.OwnerID),
+			Etag:        etag(role.Revision),
 		})
 	}
 
@@ -177,7 +196,7 @@ func (s *Server) ListRolesByScope(ctx context.Context, req *ListRolesByScopeRequ
 
 	roles, err := s.repositories.ListRolesByScope(ctx, scope)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list roles by scope: %v", err)
+		return nil, repoError(err, "list roles by scope")
 	}
 
 	resp := &ListRolesWithPermissionsResponse{}
@@ -189,23 +208,23 @@ func (s *Server) ListRolesByScope(ctx context.Context, req *ListRolesByScopeRequ
 }
 
 func (s *Server) AssignRoleToUser(ctx context.Context, req *AssignRoleRequest) (*emptypb.Empty, error) {
-	if err := s.repositories.AssignRoleToUser(ctx, int(req.UserId), int(req.RoleId)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to assign role to user: %v", err)
+	if err := s.repositories.AssignRoleToUser(ctx, common.UserID(req.UserId), int(req.RoleId)); err != nil {
+		return nil, repoError(err, "assign role to user")
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) RemoveRoleFromUser(ctx context.Context, req *RemoveRoleRequest) (*emptypb.Empty, error) {
-	if err := s.repositories.RemoveRoleFromUser(ctx, int(req.UserId), int(req.RoleId)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to remove role from user: %v", err)
+	if err := s.repositories.RemoveRoleFromUser(ctx, common.UserID(req.UserId), int(req.RoleId)); err != nil {
+		return nil, repoError(err, "remove role from user")
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) GetUserRoles(ctx context.Context, req *GetUserRolesRequest) (*ListRolesResponse, error) {
-	roles, err := s.repositories.GetUserRoles(ctx, int(req.UserId))
+	roles, err := s.repositories.GetUserRoles(ctx, common.UserID(req.UserId))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user roles: %v", err)
+		return nil, repoError(err, "get user roles")
 	}
 
 	resp := &ListRolesResponse{}
@@ -218,6 +237,7 @@ func (s *Server) GetUserRoles(ctx context.Context, req *GetUserRolesRequest) (*L
 			CreatedAt:   timestamppb.New(role.CreatedAt),
 			UpdatedAt:   timestamppb.New(role.UpdatedAt),
 			OwnerId:     int32(role.OwnerID),
+			Etag:        etag(role.Revision),
 		})
 	}
 
@@ -234,6 +254,7 @@ func convertRoleWithPermissions(r *common.RoleWithPermissions) *RoleWithPermissi
 		CreatedAt:   timestamppb.New(r.Role.CreatedAt),
 		UpdatedAt:   timestamppb.New(r.Role.UpdatedAt),
 		OwnerId:     int32(r.Role.OwnerID),
+		Etag:        etag(r.Role.Revision),
 	}
 
 	permissions := make([]*Permission, len(r.Permissions))
@@ -244,6 +265,7 @@ func convertRoleWithPermissions(r *common.RoleWithPermissions) *RoleWithPermissi
 			Description: p.Description,
 			Read:        p.Read,
 			Write:       p.Write,
+			Etag:        etag(p.Revision),
 		}
 
 		if p.OrganizationID != nil {
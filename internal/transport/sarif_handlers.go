@@ -0,0 +1,108 @@
+package data_processor
+
+import (
+	"data_processor/internal/common"
+	"data_processor/internal/sarif"
+	"errors"
+	"io"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) UploadSarif(stream ScanInfoService_UploadSarifServer) error {
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "scan_id is required")
+	}
+	if err != nil {
+		return err
+	}
+	scanID := first.ScanId
+
+	log, err := sarif.Parse(&sarifReader{stream: stream, scanID: scanID, chunk: first.Chunk, size: len(first.Chunk)})
+	if err != nil {
+		return sarifError(err)
+	}
+	findings, rules, err := log.Findings()
+	if err != nil {
+		return sarifError(err)
+	}
+
+	next := func() (*common.Finding, error) {
+		if len(findings) == 0 {
+			return nil, io.EOF
+		}
+		finding := findings[0]
+		findings = findings[1:]
+		return finding, nil
+	}
+	count, err := s.repositories.ReplaceFindings(stream.Context(), int(scanID), rules, next)
+	if err != nil {
+		return repoError(err, "upload SARIF")
+	}
+	return stream.SendAndClose(&UploadFindingsResponse{ScanId: scanID, Count: int64(count)})
+}
+
+// sarifReader склеивает части журнала из потока
+type sarifReader struct {
+	stream ScanInfoService_UploadSarifServer
+	scanID int32
+	chunk  []byte
+	size   int
+}
+
+func (r *sarifReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if err := checkStreamScan(r.scanID, req.ScanId); err != nil {
+			return 0, err
+		}
+		r.size += len(req.Chunk)
+		if r.size > maxUploadSize {
+			return 0, status.Errorf(codes.InvalidArgument, "SARIF log exceeds %d bytes", maxUploadSize)
+		}
+		r.chunk = req.Chunk
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+func sarifError(err error) error {
+	var sarifErr *sarif.Error
+	if !errors.As(err, &sarifErr) {
+		return err
+	}
+	return withDetails(status.Newf(codes.InvalidArgument, "invalid SARIF log: %v", err),
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       "chunk",
+			Description: sarifErr.Error(),
+		}}})
+}
+
+func (s *Server) ExportSarif(req *ExportSarifRequest, stream ScanInfoService_ExportSarifServer) error {
+	ctx := stream.Context()
+	rules, err := s.repositories.ListFindingRules(ctx, int(req.ScanId))
+	if err != nil {
+		return repoError(err, "export SARIF")
+	}
+
+	// До первых exportChunkSize байт ничего не отправляется, поэтому ошибка NotFound
+	// приходит вместо журнала, а не после его начала
+	w := newChunkWriter(stream.Send, func(chunk []byte) *ExportSarifResponse {
+		return &ExportSarifResponse{Chunk: chunk}
+	})
+	exporter := sarif.NewExporter(w, rules)
+	if err := s.repositories.WalkFindingsWithBaseline(ctx, int(req.ScanId), exporter.Write); err != nil {
+		return repoError(err, "export SARIF")
+	}
+	if err := exporter.Close(); err != nil {
+		return err
+	}
+	return w.Flush()
+}
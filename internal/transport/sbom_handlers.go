@@ -0,0 +1,189 @@
+package data_processor
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"data_processor/internal/sbom"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var sbomFormats = map[SbomFormat]sbom.Format{
+	SbomFormat_SBOM_FORMAT_CYCLONEDX_JSON: sbom.CycloneDXJSON,
+	SbomFormat_SBOM_FORMAT_CYCLONEDX_XML:  sbom.CycloneDXXML,
+	SbomFormat_SBOM_FORMAT_SPDX_JSON:      sbom.SPDXJSON,
+	SbomFormat_SBOM_FORMAT_SPDX_TAG_VALUE: sbom.SPDXTagValue,
+}
+
+func sbomFormatToProto(format sbom.Format) SbomFormat {
+	for p, f := range sbomFormats {
+		if f == format {
+			return p
+		}
+	}
+	return SbomFormat_SBOM_FORMAT_UNSPECIFIED
+}
+
+func (s *Server) UploadSbom(stream ScanInfoService_UploadSbomServer) error {
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "scan_id is required")
+	}
+	if err != nil {
+		return err
+	}
+	scanID := first.ScanId
+	format, ok := sbomFormats[first.Format]
+	if !ok && first.Format != SbomFormat_SBOM_FORMAT_UNSPECIFIED {
+		return status.Errorf(codes.InvalidArgument, "unknown format %d", first.Format)
+	}
+
+	data := first.Chunk
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := checkStreamScan(scanID, req.ScanId); err != nil {
+			return err
+		}
+		if len(data)+len(req.Chunk) > maxUploadSize {
+			return status.Errorf(codes.InvalidArgument, "SBOM exceeds %d bytes", maxUploadSize)
+		}
+		data = append(data, req.Chunk...)
+	}
+
+	if format == "" {
+		if format, err = sbom.Detect(data); err != nil {
+			return sbomError(err)
+		}
+	}
+	components, err := sbom.Parse(data, format)
+	if err != nil {
+		return sbomError(err)
+	}
+	if err := s.repositories.ReplaceComponents(stream.Context(), int(scanID), components); err != nil {
+		return repoError(err, "upload SBOM")
+	}
+
+	resp := &UploadSbomResponse{ScanId: scanID, Format: sbomFormatToProto(format), ComponentCount: int64(len(components))}
+	for _, c := range components {
+		resp.DependencyCount += int64(len(c.DependsOn))
+	}
+	return stream.SendAndClose(resp)
+}
+
+func sbomError(err error) error {
+	var sbomErr *sbom.Error
+	if !errors.As(err, &sbomErr) {
+		return err
+	}
+	return withDetails(status.Newf(codes.InvalidArgument, "invalid SBOM: %v", err),
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       "chunk",
+			Description: sbomErr.Error(),
+		}}})
+}
+
+func (s *Server) ListComponents(ctx context.Context, req *ListComponentsRequest) (*ListComponentsResponse, error) {
+	scan, err := s.repositories.GetLatestScan(ctx, int(req.VersionId), common.ScanTypeSCA, common.ScanSucceeded)
+	if err != nil {
+		return nil, repoError(err, "get latest scan")
+	}
+	// Токен страницы привязан к скану: после нового скана список начинается заново
+	page, err := s.pageFromRequest(req, fmt.Sprintf("components:%d", scan.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	components, total, err := s.repositories.ListComponents(ctx, scan.ID, page.Page)
+	if err != nil {
+		return nil, listError(err, "components")
+	}
+
+	components, nextPageToken, err := nextPage(s, components, page, func(item *common.Component) common.PageKey {
+		return common.PageKey{ID: item.ID}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListComponentsResponse{ScanId: int32(scan.ID), TotalCount: int32(total), NextPageToken: nextPageToken}
+	for _, c := range components {
+		resp.Components = append(resp.Components, convertComponentToProto(c))
+	}
+	return resp, nil
+}
+
+func convertComponentToProto(c *common.Component) *Component {
+	return &Component{
+		Id:        int64(c.ID),
+		ScanId:    int32(c.ScanID),
+		Ref:       c.Ref,
+		Primary:   c.Primary,
+		Name:      c.Name,
+		Version:   c.Version,
+		Purl:      c.PURL,
+		Licenses:  c.Licenses,
+		Hashes:    c.Hashes,
+		DependsOn: c.DependsOn,
+	}
+}
+
+func (s *Server) ExportSbom(req *ExportSbomRequest, stream VersionService_ExportSbomServer) error {
+	format := sbom.CycloneDXJSON
+	if req.Format != SbomFormat_SBOM_FORMAT_UNSPECIFIED {
+		format = sbomFormats[req.Format]
+	}
+	if format != sbom.CycloneDXJSON && format != sbom.SPDXJSON {
+		return status.Errorf(codes.InvalidArgument, "format must be %s or %s, not %s",
+			SbomFormat_SBOM_FORMAT_CYCLONEDX_JSON, SbomFormat_SBOM_FORMAT_SPDX_JSON, req.Format)
+	}
+
+	ctx := stream.Context()
+	version, err := s.repositories.GetVersionByID(ctx, int(req.VersionId))
+	if err != nil {
+		return repoError(err, "get version")
+	}
+	app, err := s.repositories.GetApplicationByID(ctx, version.ApplicationID)
+	if err != nil {
+		return repoError(err, "get application")
+	}
+	scan, err := s.repositories.GetLatestScan(ctx, version.ID, common.ScanTypeSCA, common.ScanSucceeded)
+	if err != nil {
+		return repoError(err, "get latest scan")
+	}
+	components, _, err := s.repositories.ListComponents(ctx, scan.ID, common.Page{})
+	if err != nil {
+		return repoError(err, "list components")
+	}
+
+	// Время создания и идентификатор документа берутся из скана, чтобы повторный экспорт
+	// давал те же байты
+	created := scan.ScanDate
+	if scan.FinishedAt != nil {
+		created = *scan.FinishedAt
+	}
+	doc := &sbom.Document{
+		Name:       app.Name,
+		Version:    version.Version,
+		ID:         fmt.Sprintf("%s/versions/%d/scans/%d", sbom.ToolName, version.ID, scan.ID),
+		Created:    created,
+		Components: components,
+	}
+	w := newChunkWriter(stream.Send, func(chunk []byte) *ExportSbomResponse {
+		return &ExportSbomResponse{Chunk: chunk}
+	})
+	if err := sbom.Write(w, format, doc); err != nil {
+		return err
+	}
+	return w.Flush()
+}
@@ -0,0 +1,65 @@
+package data_processor
+
+import (
+	"data_processor/internal/sbom"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUploadSbomInvalidDocument(t *testing.T) {
+	s := &Server{}
+
+	// Документ разрезан на части посреди значения
+	err := s.UploadSbom(&uploadStream[*UploadSbomRequest, *UploadSbomResponse]{requests: []*UploadSbomRequest{
+		{ScanId: 1, Chunk: []byte(`{"bomFormat": "CycloneDX", "specVersion": "1.6", "components": [{"na`)},
+		{ScanId: 1, Chunk: []byte(`me": "a", "purl": "a@1"}]}`)},
+	}})
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	violation := st.Details()[0].(*errdetails.BadRequest).FieldViolations[0]
+	assert.Equal(t, "chunk", violation.Field)
+	assert.Equal(t, `components[0].purl: invalid package URL "a@1"`, violation.Description)
+
+	// Заданный формат не определяется заново
+	err = s.UploadSbom(&uploadStream[*UploadSbomRequest, *UploadSbomResponse]{requests: []*UploadSbomRequest{
+		{ScanId: 1, Format: SbomFormat_SBOM_FORMAT_SPDX_JSON, Chunk: []byte(`{"bomFormat": "CycloneDX", "specVersion": "1.6"}`)},
+	}})
+	st = status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Contains(t, st.Message(), "spdxVersion: unsupported version")
+
+	err = s.UploadSbom(&uploadStream[*UploadSbomRequest, *UploadSbomResponse]{requests: []*UploadSbomRequest{
+		{ScanId: 1, Chunk: []byte(`{"bomFormat": `)},
+		{ScanId: 2, Chunk: []byte(`"CycloneDX"}`)},
+	}})
+	st = status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Contains(t, st.Message(), "scan_id must be 1")
+
+	err = s.UploadSbom(&uploadStream[*UploadSbomRequest, *UploadSbomResponse]{requests: []*UploadSbomRequest{{ScanId: 1, Format: 42}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = s.UploadSbom(&uploadStream[*UploadSbomRequest, *UploadSbomResponse]{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSbomFormatToProto(t *testing.T) {
+	for format, want := range sbomFormats {
+		assert.Equal(t, format, sbomFormatToProto(want))
+	}
+	assert.Equal(t, SbomFormat_SBOM_FORMAT_UNSPECIFIED, sbomFormatToProto(sbom.Format("csv")))
+}
+
+func TestExportSbomUnsupportedFormat(t *testing.T) {
+	s := &Server{}
+	err := s.ExportSbom(&ExportSbomRequest{VersionId: 1, Format: SbomFormat_SBOM_FORMAT_SPDX_TAG_VALUE}, nil)
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "format must be SBOM_FORMAT_CYCLONEDX_JSON or SBOM_FORMAT_SPDX_JSON, not SBOM_FORMAT_SPDX_TAG_VALUE", st.Message())
+}
@@ -2,113 +2,288 @@ package data_processor
 
 import (
 	"context"
+	"data_processor/internal/auth"
 	"data_processor/internal/common"
+	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"slices"
+	"time"
 )
 
 func (s *Server) CreateScan(ctx context.Context, req *CreateScanRequest) (*Scan, error) {
 	scan := &common.Scan{
 		ScanDate:  req.ScanDate.AsTime(),
 		VersionID: int(req.VersionId),
+		Type:      scanTypeFromProto(req.ScanType),
+	}
+	if scan.Type == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "scan_type: unknown scan type %v", req.ScanType)
+	}
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		scan.InitiatorID = &principal.UserID
 	}
 
 	if err := s.repositories.CreateScan(ctx, scan); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create scan: %v", err)
+		return nil, repoError(err, "create scan")
 	}
-
-	return &Scan{
-		Id:        int32(scan.ID),
-		ScanDate:  timestamppb.New(scan.ScanDate),
-		VersionId: int32(scan.VersionID),
-	}, nil
+	return convertScanToProto(scan), nil
 }
 
 func (s *Server) GetScan(ctx context.Context, req *GetScanRequest) (*Scan, error) {
 	scan, err := s.repositories.GetScanByID(ctx, int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get scan: %v", err)
-	}
-	if scan == nil {
-		return nil, status.Errorf(codes.NotFound, "scan not found")
+		return nil, repoError(err, "get scan")
 	}
-
-	return &Scan{
-		Id:        int32(scan.ID),
-		ScanDate:  timestamppb.New(scan.ScanDate),
-		VersionId: int32(scan.VersionID),
-	}, nil
+	return convertScanToProto(scan), nil
 }
 
 func (s *Server) UpdateScan(ctx context.Context, req *UpdateScanRequest) (*Scan, error) {
-	// Получаем текущий скан
-	currentScan, err := s.repositories.GetScanByID(ctx, int(req.Id))
+	fields, err := updatePaths(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current scan: %v", err)
+		return nil, err
 	}
-	if currentScan == nil {
-		return nil, status.Errorf(codes.NotFound, "scan not found")
+	if err := requireMaskedIDs(req, fields, "version_id"); err != nil {
+		return nil, err
 	}
-
-	// Подготавливаем обновленные данные
-	updatedScan := &common.Scan{
-		ID: int(req.Id),
+	if len(fields) == 0 {
+		return s.GetScan(ctx, &GetScanRequest{Id: req.Id})
 	}
 
-	// Обрабатываем ScanDate (если не передано - оставляем текущее)
-	if req.ScanDate != nil {
-		updatedScan.ScanDate = req.ScanDate.AsTime()
-	} else {
-		updatedScan.ScanDate = currentScan.ScanDate
+	scan := &common.Scan{
+		ID:        int(req.Id),
+		ScanDate:  req.GetScanDate().AsTime(),
+		VersionID: int(req.GetVersionId()),
 	}
 
-	// Обрабатываем VersionID (если не передано - оставляем текущее)
-	if req.VersionId != nil {
-		// Проверяем существование версии
-		if _, err := s.repositories.GetVersionByID(ctx, int(*req.VersionId)); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "version with id %d not found", req.VersionId)
-		}
-		updatedScan.VersionID = int(*req.VersionId)
-	} else {
-		updatedScan.VersionID = currentScan.VersionID
+	// Дата скана обязательна: очистить её маской нельзя
+	if slices.Contains(fields, "scan_date") && req.ScanDate == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "scan_date cannot be cleared")
 	}
 
-	// Обновляем скан
-	if err := s.repositories.UpdateScan(ctx, updatedScan); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update scan: %v", err)
+	updatedScan, err := s.repositories.UpdateScan(ctx, scan, fields)
+	if err != nil {
+		return nil, repoError(err, "update scan")
 	}
-
-	// Возвращаем обновленный скан
-	return &Scan{
-		Id:        int32(updatedScan.ID),
-		ScanDate:  timestamppb.New(updatedScan.ScanDate),
-		VersionId: int32(updatedScan.VersionID),
-	}, nil
+	return convertScanToProto(updatedScan), nil
 }
 
 func (s *Server) DeleteScan(ctx context.Context, req *DeleteScanRequest) (*emptypb.Empty, error) {
 	if err := s.repositories.DeleteScan(ctx, int(req.Id)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete scan: %v", err)
+		return nil, repoError(err, "delete scan")
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) ListScans(ctx context.Context, req *ListScansRequest) (*ListScansResponse, error) {
-	scans, err := s.repositories.ListScans(ctx, int(req.VersionId))
+	list := fmt.Sprintf("scans:%d", req.VersionId)
+	page, err := s.pageFromRequest(req, list)
+	if err != nil {
+		return nil, err
+	}
+
+	scans, total, err := s.repositories.ListScans(ctx, int(req.VersionId), page.Page)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list scans: %v", err)
+		return nil, listError(err, "scans")
 	}
 
-	resp := &ListScansResponse{}
+	scans, nextPageToken, err := nextPage(s, scans, page, func(item *common.Scan) common.PageKey {
+		return common.PageKey{Time: item.ScanDate, ID: item.ID}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListScansResponse{TotalCount: int32(total), NextPageToken: nextPageToken}
 	for _, scan := range scans {
-		resp.Scans = append(resp.Scans, &Scan{
-			Id:        int32(scan.ID),
-			ScanDate:  timestamppb.New(scan.ScanDate),
-			VersionId: int32(scan.VersionID),
-		})
+		resp.Scans = append(resp.Scans, convertScanToProto(scan))
 	}
 
 	return resp, nil
 }
+
+func (s *Server) StartScan(ctx context.Context, req *StartScanRequest) (*Scan, error) {
+	return s.transitionScan(ctx, req.Id, common.ScanRunning, "", nil)
+}
+
+func (s *Server) CompleteScan(ctx context.Context, req *CompleteScanRequest) (*Scan, error) {
+	return s.transitionScan(ctx, req.Id, common.ScanSucceeded, req.WorkerId, nil)
+}
+
+func (s *Server) FailScan(ctx context.Context, req *FailScanRequest) (*Scan, error) {
+	return s.transitionScan(ctx, req.Id, common.ScanFailed, req.WorkerId, &req.Reason)
+}
+
+func (s *Server) CancelScan(ctx context.Context, req *CancelScanRequest) (*Scan, error) {
+	var reason *string
+	if req.Reason != "" {
+		reason = &req.Reason
+	}
+	return s.transitionScan(ctx, req.Id, common.ScanCancelled, "", reason)
+}
+
+func (s *Server) transitionScan(ctx context.Context, id int32, to common.ScanStatus, workerID string, reason *string) (*Scan, error) {
+	scan, err := s.repositories.TransitionScan(ctx, int(id), to, workerID, reason)
+	if err != nil {
+		return nil, repoError(err, "update scan status")
+	}
+	return convertScanToProto(scan), nil
+}
+
+func (s *Server) LeaseScan(ctx context.Context, req *LeaseScanRequest) (*LeaseScanResponse, error) {
+	types := make([]common.ScanType, 0, len(req.Capabilities))
+	for i, capability := range req.Capabilities {
+		scanType := scanTypeFromProto(capability)
+		if scanType == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "capabilities[%d]: unknown scan type %v", i, capability)
+		}
+		types = append(types, scanType)
+	}
+
+	lease, err := s.repositories.LeaseScan(ctx, req.WorkerId, types, s.scanQueue)
+	if err != nil {
+		return nil, repoError(err, "lease scan")
+	}
+	if lease == nil {
+		return &LeaseScanResponse{}, nil
+	}
+	return &LeaseScanResponse{
+		Scan: convertScanToProto(lease.Scan),
+		Rule: convertEffectiveScanRuleToProto(lease.Rule),
+	}, nil
+}
+
+func (s *Server) HeartbeatScan(ctx context.Context, req *HeartbeatScanRequest) (*Scan, error) {
+	scan, err := s.repositories.HeartbeatScan(ctx, int(req.Id), req.WorkerId, s.scanQueue.LeaseTTL)
+	if err != nil {
+		return nil, repoError(err, "extend scan lease")
+	}
+	return convertScanToProto(scan), nil
+}
+
+func (s *Server) ReportProgress(ctx context.Context, req *ReportProgressRequest) (*Scan, error) {
+	progress := common.ScanProgress{
+		Phase:   req.Phase,
+		Percent: int(req.Progress),
+		Message: req.Message,
+	}
+	scan, err := s.repositories.ReportScanProgress(ctx, int(req.Id), req.WorkerId, progress, s.scanQueue.LeaseTTL)
+	if err != nil {
+		return nil, repoError(err, "report scan progress")
+	}
+	return convertScanToProto(scan), nil
+}
+
+// watchScanPollInterval ограничивает задержку, если уведомление об изменении скана потерялось
+const watchScanPollInterval = 30 * time.Second
+
+func (s *Server) WatchScan(req *WatchScanRequest, stream ScanService_WatchScanServer) error {
+	if s.scanEvents == nil {
+		return status.Error(codes.Unavailable, "scan events are not available")
+	}
+	ctx := stream.Context()
+
+	// Подписка до первого чтения, чтобы не пропустить изменение между чтением и ожиданием
+	sub := s.scanEvents.Subscribe(int(req.Id))
+	defer sub.Close()
+	poll := time.NewTicker(watchScanPollInterval)
+	defer poll.Stop()
+
+	var last *Scan
+	for {
+		scan, err := s.repositories.GetScanByID(ctx, int(req.Id))
+		if err != nil {
+			return repoError(err, "get scan")
+		}
+		resp := convertScanToProto(scan)
+		if last == nil || !proto.Equal(last, resp) {
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+			last = resp
+		}
+		if scan.Status.Finished() {
+			return nil
+		}
+
+		select {
+		case <-sub.C:
+		case <-poll.C:
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+func convertScanToProto(scan *common.Scan) *Scan {
+	resp := &Scan{
+		Id:         int32(scan.ID),
+		ScanDate:   timestamppb.New(scan.ScanDate),
+		VersionId:  int32(scan.VersionID),
+		Status:     scanStatusToProto(scan.Status),
+		ScanType:   scanTypeToProto(scan.Type),
+		ExitReason: scan.ExitReason,
+		Attempts:   int32(scan.Attempts),
+		LeaseOwner: scan.LeaseOwner,
+
+		Phase:           scan.Phase,
+		Progress:        int32(scan.Progress),
+		ProgressMessage: scan.ProgressMessage,
+	}
+	if !scan.AvailableAt.IsZero() {
+		resp.AvailableAt = timestamppb.New(scan.AvailableAt)
+	}
+	if scan.LeaseExpiresAt != nil {
+		resp.LeaseExpiresAt = timestamppb.New(*scan.LeaseExpiresAt)
+	}
+	if scan.InitiatorID != nil {
+		initiatorID := int32(*scan.InitiatorID)
+		resp.InitiatorId = &initiatorID
+	}
+	if scan.StartedAt != nil {
+		resp.StartedAt = timestamppb.New(*scan.StartedAt)
+	}
+	if scan.FinishedAt != nil {
+		resp.FinishedAt = timestamppb.New(*scan.FinishedAt)
+	}
+	return resp
+}
+
+func scanStatusToProto(status common.ScanStatus) ScanStatus {
+	switch status {
+	case common.ScanQueued:
+		return ScanStatus_SCAN_STATUS_QUEUED
+	case common.ScanRunning:
+		return ScanStatus_SCAN_STATUS_RUNNING
+	case common.ScanSucceeded:
+		return ScanStatus_SCAN_STATUS_SUCCEEDED
+	case common.ScanFailed:
+		return ScanStatus_SCAN_STATUS_FAILED
+	case common.ScanCancelled:
+		return ScanStatus_SCAN_STATUS_CANCELLED
+	}
+	return ScanStatus_SCAN_STATUS_UNSPECIFIED
+}
+
+func scanTypeToProto(scanType common.ScanType) ScanType {
+	switch scanType {
+	case common.ScanTypeSAST:
+		return ScanType_SCAN_TYPE_SAST
+	case common.ScanTypeSCA:
+		return ScanType_SCAN_TYPE_SCA
+	}
+	return ScanType_SCAN_TYPE_UNSPECIFIED
+}
+
+func scanTypeFromProto(scanType ScanType) common.ScanType {
+	switch scanType {
+	case ScanType_SCAN_TYPE_SAST:
+		return common.ScanTypeSAST
+	case ScanType_SCAN_TYPE_SCA:
+		return common.ScanTypeSCA
+	}
+	return ""
+}
@@ -0,0 +1,51 @@
+package data_processor
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConvertScan(t *testing.T) {
+	started := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	initiator := common.UserID(7)
+	reason := "cancelled by user"
+
+	scan := convertScanToProto(&common.Scan{
+		ID:          1,
+		ScanDate:    started.Add(-time.Minute),
+		VersionID:   2,
+		Status:      common.ScanCancelled,
+		Type:        common.ScanTypeSAST,
+		InitiatorID: &initiator,
+		StartedAt:   &started,
+		ExitReason:  &reason,
+	})
+	assert.Equal(t, ScanStatus_SCAN_STATUS_CANCELLED, scan.Status)
+	assert.Equal(t, ScanType_SCAN_TYPE_SAST, scan.ScanType)
+	assert.Equal(t, int32(7), scan.GetInitiatorId())
+	assert.Equal(t, started, scan.StartedAt.AsTime())
+	assert.Nil(t, scan.FinishedAt)
+	assert.Equal(t, reason, scan.GetExitReason())
+
+	// Сканы, созданные до появления типов, возвращаются без типа
+	legacy := convertScanToProto(&common.Scan{Status: common.ScanSucceeded})
+	assert.Equal(t, ScanType_SCAN_TYPE_UNSPECIFIED, legacy.ScanType)
+	assert.Nil(t, legacy.InitiatorId)
+	assert.Equal(t, common.ScanType(""), scanTypeFromProto(ScanType_SCAN_TYPE_UNSPECIFIED))
+}
+
+func TestLeaseScanCapabilities(t *testing.T) {
+	s := &Server{}
+	_, err := s.LeaseScan(context.Background(), &LeaseScanRequest{
+		WorkerId:     "w1",
+		Capabilities: []ScanType{ScanType_SCAN_TYPE_SAST, ScanType_SCAN_TYPE_UNSPECIFIED},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "capabilities[1]")
+}
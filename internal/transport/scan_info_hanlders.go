@@ -3,8 +3,6 @@ package data_processor
 import (
 	"context"
 	"data_processor/internal/common"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -14,7 +12,7 @@ func (s *Server) CreateScanInfo(ctx context.Context, req *CreateScanInfoRequest)
 	}
 
 	if err := s.repositories.CreateScanInfo(ctx, scanInfo); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create scan info: %v", err)
+		return nil, repoError(err, "create scan info")
 	}
 
 	return &ScanInfo{
@@ -26,10 +24,7 @@ func (s *Server) CreateScanInfo(ctx context.Context, req *CreateScanInfoRequest)
 func (s *Server) GetScanInfo(ctx context.Context, req *GetScanInfoRequest) (*ScanInfo, error) {
 	scanInfo, err := s.repositories.GetScanInfoByID(ctx, int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get scan info: %v", err)
-	}
-	if scanInfo == nil {
-		return nil, status.Errorf(codes.NotFound, "scan info not found")
+		return nil, repoError(err, "get scan info")
 	}
 
 	return &ScanInfo{
@@ -41,10 +36,7 @@ func (s *Server) GetScanInfo(ctx context.Context, req *GetScanInfoRequest) (*Sca
 func (s *Server) GetScanInfoByScan(ctx context.Context, req *GetScanInfoByScanRequest) (*ScanInfo, error) {
 	scanInfo, err := s.repositories.GetScanInfoByScanID(ctx, int(req.ScanId))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get scan info: %v", err)
-	}
-	if scanInfo == nil {
-		return nil, status.Errorf(codes.NotFound, "scan info not found")
+		return nil, repoError(err, "get scan info")
 	}
 
 	return &ScanInfo{
@@ -54,37 +46,27 @@ func (s *Server) GetScanInfoByScan(ctx context.Context, req *GetScanInfoByScanRe
 }
 
 func (s *Server) UpdateScanInfo(ctx context.Context, req *UpdateScanInfoRequest) (*ScanInfo, error) {
-	// Получаем текущую информацию о сканировании
-	currentScanInfo, err := s.repositories.GetScanInfoByID(ctx, int(req.Id))
+	fields, err := updatePaths(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current scan info: %v", err)
+		return nil, err
 	}
-	if currentScanInfo == nil {
-		return nil, status.Errorf(codes.NotFound, "scan info not found")
+	if err := requireMaskedIDs(req, fields, "scan_id"); err != nil {
+		return nil, err
 	}
-
-	// Подготавливаем обновленные данные
-	updatedScanInfo := &common.ScanInfo{
-		ID: int(req.Id),
+	if len(fields) == 0 {
+		return s.GetScanInfo(ctx, &GetScanInfoRequest{Id: req.Id})
 	}
 
-	// Обрабатываем ScanID (если не передано - оставляем текущее)
-	if req.ScanId != nil {
-		// Проверяем существование скана
-		if _, err := s.repositories.GetScanByID(ctx, int(*req.ScanId)); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "scan with id %d not found", req.ScanId)
-		}
-		updatedScanInfo.ScanID = int(*req.ScanId)
-	} else {
-		updatedScanInfo.ScanID = currentScanInfo.ScanID
+	scanInfo := &common.ScanInfo{
+		ID:     int(req.Id),
+		ScanID: int(req.GetScanId()),
 	}
 
-	// Обновляем информацию о сканировании
-	if err := s.repositories.UpdateScanInfo(ctx, updatedScanInfo); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update scan info: %v", err)
+	updatedScanInfo, err := s.repositories.UpdateScanInfo(ctx, scanInfo, fields)
+	if err != nil {
+		return nil, repoError(err, "update scan info")
 	}
 
-	// Возвращаем обновленную информацию
 	return &ScanInfo{
 		Id:     int32(updatedScanInfo.ID),
 		ScanId: int32(updatedScanInfo.ScanID),
@@ -93,7 +75,7 @@ func (s *Server) UpdateScanInfo(ctx context.Context, req *UpdateScanInfoRequest)
 
 func (s *Server) DeleteScanInfo(ctx context.Context, req *DeleteScanInfoRequest) (*emptypb.Empty, error) {
 	if err := s.repositories.DeleteScanInfo(ctx, int(req.Id)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete scan info: %v", err)
+		return nil, repoError(err, "delete scan info")
 	}
 	return &emptypb.Empty{}, nil
 }
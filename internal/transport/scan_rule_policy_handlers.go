@@ -0,0 +1,155 @@
+package data_processor
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"data_processor/internal/policy"
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ExportScanRules(ctx context.Context, req *ExportScanRulesRequest) (*ExportScanRulesResponse, error) {
+	format, err := scanRulesFormatFromProto(req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	var orgIDs []int
+	if req.OrganizationId != nil {
+		orgIDs = []int{int(*req.OrganizationId)}
+	}
+	state, err := s.repositories.GetScanRulePolicyState(ctx, orgIDs)
+	if err != nil {
+		return nil, repoError(err, "export scan rules")
+	}
+	if orgIDs != nil && len(state.Organizations) == 0 {
+		return nil, repoError(common.NotFoundError("organization", orgIDs[0]), "export scan rules")
+	}
+
+	doc, err := policy.Export(state)
+	if err != nil {
+		return nil, repoError(err, "export scan rules")
+	}
+	data, err := policy.Marshal(doc, format)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode scan rules: %v", err)
+	}
+	return &ExportScanRulesResponse{Document: string(data), Format: scanRulesFormatToProto(format)}, nil
+}
+
+func (s *Server) ApplyScanRules(ctx context.Context, req *ApplyScanRulesRequest) (*ApplyScanRulesResponse, error) {
+	format, err := scanRulesFormatFromProto(req.Format)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := policy.Parse([]byte(req.Document), format)
+	if err != nil {
+		return nil, documentError(err)
+	}
+
+	if req.OrganizationId != nil {
+		org, err := s.repositories.GetOrganizationByID(ctx, int(*req.OrganizationId))
+		if err != nil {
+			return nil, repoError(err, "apply scan rules")
+		}
+		if err := checkDocumentOrganization(doc, org.ProjectName); err != nil {
+			return nil, documentError(err)
+		}
+	}
+
+	changes, err := s.repositories.ApplyScanRulePolicy(ctx, doc, req.DryRun)
+	if err != nil {
+		return nil, documentError(err)
+	}
+
+	resp := &ApplyScanRulesResponse{
+		Steps:   make([]*ScanRulePlanStep, 0, len(changes)),
+		Applied: !req.DryRun,
+	}
+	for _, change := range changes {
+		step, err := convertPlanChangeToProto(change)
+		if err != nil {
+			return nil, err
+		}
+		resp.Steps = append(resp.Steps, step)
+	}
+	return resp, nil
+}
+
+// checkDocumentOrganization проверяет, что документ описывает только организацию с именем name
+func checkDocumentOrganization(doc *policy.Document, name string) error {
+	for i, org := range doc.Organizations {
+		if org.Name != name {
+			return &policy.Error{
+				Path: fmt.Sprintf("organizations[%d].name", i),
+				Msg:  fmt.Sprintf("the request is restricted to organization %q", name),
+			}
+		}
+	}
+	return nil
+}
+
+// documentError переводит ошибку документа в InvalidArgument с нарушением поля document;
+// остальные ошибки обрабатывает repoError
+func documentError(err error) error {
+	var docErr *policy.Error
+	if !errors.As(err, &docErr) {
+		return repoError(err, "apply scan rules")
+	}
+	return withDetails(status.Newf(codes.InvalidArgument, "invalid document: %v", err),
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       "document",
+			Description: docErr.Error(),
+		}}})
+}
+
+func scanRulesFormatFromProto(format ScanRulesFormat) (policy.Format, error) {
+	switch format {
+	case ScanRulesFormat_SCAN_RULES_FORMAT_UNSPECIFIED, ScanRulesFormat_SCAN_RULES_FORMAT_YAML:
+		return policy.YAML, nil
+	case ScanRulesFormat_SCAN_RULES_FORMAT_JSON:
+		return policy.JSON, nil
+	}
+	return 0, status.Errorf(codes.InvalidArgument, "unknown format %v", format)
+}
+
+func scanRulesFormatToProto(format policy.Format) ScanRulesFormat {
+	if format == policy.JSON {
+		return ScanRulesFormat_SCAN_RULES_FORMAT_JSON
+	}
+	return ScanRulesFormat_SCAN_RULES_FORMAT_YAML
+}
+
+func convertPlanChangeToProto(change policy.Change) (*ScanRulePlanStep, error) {
+	fields, err := convertScanRuleChangesToProto(change.Fields)
+	if err != nil {
+		return nil, err
+	}
+	step := &ScanRulePlanStep{
+		Action:  scanRulePlanActionToProto(change.Action),
+		Path:    change.Path,
+		Level:   scanRuleLevelToProto(change.Rule.Level()),
+		Changes: fields,
+	}
+	if change.Rule.ID != 0 {
+		id := int32(change.Rule.ID)
+		step.RuleId = &id
+	}
+	return step, nil
+}
+
+func scanRulePlanActionToProto(action policy.Action) ScanRulePlanAction {
+	switch action {
+	case policy.Create:
+		return ScanRulePlanAction_SCAN_RULE_PLAN_ACTION_CREATE
+	case policy.Update:
+		return ScanRulePlanAction_SCAN_RULE_PLAN_ACTION_UPDATE
+	case policy.Delete:
+		return ScanRulePlanAction_SCAN_RULE_PLAN_ACTION_DELETE
+	}
+	return ScanRulePlanAction_SCAN_RULE_PLAN_ACTION_UNSPECIFIED
+}
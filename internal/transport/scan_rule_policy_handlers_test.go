@@ -0,0 +1,61 @@
+package data_processor
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"data_processor/internal/policy"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestApplyScanRulesInvalidDocument(t *testing.T) {
+	s := &Server{}
+	_, err := s.ApplyScanRules(context.Background(), &ApplyScanRulesRequest{
+		Document: "version: 1\norganizations:\n  - name: acme\n    rule: {exclude_dir_regexp_queue: ['(']}\n",
+	})
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	violation := st.Details()[0].(*errdetails.BadRequest).FieldViolations[0]
+	assert.Equal(t, "document", violation.Field)
+	assert.Contains(t, violation.Description, "organizations[0].rule.exclude_dir_regexp_queue[0]")
+
+	_, err = s.ApplyScanRules(context.Background(), &ApplyScanRulesRequest{
+		Document: "version: 1",
+		Format:   ScanRulesFormat_SCAN_RULES_FORMAT_JSON,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCheckDocumentOrganization(t *testing.T) {
+	doc := &policy.Document{Version: policy.Version, Organizations: []policy.Organization{{Name: "acme"}, {Name: "globex"}}}
+
+	err := checkDocumentOrganization(doc, "acme")
+	var docErr *policy.Error
+	require.ErrorAs(t, err, &docErr)
+	assert.Equal(t, "organizations[1].name", docErr.Path)
+
+	doc.Organizations = doc.Organizations[:1]
+	assert.NoError(t, checkDocumentOrganization(doc, "acme"))
+}
+
+func TestConvertPlanChange(t *testing.T) {
+	team := 10
+	step, err := convertPlanChangeToProto(policy.Change{
+		Action: policy.Create,
+		Path:   "acme/payments",
+		Rule:   &common.ScanRule{OrganizationID: 1, TeamID: &team},
+		Fields: []common.ScanRuleChange{{Field: "team_id", New: 10}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ScanRulePlanAction_SCAN_RULE_PLAN_ACTION_CREATE, step.Action)
+	assert.Equal(t, ScanRuleLevel_SCAN_RULE_LEVEL_TEAM, step.Level)
+	assert.Nil(t, step.RuleId)
+	assert.Equal(t, "null", step.Changes[0].OldValue)
+	assert.Equal(t, "10", step.Changes[0].NewValue)
+}
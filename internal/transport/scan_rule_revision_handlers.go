@@ -0,0 +1,156 @@
+package data_processor
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListScanRuleRevisions(ctx context.Context, req *ListScanRuleRevisionsRequest) (*ListScanRuleRevisionsResponse, error) {
+	list := fmt.Sprintf("scan_rule_revisions:%d", req.RuleId)
+	page, err := s.pageFromRequest(req, list)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, total, err := s.repositories.ListScanRuleRevisions(ctx, int(req.RuleId), page.Page)
+	if err != nil {
+		return nil, listError(err, "scan rule revisions")
+	}
+
+	revisions, nextPageToken, err := nextPage(s, revisions, page, func(item *common.ScanRuleRevision) common.PageKey {
+		return common.PageKey{ID: item.ID}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListScanRuleRevisionsResponse{
+		Revisions:     make([]*ScanRuleRevision, 0, len(revisions)),
+		TotalCount:    int32(total),
+		NextPageToken: nextPageToken,
+	}
+	for _, revision := range revisions {
+		resp.Revisions = append(resp.Revisions, convertScanRuleRevisionToProto(revision))
+	}
+	return resp, nil
+}
+
+func (s *Server) DiffScanRuleRevisions(ctx context.Context, req *DiffScanRuleRevisionsRequest) (*DiffScanRuleRevisionsResponse, error) {
+	from, err := s.scanRuleRevision(ctx, req.RuleId, req.FromRevisionId)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.scanRuleRevision(ctx, req.RuleId, req.ToRevisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := convertScanRuleChangesToProto(common.DiffScanRules(from.After, to.After))
+	if err != nil {
+		return nil, err
+	}
+	return &DiffScanRuleRevisionsResponse{Changes: changes}, nil
+}
+
+func convertScanRuleChangesToProto(changes []common.ScanRuleChange) ([]*ScanRuleFieldChange, error) {
+	result := make([]*ScanRuleFieldChange, 0, len(changes))
+	for _, change := range changes {
+		oldValue, err := json.Marshal(change.Old)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode %s: %v", change.Field, err)
+		}
+		newValue, err := json.Marshal(change.New)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode %s: %v", change.Field, err)
+		}
+		result = append(result, &ScanRuleFieldChange{
+			Field:    change.Field,
+			OldValue: string(oldValue),
+			NewValue: string(newValue),
+		})
+	}
+	return result, nil
+}
+
+func (s *Server) RestoreScanRuleRevision(ctx context.Context, req *RestoreScanRuleRevisionRequest) (*ScanRule, error) {
+	revision, err := revisionFromEtag(req.Etag)
+	if err != nil {
+		return nil, err
+	}
+	from, err := s.scanRuleRevision(ctx, req.RuleId, req.RevisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// С момента ревизии приложение могло перейти в другую команду, а на уровне правила могло
+	// появиться другое правило. Шаблоны исключений проверяются как при создании: ревизия могла
+	// быть записана до их проверки
+	if state := from.After; state != nil {
+		if _, err := compileExcludes(state.ExcludeDirRegexpQueue, "exclude_dir_regexp_queue"); err != nil {
+			return nil, err
+		}
+		if err := s.repositories.CheckScanRuleScope(ctx, state.ApplicationID, state.TeamID, state.OrganizationID); err != nil {
+			return nil, repoError(err, "check scan rule scope")
+		}
+		if err := s.checkScanRuleUnique(ctx, state); err != nil {
+			return nil, err
+		}
+	}
+
+	rule, err := s.repositories.RestoreScanRuleRevision(ctx, from, revision)
+	if err != nil {
+		return nil, repoError(err, "restore scan rule revision")
+	}
+	return convertScanRuleToProto(rule), nil
+}
+
+// scanRuleRevision возвращает ревизию правила; ревизия другого правила считается отсутствующей
+func (s *Server) scanRuleRevision(ctx context.Context, ruleID, revisionID int32) (*common.ScanRuleRevision, error) {
+	revision, err := s.repositories.GetScanRuleRevision(ctx, int(revisionID))
+	if err == nil && revision.RuleID != int(ruleID) {
+		err = common.NotFoundError("scan_rule_revision", revisionID)
+	}
+	if err != nil {
+		return nil, repoError(err, "get scan rule revision")
+	}
+	return revision, nil
+}
+
+func convertScanRuleRevisionToProto(revision *common.ScanRuleRevision) *ScanRuleRevision {
+	resp := &ScanRuleRevision{
+		Id:           int32(revision.ID),
+		RuleId:       int32(revision.RuleID),
+		Operation:    scanRuleOperationToProto(revision.Operation),
+		RestoredFrom: int32Ptr(revision.RestoredFrom),
+		ChangedAt:    timestamppb.New(revision.ChangedAt),
+	}
+	if revision.ActorID != nil {
+		actorID := int32(*revision.ActorID)
+		resp.ActorId = &actorID
+	}
+	if revision.Before != nil {
+		resp.Before = convertScanRuleToProto(revision.Before)
+	}
+	if revision.After != nil {
+		resp.After = convertScanRuleToProto(revision.After)
+	}
+	return resp
+}
+
+func scanRuleOperationToProto(operation common.ScanRuleOperation) ScanRuleOperation {
+	switch operation {
+	case common.ScanRuleInserted:
+		return ScanRuleOperation_SCAN_RULE_OPERATION_INSERT
+	case common.ScanRuleUpdated:
+		return ScanRuleOperation_SCAN_RULE_OPERATION_UPDATE
+	case common.ScanRuleDeleted:
+		return ScanRuleOperation_SCAN_RULE_OPERATION_DELETE
+	}
+	return ScanRuleOperation_SCAN_RULE_OPERATION_UNSPECIFIED
+}
@@ -0,0 +1,32 @@
+package data_processor
+
+import (
+	"data_processor/internal/common"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertScanRuleRevision(t *testing.T) {
+	actorID := common.UserID(5)
+	blocking := true
+	revision := &common.ScanRuleRevision{
+		ID:        3,
+		RuleID:    7,
+		Operation: common.ScanRuleDeleted,
+		ActorID:   &actorID,
+		ChangedAt: time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+		Before:    &common.ScanRule{ID: 7, OrganizationID: 1, ActiveBlockingSCA: &blocking, Revision: 2},
+	}
+
+	converted := convertScanRuleRevisionToProto(revision)
+	assert.Equal(t, ScanRuleOperation_SCAN_RULE_OPERATION_DELETE, converted.Operation)
+	assert.Equal(t, int32(5), converted.GetActorId())
+	assert.Nil(t, converted.RestoredFrom)
+	assert.True(t, converted.Before.GetActiveBlockingSca())
+	assert.Equal(t, etag(2), converted.Before.Etag)
+	// У удаления нет состояния после изменения
+	assert.Nil(t, converted.After)
+	assert.Equal(t, revision.ChangedAt, converted.ChangedAt.AsTime())
+}
@@ -3,15 +3,21 @@ package data_processor
 import (
 	"context"
 	"data_processor/internal/common"
+	"data_processor/internal/excludes"
+	"errors"
+	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"slices"
 )
 
 func (s *Server) CreateScanRule(ctx context.Context, req *CreateScanRuleRequest) (*ScanRule, error) {
 	rule := &common.ScanRule{
-		ApplicationID:         int(req.ApplicationId),
-		TeamID:                int(req.TeamId),
+		ApplicationID:         intPtr(req.ApplicationId),
+		TeamID:                intPtr(req.TeamId),
 		OrganizationID:        int(req.OrganizationId),
 		SCAScanEnabled:        req.ScaScanEnabled,
 		SASTScanEnabled:       req.SastScanEnabled,
@@ -22,8 +28,20 @@ func (s *Server) CreateScanRule(ctx context.Context, req *CreateScanRuleRequest)
 		ActiveBlockingSCA:     req.ActiveBlockingSca,
 	}
 
+	if _, err := compileExcludes(rule.ExcludeDirRegexpQueue, "exclude_dir_regexp_queue"); err != nil {
+		return nil, err
+	}
+
+	// Приложение должно принадлежать команде, а команда — организации
+	if err := s.repositories.CheckScanRuleScope(ctx, rule.ApplicationID, rule.TeamID, rule.OrganizationID); err != nil {
+		return nil, repoError(err, "check scan rule scope")
+	}
+	if err := s.checkScanRuleUnique(ctx, rule); err != nil {
+		return nil, err
+	}
+
 	if err := s.repositories.CreateScanRule(ctx, rule); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create scan rule: %v", err)
+		return nil, repoError(err, "create scan rule")
 	}
 
 	return convertScanRuleToProto(rule), nil
@@ -32,10 +50,7 @@ func (s *Server) CreateScanRule(ctx context.Context, req *CreateScanRuleRequest)
 func (s *Server) GetScanRule(ctx context.Context, req *GetScanRuleRequest) (*ScanRule, error) {
 	rule, err := s.repositories.GetScanRuleByID(ctx, int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get scan rule: %v", err)
-	}
-	if rule == nil {
-		return nil, status.Errorf(codes.NotFound, "scan rule not found")
+		return nil, repoError(err, "get scan rule")
 	}
 
 	return convertScanRuleToProto(rule), nil
@@ -44,142 +59,261 @@ func (s *Server) GetScanRule(ctx context.Context, req *GetScanRuleRequest) (*Sca
 func (s *Server) GetScanRuleByComposite(ctx context.Context, req *GetScanRuleByCompositeRequest) (*ScanRule, error) {
 	rule, err := s.repositories.GetScanRuleByComposite(
 		ctx,
-		int(req.ApplicationId),
-		int(req.TeamId),
+		intPtr(req.ApplicationId),
+		intPtr(req.TeamId),
 		int(req.OrganizationId),
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get scan rule: %v", err)
-	}
-	if rule == nil {
-		return nil, status.Errorf(codes.NotFound, "scan rule not found")
+		return nil, repoError(err, "get scan rule")
 	}
 
 	return convertScanRuleToProto(rule), nil
 }
 
 func (s *Server) UpdateScanRule(ctx context.Context, req *UpdateScanRuleRequest) (*ScanRule, error) {
-	currentRule, err := s.repositories.GetScanRuleByID(ctx, int(req.Id))
+	fields, err := updatePaths(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current scan rule: %v", err)
+		return nil, err
 	}
-	if currentRule == nil {
-		return nil, status.Errorf(codes.NotFound, "scan rule not found")
+	if err := requireMaskedIDs(req, fields, "organization_id"); err != nil {
+		return nil, err
 	}
-
-	updatedRule := &common.ScanRule{
-		ID: int(req.Id),
+	revision, err := revisionFromEtag(req.Etag)
+	if err != nil {
+		return nil, err
 	}
-
-	// Обновляем основные поля
-	if req.ApplicationId != nil {
-		if _, err := s.repositories.GetApplicationByID(ctx, int(*req.ApplicationId)); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "application with id %d not found", *req.ApplicationId)
+	if len(fields) == 0 {
+		rule, err := s.GetScanRule(ctx, &GetScanRuleRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		if err := checkEtag(rule.Etag, revision, "update scan rule"); err != nil {
+			return nil, err
 		}
-		updatedRule.ApplicationID = int(*req.ApplicationId)
-	} else {
-		updatedRule.ApplicationID = currentRule.ApplicationID
+		return rule, nil
 	}
 
-	if req.TeamId != nil {
-		if _, err := s.repositories.GetTeamByID(ctx, int(*req.TeamId)); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "team with id %d not found", *req.TeamId)
-		}
-		updatedRule.TeamID = int(*req.TeamId)
-	} else {
-		updatedRule.TeamID = currentRule.TeamID
+	rule := &common.ScanRule{
+		ID:                    int(req.Id),
+		Revision:              revision,
+		ApplicationID:         intPtr(req.ApplicationId),
+		TeamID:                intPtr(req.TeamId),
+		OrganizationID:        int(req.GetOrganizationId()),
+		SCAScanEnabled:        req.ScaScanEnabled,
+		SASTScanEnabled:       req.SastScanEnabled,
+		AllowIncrementalScans: req.AllowIncrementalScans,
+		AllowSASTEmptyCode:    req.AllowSastEmptyCode,
+		ExcludeDirRegexpQueue: req.ExcludeDirRegexpQueue,
+		ForcedDoOwnSBOM:       req.ForcedDoOwnSbom,
+		ActiveBlockingSCA:     req.ActiveBlockingSca,
 	}
 
-	if req.OrganizationId != nil {
-		if _, err := s.repositories.GetOrganizationByID(ctx, int(*req.OrganizationId)); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "organization with id %d not found", *req.OrganizationId)
+	if slices.Contains(fields, "exclude_dir_regexp_queue") {
+		if _, err := compileExcludes(rule.ExcludeDirRegexpQueue, "exclude_dir_regexp_queue"); err != nil {
+			return nil, err
 		}
-		updatedRule.OrganizationID = int(*req.OrganizationId)
-	} else {
-		updatedRule.OrganizationID = currentRule.OrganizationID
 	}
 
-	// Обновляем дополнительные поля
-	updatedRule.SCAScanEnabled = getUpdatedBoolValue(req.ScaScanEnabled, currentRule.SCAScanEnabled)
-	updatedRule.SASTScanEnabled = getUpdatedBoolValue(req.SastScanEnabled, currentRule.SASTScanEnabled)
-	updatedRule.AllowIncrementalScans = getUpdatedBoolValue(req.AllowIncrementalScans, currentRule.AllowIncrementalScans)
-	updatedRule.AllowSASTEmptyCode = getUpdatedBoolValue(req.AllowSastEmptyCode, currentRule.AllowSASTEmptyCode)
-	updatedRule.ForcedDoOwnSBOM = getUpdatedBoolValue(req.ForcedDoOwnSbom, currentRule.ForcedDoOwnSBOM)
-	updatedRule.ActiveBlockingSCA = getUpdatedBoolValue(req.ActiveBlockingSca, currentRule.ActiveBlockingSCA)
+	// Смена области правила: новая область должна быть согласованной и ещё не занятой другим правилом
+	if slices.Contains(fields, "application_id") || slices.Contains(fields, "team_id") || slices.Contains(fields, "organization_id") {
+		currentRule, err := s.repositories.GetScanRuleByID(ctx, rule.ID)
+		if err != nil {
+			return nil, repoError(err, "get current scan rule")
+		}
+		scope := &common.ScanRule{
+			ID:             rule.ID,
+			ApplicationID:  currentRule.ApplicationID,
+			TeamID:         currentRule.TeamID,
+			OrganizationID: currentRule.OrganizationID,
+		}
 
-	if req.ExcludeDirRegexpQueue != nil {
-		updatedRule.ExcludeDirRegexpQueue = req.ExcludeDirRegexpQueue
-	} else {
-		updatedRule.ExcludeDirRegexpQueue = currentRule.ExcludeDirRegexpQueue
-	}
+		if slices.Contains(fields, "application_id") {
+			scope.ApplicationID = rule.ApplicationID
+		}
+		if slices.Contains(fields, "team_id") {
+			scope.TeamID = rule.TeamID
+		}
+		if slices.Contains(fields, "organization_id") {
+			scope.OrganizationID = rule.OrganizationID
+		}
 
-	// Проверяем уникальность
-	if existingRule, err := s.repositories.GetScanRuleByComposite(
-		ctx,
-		updatedRule.ApplicationID,
-		updatedRule.TeamID,
-		updatedRule.OrganizationID,
-	); err == nil && existingRule != nil && existingRule.ID != updatedRule.ID {
-		return nil, status.Errorf(
-			codes.AlreadyExists,
-			"scan rule for application %d, team %d and organization %d already exists",
-			updatedRule.ApplicationID,
-			updatedRule.TeamID,
-			updatedRule.OrganizationID,
-		)
-	} else if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check scan rule uniqueness: %v", err)
+		// Права на новый, более широкий уровень проверяются по полю запроса, поэтому при переносе
+		// правила вверх по иерархии это поле должно быть задано явно
+		if scope.Level() != currentRule.Level() {
+			if err := movedScopeSet(req, scope.Level()); err != nil {
+				return nil, repoError(err, "update scan rule")
+			}
+		}
+
+		if err := s.repositories.CheckScanRuleScope(ctx, scope.ApplicationID, scope.TeamID, scope.OrganizationID); err != nil {
+			return nil, repoError(err, "check scan rule scope")
+		}
+		if err := s.checkScanRuleUnique(ctx, scope); err != nil {
+			return nil, err
+		}
 	}
 
-	if err := s.repositories.UpdateScanRule(ctx, updatedRule); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update scan rule: %v", err)
+	updatedRule, err := s.repositories.UpdateScanRule(ctx, rule, fields)
+	if err != nil {
+		return nil, repoError(err, "update scan rule")
 	}
 
 	return convertScanRuleToProto(updatedRule), nil
 }
 
 func (s *Server) DeleteScanRule(ctx context.Context, req *DeleteScanRuleRequest) (*emptypb.Empty, error) {
-	if err := s.repositories.DeleteScanRule(ctx, int(req.Id)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete scan rule: %v", err)
+	revision, err := revisionFromEtag(req.Etag)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repositories.DeleteScanRule(ctx, int(req.Id), revision); err != nil {
+		return nil, repoError(err, "delete scan rule")
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) ListScanRules(ctx context.Context, req *ListScanRulesRequest) (*ListScanRulesResponse, error) {
-	rules, err := s.repositories.ListScanRules(ctx)
+	page, err := s.pageFromRequest(req, "scan_rules")
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list scan rules: %v", err)
+		return nil, err
 	}
 
-	// Применяем пагинацию
-	total := len(rules)
-	offset := int(req.Offset)
-	if offset > total {
-		offset = total
+	rules, total, err := s.repositories.ListScanRules(ctx, page.Page)
+	if err != nil {
+		return nil, listError(err, "scan rules")
 	}
-	end := offset + int(req.Limit)
-	if end > total {
-		end = total
+
+	rules, nextPageToken, err := nextPage(s, rules, page, func(item *common.ScanRule) common.PageKey {
+		return common.PageKey{ID: item.ID}
+	})
+	if err != nil {
+		return nil, err
 	}
-	paginatedRules := rules[offset:end]
 
 	resp := &ListScanRulesResponse{
-		ScanRules:  make([]*ScanRule, 0, len(paginatedRules)),
-		TotalCount: int32(total),
+		ScanRules:     make([]*ScanRule, 0, len(rules)),
+		TotalCount:    int32(total),
+		NextPageToken: nextPageToken,
 	}
 
-	for _, rule := range paginatedRules {
+	for _, rule := range rules {
 		resp.ScanRules = append(resp.ScanRules, convertScanRuleToProto(rule))
 	}
 
 	return resp, nil
 }
 
+func (s *Server) GetEffectiveScanRule(ctx context.Context, req *GetEffectiveScanRuleRequest) (*EffectiveScanRule, error) {
+	eff, err := s.repositories.GetEffectiveScanRule(ctx, int(req.ApplicationId))
+	if err != nil {
+		return nil, repoError(err, "get effective scan rule")
+	}
+
+	return convertEffectiveScanRuleToProto(eff), nil
+}
+
+func (s *Server) TestExcludes(ctx context.Context, req *TestExcludesRequest) (*TestExcludesResponse, error) {
+	var matcher *excludes.Matcher
+	switch rule := req.Rule.(type) {
+	case *TestExcludesRequest_RuleId:
+		stored, err := s.repositories.GetScanRuleByID(ctx, int(rule.RuleId))
+		if err != nil {
+			return nil, repoError(err, "get scan rule")
+		}
+		// Правила, сохранённые до проверки шаблонов, могут содержать некорректные выражения
+		matcher, err = excludes.Compile(stored.ExcludeDirRegexpQueue)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "scan rule %d has an invalid exclude pattern: %v", stored.ID, err)
+		}
+	case *TestExcludesRequest_Draft:
+		var err error
+		matcher, err = compileExcludes(rule.Draft.GetExcludeDirRegexpQueue(), "draft.exclude_dir_regexp_queue")
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either rule_id or draft is required")
+	}
+
+	resp := &TestExcludesResponse{Results: make([]*PathExclusion, 0, len(req.Paths))}
+	for _, path := range req.Paths {
+		result := &PathExclusion{Path: path}
+		if pattern, index, ok := matcher.Match(path); ok {
+			result.Excluded = true
+			result.Pattern = pattern
+			result.PatternIndex = proto.Int32(int32(index))
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
+
+// checkScanRuleUnique проверяет, что на уровне правила нет другого правила. Одновременные
+// запросы эта проверка не различает, их разводит уникальный индекс idx_scan_rules_scope
+func (s *Server) checkScanRuleUnique(ctx context.Context, rule *common.ScanRule) error {
+	existingRule, err := s.repositories.GetScanRuleByComposite(ctx, rule.ApplicationID, rule.TeamID, rule.OrganizationID)
+	if err == nil && existingRule.ID != rule.ID {
+		return status.Errorf(codes.AlreadyExists, "scan rule for %s already exists", scanRuleScopeName(rule))
+	}
+	if err != nil && !common.IsNotFound(err) {
+		return repoError(err, "check scan rule uniqueness")
+	}
+	return nil
+}
+
+// movedScopeSet проверяет, что при смене уровня правила поле нового уровня задано в запросе
+func movedScopeSet(req *UpdateScanRuleRequest, level common.ScanRuleLevel) error {
+	switch {
+	case level == common.ScanRuleLevelTeam && req.TeamId == nil:
+		return common.ValidationError("team_id", "must be set to move the rule to the team level")
+	case level == common.ScanRuleLevelOrganization && req.OrganizationId == nil:
+		return common.ValidationError("organization_id", "must be set to move the rule to the organization level")
+	}
+	return nil
+}
+
+func scanRuleScopeName(rule *common.ScanRule) string {
+	switch rule.Level() {
+	case common.ScanRuleLevelApplication:
+		return fmt.Sprintf("application %d", *rule.ApplicationID)
+	case common.ScanRuleLevelTeam:
+		return fmt.Sprintf("team %d", *rule.TeamID)
+	}
+	return fmt.Sprintf("organization %d", rule.OrganizationID)
+}
+
+func scanRuleLevelToProto(level common.ScanRuleLevel) ScanRuleLevel {
+	switch level {
+	case common.ScanRuleLevelOrganization:
+		return ScanRuleLevel_SCAN_RULE_LEVEL_ORGANIZATION
+	case common.ScanRuleLevelTeam:
+		return ScanRuleLevel_SCAN_RULE_LEVEL_TEAM
+	case common.ScanRuleLevelApplication:
+		return ScanRuleLevel_SCAN_RULE_LEVEL_APPLICATION
+	}
+	return ScanRuleLevel_SCAN_RULE_LEVEL_UNSPECIFIED
+}
+
+// compileExcludes компилирует очередь исключений; некорректный шаблон — InvalidArgument с
+// нарушением поля field[i]
+func compileExcludes(patterns []string, field string) (*excludes.Matcher, error) {
+	matcher, err := excludes.Compile(patterns)
+	var patternErr *excludes.Error
+	if errors.As(err, &patternErr) {
+		return nil, withDetails(status.Newf(codes.InvalidArgument, "invalid %s: %v", field, err),
+			&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       fmt.Sprintf("%s[%d]", field, patternErr.Index),
+				Description: fmt.Sprintf("%s at position %d", patternErr.Msg, patternErr.Pos),
+			}}})
+	}
+	return matcher, err
+}
+
 func convertScanRuleToProto(rule *common.ScanRule) *ScanRule {
 	protoRule := &ScanRule{
 		Id:                    int32(rule.ID),
-		ApplicationId:         int32(rule.ApplicationID),
-		TeamId:                int32(rule.TeamID),
+		ApplicationId:         int32Ptr(rule.ApplicationID),
+		TeamId:                int32Ptr(rule.TeamID),
 		OrganizationId:        int32(rule.OrganizationID),
 		ExcludeDirRegexpQueue: rule.ExcludeDirRegexpQueue,
 		ScaScanEnabled:        rule.SCAScanEnabled,
@@ -188,14 +322,35 @@ func convertScanRuleToProto(rule *common.ScanRule) *ScanRule {
 		AllowSastEmptyCode:    rule.AllowSASTEmptyCode,
 		ForcedDoOwnSbom:       rule.ForcedDoOwnSBOM,
 		ActiveBlockingSca:     rule.ActiveBlockingSCA,
+		Etag:                  etag(rule.Revision),
 	}
 	return protoRule
 }
 
-func getUpdatedBoolValue(newVal *bool, currentVal *bool) *bool {
-	if newVal != nil {
-		val := newVal
-		return val
+func convertEffectiveScanRuleToProto(eff *common.EffectiveScanRule) *EffectiveScanRule {
+	resp := &EffectiveScanRule{
+		ApplicationId:         int32(eff.ApplicationID),
+		TeamId:                int32(eff.TeamID),
+		OrganizationId:        int32(eff.OrganizationID),
+		ScaScanEnabled:        eff.Settings.SCAScanEnabled,
+		SastScanEnabled:       eff.Settings.SASTScanEnabled,
+		AllowIncrementalScans: eff.Settings.AllowIncrementalScans,
+		AllowSastEmptyCode:    eff.Settings.AllowSASTEmptyCode,
+		ExcludeDirRegexpQueue: eff.Settings.ExcludeDirRegexpQueue,
+		ForcedDoOwnSbom:       eff.Settings.ForcedDoOwnSBOM,
+		ActiveBlockingSca:     eff.Settings.ActiveBlockingSCA,
+		Sources:               make([]*ScanRuleSource, 0, len(eff.Sources)),
+		Rules:                 make([]*ScanRule, 0, len(eff.Chain)),
+	}
+	for _, source := range eff.Sources {
+		resp.Sources = append(resp.Sources, &ScanRuleSource{
+			Field:  source.Field,
+			Level:  scanRuleLevelToProto(source.Level),
+			RuleId: int32(source.RuleID),
+		})
+	}
+	for _, rule := range eff.Chain {
+		resp.Rules = append(resp.Rules, convertScanRuleToProto(rule))
 	}
-	return currentVal
+	return resp
 }
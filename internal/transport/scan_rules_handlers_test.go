@@ -0,0 +1,77 @@
+package data_processor
+
+import (
+	"context"
+	"data_processor/internal/common"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTestExcludesDraft(t *testing.T) {
+	s := &Server{}
+	resp, err := s.TestExcludes(context.Background(), &TestExcludesRequest{
+		Rule:  &TestExcludesRequest_Draft{Draft: &ScanRule{ExcludeDirRegexpQueue: []string{"^build", "vendor"}}},
+		Paths: []string{"src/main.go", "third_party/vendor/lib.go", "build/out/app"},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+
+	assert.False(t, resp.Results[0].Excluded)
+	assert.Nil(t, resp.Results[0].PatternIndex)
+
+	assert.True(t, resp.Results[1].Excluded)
+	assert.Equal(t, "vendor", resp.Results[1].Pattern)
+	assert.Equal(t, int32(1), resp.Results[1].GetPatternIndex())
+
+	assert.Equal(t, "build/out/app", resp.Results[2].Path)
+	assert.Equal(t, int32(0), resp.Results[2].GetPatternIndex())
+
+	_, err = s.TestExcludes(context.Background(), &TestExcludesRequest{Paths: []string{"a.go"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCompileExcludes(t *testing.T) {
+	_, err := compileExcludes([]string{"vendor", "dist[z-a]"}, "exclude_dir_regexp_queue")
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 1)
+	assert.Equal(t, "exclude_dir_regexp_queue[1]", badRequest.FieldViolations[0].Field)
+	assert.Contains(t, badRequest.FieldViolations[0].Description, "at position 6")
+
+	_, err = compileExcludes(nil, "exclude_dir_regexp_queue")
+	assert.NoError(t, err)
+}
+
+func TestMovedScopeSet(t *testing.T) {
+	// Перенос на уровень команды требует явного team_id: по нему проверяются права
+	err := movedScopeSet(&UpdateScanRuleRequest{Id: 1}, common.ScanRuleLevelTeam)
+	assert.ErrorIs(t, err, common.ErrValidation)
+	assert.NoError(t, movedScopeSet(&UpdateScanRuleRequest{Id: 1, TeamId: proto.Int32(2)}, common.ScanRuleLevelTeam))
+
+	err = movedScopeSet(&UpdateScanRuleRequest{Id: 1, TeamId: proto.Int32(2)}, common.ScanRuleLevelOrganization)
+	assert.ErrorIs(t, err, common.ErrValidation)
+	assert.NoError(t, movedScopeSet(&UpdateScanRuleRequest{Id: 1, OrganizationId: proto.Int32(3)}, common.ScanRuleLevelOrganization))
+
+	assert.NoError(t, movedScopeSet(&UpdateScanRuleRequest{Id: 1, ApplicationId: proto.Int32(4)}, common.ScanRuleLevelApplication))
+}
+
+func TestConvertScanRuleLevel(t *testing.T) {
+	teamID := 2
+	rule := &common.ScanRule{OrganizationID: 1, TeamID: &teamID}
+	assert.Equal(t, common.ScanRuleLevelTeam, rule.Level())
+	assert.Equal(t, "team 2", scanRuleScopeName(rule))
+
+	converted := convertScanRuleToProto(rule)
+	assert.Nil(t, converted.ApplicationId)
+	assert.Equal(t, int32(2), converted.GetTeamId())
+	assert.Equal(t, ScanRuleLevel_SCAN_RULE_LEVEL_TEAM, scanRuleLevelToProto(rule.Level()))
+}
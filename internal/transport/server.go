@@ -1,6 +1,11 @@
 package data_processor
 
-import "data_processor/internal/repo"
+import (
+	"data_processor/internal/auth"
+	"data_processor/internal/common"
+	"data_processor/internal/repo"
+	"data_processor/internal/scanevents"
+)
 
 type Server struct {
 	UnimplementedUserServiceServer
@@ -13,12 +18,30 @@ type Server struct {
 	UnimplementedScanRuleServiceServer
 	UnimplementedPermissionServiceServer
 	UnimplementedRoleServiceServer
+	UnimplementedAuthServiceServer
 
 	repositories *repo.PgxRepository
+	tokens       *auth.TokenIssuer
+	pageTokens   *auth.PageTokenSigner
+	scanQueue    common.ScanQueueOptions
+	scanEvents   *scanevents.Hub
 }
 
-func NewServer(repo *repo.PgxRepository) *Server {
+func NewServer(repo *repo.PgxRepository, tokens *auth.TokenIssuer, pageTokens *auth.PageTokenSigner) *Server {
 	return &Server{
 		repositories: repo,
+		tokens:       tokens,
+		pageTokens:   pageTokens,
+		scanQueue:    common.DefaultScanQueueOptions,
 	}
 }
+
+// SetScanQueueOptions replaces common.DefaultScanQueueOptions used by LeaseScan and HeartbeatScan.
+func (s *Server) SetScanQueueOptions(opts common.ScanQueueOptions) {
+	s.scanQueue = opts
+}
+
+// SetScanEvents enables WatchScan; without a hub it fails with Unavailable.
+func (s *Server) SetScanEvents(hub *scanevents.Hub) {
+	s.scanEvents = hub
+}
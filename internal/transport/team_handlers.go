@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *Server) CreateTeam(ctx context.Context, req *CreateTeamRequest) (*Team, error) {
@@ -17,7 +18,7 @@ func (s *Server) CreateTeam(ctx context.Context, req *CreateTeamRequest) (*Team,
 	}
 
 	if err := s.repositories.CreateTeam(ctx, team); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create team: %v", err)
+		return nil, repoError(err, "create team")
 	}
 
 	return &Team{
@@ -26,16 +27,14 @@ func (s *Server) CreateTeam(ctx context.Context, req *CreateTeamRequest) (*Team,
 		OwnerId:        int32(team.OwnerID),
 		Folder:         team.Folder,
 		OrganizationId: int32(team.OrganizationID),
+		Etag:           etag(team.Revision),
 	}, nil
 }
 
 func (s *Server) GetTeam(ctx context.Context, req *GetTeamRequest) (*Team, error) {
 	team, err := s.repositories.GetTeamByID(ctx, int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get team: %v", err)
-	}
-	if team == nil {
-		return nil, status.Errorf(codes.NotFound, "team not found")
+		return nil, repoError(err, "get team")
 	}
 
 	return &Team{
@@ -44,16 +43,14 @@ func (s *Server) GetTeam(ctx context.Context, req *GetTeamRequest) (*Team, error
 		OwnerId:        int32(team.OwnerID),
 		Folder:         team.Folder,
 		OrganizationId: int32(team.OrganizationID),
+		Etag:           etag(team.Revision),
 	}, nil
 }
 
 func (s *Server) GetTeamByName(ctx context.Context, req *GetTeamByNameRequest) (*Team, error) {
 	team, err := s.repositories.GetTeamByName(ctx, req.Name)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get team: %v", err)
-	}
-	if team == nil {
-		return nil, status.Errorf(codes.NotFound, "team not found")
+		return nil, repoError(err, "get team")
 	}
 
 	return &Team{
@@ -62,48 +59,44 @@ func (s *Server) GetTeamByName(ctx context.Context, req *GetTeamByNameRequest) (
 		OwnerId:        int32(team.OwnerID),
 		Folder:         team.Folder,
 		OrganizationId: int32(team.OrganizationID),
+		Etag:           etag(team.Revision),
 	}, nil
 }
 
 func (s *Server) UpdateTeam(ctx context.Context, req *UpdateTeamRequest) (*Team, error) {
-	currentTeam, err := s.repositories.GetTeamByID(ctx, int(req.Id))
+	fields, err := updatePaths(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current team: %v", err)
+		return nil, err
 	}
-	if currentTeam == nil {
-		return nil, status.Errorf(codes.NotFound, "team not found")
-	}
-
-	updatedTeam := &common.Team{
-		ID: int(req.Id),
+	if err := requireMaskedIDs(req, fields, "owner_id", "organization_id"); err != nil {
+		return nil, err
 	}
-
-	if req.TeamName != nil {
-		updatedTeam.TeamName = *req.TeamName
-	} else {
-		updatedTeam.TeamName = currentTeam.TeamName
+	revision, err := revisionFromEtag(req.Etag)
+	if err != nil {
+		return nil, err
 	}
-
-	if req.OwnerId != nil {
-		updatedTeam.OwnerID = common.UserID(*req.OwnerId)
-	} else {
-		updatedTeam.OwnerID = currentTeam.OwnerID
+	if len(fields) == 0 {
+		team, err := s.GetTeam(ctx, &GetTeamRequest{Id: req.Id})
+		if err != nil {
+			return nil, err
+		}
+		if err := checkEtag(team.Etag, revision, "update team"); err != nil {
+			return nil, err
+		}
+		return team, nil
 	}
 
-	if req.Folder != nil {
-		updatedTeam.Folder = req.Folder
-	} else {
-		updatedTeam.Folder = currentTeam.Folder
-	}
-
-	if req.OrganizationId != nil {
-		updatedTeam.OrganizationID = int(*req.OrganizationId)
-	} else {
-		updatedTeam.OrganizationID = currentTeam.OrganizationID
+	team := &common.Team{
+		ID:             int(req.Id),
+		Revision:       revision,
+		TeamName:       req.GetTeamName(),
+		OwnerID:        common.UserID(req.GetOwnerId()),
+		Folder:         req.Folder,
+		OrganizationID: int(req.GetOrganizationId()),
 	}
-
-	if err := s.repositories.UpdateTeam(ctx, updatedTeam); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update team: %v", err)
+	updatedTeam, err := s.repositories.UpdateTeam(ctx, team, fields)
+	if err != nil {
+		return nil, repoError(err, "update team")
 	}
 
 	return &Team{
@@ -112,23 +105,40 @@ func (s *Server) UpdateTeam(ctx context.Context, req *UpdateTeamRequest) (*Team,
 		OwnerId:        int32(updatedTeam.OwnerID),
 		Folder:         updatedTeam.Folder,
 		OrganizationId: int32(updatedTeam.OrganizationID),
+		Etag:           etag(updatedTeam.Revision),
 	}, nil
 }
 
 func (s *Server) DeleteTeam(ctx context.Context, req *DeleteTeamRequest) (*emptypb.Empty, error) {
-	if err := s.repositories.DeleteTeam(ctx, int(req.Id)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete team: %v", err)
+	revision, err := revisionFromEtag(req.Etag)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repositories.DeleteTeam(ctx, int(req.Id), revision); err != nil {
+		return nil, repoError(err, "delete team")
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) ListTeams(ctx context.Context, req *ListTeamsRequest) (*ListTeamsResponse, error) {
-	teams, err := s.repositories.ListTeams(ctx)
+	page, err := s.pageFromRequest(req, "teams")
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list teams: %v", err)
+		return nil, err
 	}
 
-	resp := &ListTeamsResponse{}
+	teams, total, err := s.repositories.ListTeams(ctx, page.Page)
+	if err != nil {
+		return nil, listError(err, "teams")
+	}
+
+	teams, nextPageToken, err := nextPage(s, teams, page, func(item *common.Team) common.PageKey {
+		return common.PageKey{ID: item.ID}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListTeamsResponse{TotalCount: int32(total), NextPageToken: nextPageToken}
 	for _, team := range teams {
 		resp.Teams = append(resp.Teams, &Team{
 			Id:             int32(team.ID),
@@ -136,6 +146,7 @@ func (s *Server) ListTeams(ctx context.Context, req *ListTeamsRequest) (*ListTea
 			OwnerId:        int32(team.OwnerID),
 			Folder:         team.Folder,
 			OrganizationId: int32(team.OrganizationID),
+			Etag:           etag(team.Revision),
 		})
 	}
 
@@ -145,7 +156,7 @@ func (s *Server) ListTeams(ctx context.Context, req *ListTeamsRequest) (*ListTea
 func (s *Server) ListTeamsByOrganization(ctx context.Context, req *ListByParentRequest) (*ListTeamsResponse, error) {
 	teams, err := s.repositories.ListTeamsByOrganization(ctx, int(req.ParentId))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list teams by organization: %v", err)
+		return nil, repoError(err, "list teams by organization")
 	}
 
 	resp := &ListTeamsResponse{}
@@ -156,6 +167,7 @@ func (s *Server) ListTeamsByOrganization(ctx context.Context, req *ListByParentR
 			OwnerId:        int32(team.OwnerID),
 			Folder:         team.Folder,
 			OrganizationId: int32(team.OrganizationID),
+			Etag:           etag(team.Revision),
 		})
 	}
 
@@ -165,7 +177,7 @@ func (s *Server) ListTeamsByOrganization(ctx context.Context, req *ListByParentR
 func (s *Server) ListTeamsByOwner(ctx context.Context, req *ListByOwnerRequest) (*ListTeamsResponse, error) {
 	teams, err := s.repositories.ListTeamsByOwner(ctx, int(req.OwnerId))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list teams by owner: %v", err)
+		return nil, repoError(err, "list teams by owner")
 	}
 
 	resp := &ListTeamsResponse{}
@@ -176,8 +188,96 @@ func (s *Server) ListTeamsByOwner(ctx context.Context, req *ListByOwnerRequest)
 			OwnerId:        int32(team.OwnerID),
 			Folder:         team.Folder,
 			OrganizationId: int32(team.OrganizationID),
+			Etag:           etag(team.Revision),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *Server) AddMember(ctx context.Context, req *AddTeamMemberRequest) (*TeamMember, error) {
+	role, ok := teamMemberRolesFromProto[req.Role]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported team member role %s", req.Role)
+	}
+
+	member := &common.TeamMember{
+		TeamID: int(req.TeamId),
+		UserID: common.UserID(req.UserId),
+		Role:   role,
+	}
+	if err := s.repositories.AddTeamMember(ctx, member); err != nil {
+		return nil, repoError(err, "add team member")
+	}
+
+	return convertTeamMemberToProto(member), nil
+}
+
+func (s *Server) RemoveMember(ctx context.Context, req *RemoveTeamMemberRequest) (*emptypb.Empty, error) {
+	if err := s.repositories.RemoveTeamMember(ctx, int(req.TeamId), common.UserID(req.UserId)); err != nil {
+		return nil, repoError(err, "remove team member")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListMembers(ctx context.Context, req *ListTeamMembersRequest) (*ListTeamMembersResponse, error) {
+	members, err := s.repositories.ListTeamMembers(ctx, int(req.TeamId))
+	if err != nil {
+		return nil, repoError(err, "list team members")
+	}
+
+	resp := &ListTeamMembersResponse{}
+	for _, member := range members {
+		resp.Members = append(resp.Members, convertTeamMemberToProto(member))
+	}
+
+	return resp, nil
+}
+
+func (s *Server) ListTeamsForUser(ctx context.Context, req *ListTeamsForUserRequest) (*ListTeamsForUserResponse, error) {
+	memberships, err := s.repositories.ListTeamsForUser(ctx, common.UserID(req.UserId))
+	if err != nil {
+		return nil, repoError(err, "list teams for user")
+	}
+
+	resp := &ListTeamsForUserResponse{}
+	for _, membership := range memberships {
+		resp.Memberships = append(resp.Memberships, &TeamMembership{
+			Team: &Team{
+				Id:             int32(membership.Team.ID),
+				TeamName:       membership.Team.TeamName,
+				OwnerId:        int32(membership.Team.OwnerID),
+				Folder:         membership.Team.Folder,
+				OrganizationId: int32(membership.Team.OrganizationID),
+				Etag:           etag(membership.Team.Revision),
+			},
+			Role: teamMemberRoleToProto(membership.Role),
 		})
 	}
 
 	return resp, nil
 }
+
+var teamMemberRolesFromProto = map[TeamMemberRole]common.TeamMemberRole{
+	TeamMemberRole_TEAM_MEMBER_ROLE_MAINTAINER: common.TeamMemberMaintainer,
+	TeamMemberRole_TEAM_MEMBER_ROLE_DEVELOPER:  common.TeamMemberDeveloper,
+	TeamMemberRole_TEAM_MEMBER_ROLE_VIEWER:     common.TeamMemberViewer,
+}
+
+func teamMemberRoleToProto(role common.TeamMemberRole) TeamMemberRole {
+	for protoRole, r := range teamMemberRolesFromProto {
+		if r == role {
+			return protoRole
+		}
+	}
+	return TeamMemberRole_TEAM_MEMBER_ROLE_UNSPECIFIED
+}
+
+func convertTeamMemberToProto(member *common.TeamMember) *TeamMember {
+	return &TeamMember{
+		TeamId:    int32(member.TeamID),
+		UserId:    int32(member.UserID),
+		Role:      teamMemberRoleToProto(member.Role),
+		CreatedAt: timestamppb.New(member.CreatedAt),
+	}
+}
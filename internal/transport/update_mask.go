@@ -0,0 +1,69 @@
+package data_processor
+
+import (
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// updateRequest is implemented by every Update request.
+type updateRequest interface {
+	proto.Message
+	GetUpdateMask() *fieldmaskpb.FieldMask
+}
+
+// updatePaths возвращает поля запроса, которые нужно изменить. С update_mask это пути маски:
+// поле из маски, не заданное в запросе, очищается. Без маски изменяются все заданные поля.
+// Имена полей запроса совпадают с именами столбцов в репозитории.
+func updatePaths(req updateRequest) ([]string, error) {
+	msg := req.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+
+	var paths []string
+	if mask := req.GetUpdateMask(); mask != nil {
+		for _, path := range mask.GetPaths() {
+			field := fields.ByName(protoreflect.Name(path))
+			if field == nil || !updatable(field) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask path %q", path)
+			}
+			if !slices.Contains(paths, path) {
+				paths = append(paths, path)
+			}
+		}
+		return paths, nil
+	}
+
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if updatable(field) && msg.Has(field) {
+			paths = append(paths, string(field.Name()))
+		}
+	}
+	return paths, nil
+}
+
+// updatable: id и etag выбирают строку, а update_mask описывает само обновление — сами они не изменяются
+func updatable(field protoreflect.FieldDescriptor) bool {
+	switch field.Name() {
+	case "id", "update_mask", "etag":
+		return false
+	}
+	return true
+}
+
+// requireMaskedIDs отклоняет маску, очищающую ссылку на родительскую сущность: такие столбцы
+// не допускают NULL, и незаданный идентификатор записался бы как 0, нарушив внешний ключ
+func requireMaskedIDs(req updateRequest, fields []string, names ...string) error {
+	msg := req.ProtoReflect()
+	for _, name := range names {
+		field := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if slices.Contains(fields, name) && msg.Get(field).Int() == 0 {
+			return status.Errorf(codes.InvalidArgument, "%s cannot be cleared", name)
+		}
+	}
+	return nil
+}
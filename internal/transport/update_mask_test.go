@@ -0,0 +1,68 @@
+package data_processor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestUpdatePaths(t *testing.T) {
+	// Без маски обновляются заданные поля в порядке их номеров; id не входит в обновление
+	paths, err := updatePaths(&UpdateScanRuleRequest{
+		Id:                    1,
+		SastScanEnabled:       proto.Bool(false),
+		TeamId:                proto.Int32(3),
+		ExcludeDirRegexpQueue: []string{"vendor"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"team_id", "sast_scan_enabled", "exclude_dir_regexp_queue"}, paths)
+
+	// Пустой список без маски не отличить от отсутствующего — его можно очистить только маской
+	paths, err = updatePaths(&UpdateScanRuleRequest{Id: 1, ExcludeDirRegexpQueue: []string{}})
+	require.NoError(t, err)
+	assert.Empty(t, paths)
+
+	// С маской обновляются только её поля, даже если заданы и другие
+	paths, err = updatePaths(&UpdateScanRuleRequest{
+		Id:              1,
+		SastScanEnabled: proto.Bool(true),
+		UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"exclude_dir_regexp_queue", "exclude_dir_regexp_queue"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"exclude_dir_regexp_queue"}, paths)
+
+	// etag — условие обновления, а не изменяемое поле
+	paths, err = updatePaths(&UpdateRoleRequest{Id: 1, Etag: "3", Name: proto.String("auditor")})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name"}, paths)
+
+	for _, path := range []string{"id", "update_mask", "etag", "unknown", "scope", "name.first", ""} {
+		_, err = updatePaths(&UpdateUserRequest{Id: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{path}}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), path)
+	}
+
+	// Поле oneof указывается по собственному имени
+	paths, err = updatePaths(&UpdatePermissionRequest{Id: 1, Scope: &UpdatePermissionRequest_TeamId{TeamId: 2}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"team_id"}, paths)
+}
+
+func TestRequireMaskedIDs(t *testing.T) {
+	req := &UpdateTeamRequest{Id: 1, OwnerId: proto.Int32(2), UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"owner_id", "organization_id"}}}
+	paths, err := updatePaths(req)
+	require.NoError(t, err)
+	err = requireMaskedIDs(req, paths, "owner_id", "organization_id")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "organization_id")
+
+	// Поля вне маски не проверяются
+	req.UpdateMask.Paths = []string{"owner_id"}
+	paths, err = updatePaths(req)
+	require.NoError(t, err)
+	assert.NoError(t, requireMaskedIDs(req, paths, "owner_id", "organization_id"))
+}
@@ -2,117 +2,155 @@ package data_processor
 
 import (
 	"context"
+	"data_processor/internal/auth"
 	"data_processor/internal/common"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"slices"
 )
 
 func (s *Server) CreateUser(ctx context.Context, req *CreateUserRequest) (*User, error) {
+	if req.Password == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "password cannot be empty")
+	}
+
 	user := &common.User{
 		Name:     req.Name,
 		Password: req.Password,
 	}
 
 	if err := s.repositories.CreateUser(ctx, user); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
+		return nil, repoError(err, "create user")
 	}
 
-	return &User{
-		Id:   int32(user.ID),
-		Name: user.Name,
-	}, nil
+	return convertUserToProto(user), nil
 }
 
 func (s *Server) GetUser(ctx context.Context, req *GetUserRequest) (*User, error) {
 	user, err := s.repositories.GetUserByID(ctx, common.UserID(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
-	}
-	if user == nil {
-		return nil, status.Errorf(codes.NotFound, "user not found")
+		return nil, repoError(err, "get user")
 	}
 
-	return &User{
-		Id:   int32(user.ID),
-		Name: user.Name,
-	}, nil
+	return convertUserToProto(user), nil
 }
 
 func (s *Server) GetUserByName(ctx context.Context, req *GetUserByNameRequest) (*User, error) {
 	user, err := s.repositories.GetUserByName(ctx, req.Name)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
-	}
-	if user == nil {
-		return nil, status.Errorf(codes.NotFound, "user not found")
+		return nil, repoError(err, "get user")
 	}
 
-	return &User{
-		Id:   int32(user.ID),
-		Name: user.Name,
-	}, nil
+	return convertUserToProto(user), nil
 }
 
 func (s *Server) UpdateUser(ctx context.Context, req *UpdateUserRequest) (*User, error) {
-	// Получаем текущие данные пользователя
-	currentUser, err := s.repositories.GetUserByID(ctx, common.UserID(req.Id))
+	fields, err := updatePaths(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user data: %v", err)
-	}
-	if currentUser == nil {
-		return nil, status.Errorf(codes.NotFound, "user not found")
+		return nil, err
 	}
 
-	// Подготавливаем обновленные данные
-	updatedUser := &common.User{
-		ID: common.UserID(req.Id),
+	// Пароль нельзя очистить: пустое значение отклоняется
+	if slices.Contains(fields, "password") && req.GetPassword() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "password cannot be empty")
 	}
 
-	// Обрабатываем Name
-	if req.Name != nil {
-		updatedUser.Name = *req.Name // Используем новое значение
-	} else {
-		updatedUser.Name = currentUser.Name // Сохраняем текущее значение
+	// Права администратора и воркера может менять только администратор; передать текущее значение можно
+	if principal, ok := auth.PrincipalFromContext(ctx); !ok || !principal.IsAdmin {
+		var currentUser *common.User
+		for _, flag := range []struct {
+			field     string
+			requested bool
+			current   func(*common.User) bool
+		}{
+			{"is_admin", req.GetIsAdmin(), func(u *common.User) bool { return u.IsAdmin }},
+			{"is_worker", req.GetIsWorker(), func(u *common.User) bool { return u.IsWorker }},
+		} {
+			i := slices.Index(fields, flag.field)
+			if i < 0 {
+				continue
+			}
+			if currentUser == nil {
+				if currentUser, err = s.repositories.GetUserByID(ctx, common.UserID(req.Id)); err != nil {
+					return nil, repoError(err, "get current user data")
+				}
+			}
+			if flag.requested != flag.current(currentUser) {
+				return nil, status.Errorf(codes.PermissionDenied, "administrator access required to change %s", flag.field)
+			}
+			fields = slices.Delete(fields, i, i+1)
+		}
 	}
 
-	// Обрабатываем Password
-	if req.Password != nil {
-		updatedUser.Password = *req.Password // Используем новый пароль
-	} else {
-		updatedUser.Password = currentUser.Password // Сохраняем текущий пароль
+	if len(fields) == 0 {
+		return s.GetUser(ctx, &GetUserRequest{Id: req.Id})
 	}
 
-	// Выполняем обновление
-	if err := s.repositories.UpdateUser(ctx, updatedUser); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
+	user := &common.User{
+		ID:       common.UserID(req.Id),
+		Name:     req.GetName(),
+		Password: req.GetPassword(),
+		IsAdmin:  req.GetIsAdmin(),
+		IsWorker: req.GetIsWorker(),
+	}
+	updatedUser, err := s.repositories.UpdateUser(ctx, user, fields)
+	if err != nil {
+		return nil, repoError(err, "update user")
 	}
 
-	return &User{
-		Id:   int32(updatedUser.ID),
-		Name: updatedUser.Name,
-	}, nil
+	return convertUserToProto(updatedUser), nil
 }
 func (s *Server) DeleteUser(ctx context.Context, req *DeleteUserRequest) (*emptypb.Empty, error) {
 	if err := s.repositories.DeleteUser(ctx, common.UserID(req.Id)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
+		return nil, repoError(err, "delete user")
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error) {
-	users, err := s.repositories.ListUsers(ctx)
+	page, err := s.pageFromRequest(req, "users")
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+		return nil, err
 	}
 
-	resp := &ListUsersResponse{}
+	users, total, err := s.repositories.ListUsers(ctx, page.Page)
+	if err != nil {
+		return nil, listError(err, "users")
+	}
+
+	users, nextPageToken, err := nextPage(s, users, page, func(item *common.User) common.PageKey {
+		return common.PageKey{ID: int(item.ID)}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListUsersResponse{TotalCount: int32(total), NextPageToken: nextPageToken}
 	for _, user := range users {
-		resp.Users = append(resp.Users, &User{
-			Id:   int32(user.ID),
-			Name: user.Name,
-		})
+		resp.Users = append(resp.Users, convertUserToProto(user))
 	}
 
 	return resp, nil
 }
+
+func (s *Server) VerifyCredentials(ctx context.Context, req *VerifyCredentialsRequest) (*User, error) {
+	user, err := s.repositories.VerifyCredentials(ctx, req.Name, req.Password)
+	if err != nil {
+		return nil, repoError(err, "verify credentials")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
+	}
+
+	return convertUserToProto(user), nil
+}
+
+func convertUserToProto(user *common.User) *User {
+	return &User{
+		Id:       int32(user.ID),
+		Name:     user.Name,
+		IsAdmin:  user.IsAdmin,
+		IsWorker: user.IsWorker,
+	}
+}
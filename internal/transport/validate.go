@@ -0,0 +1,194 @@
+package data_processor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ValidationUnaryInterceptor checks requests against the FieldRules declared in processor.proto
+// and rejects invalid ones with InvalidArgument before they reach the handler.
+func ValidationUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if message, ok := req.(proto.Message); ok {
+			if err := validateMessage(message); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ValidationStreamInterceptor checks every message received on a streaming RPC like
+// ValidationUnaryInterceptor does for unary ones.
+func ValidationStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatedStream{ServerStream: ss})
+	}
+}
+
+type validatedStream struct {
+	grpc.ServerStream
+	// received — число полученных сообщений: пути полей считаются внутри сообщения,
+	// поэтому ошибка называет и его номер
+	received int
+}
+
+func (s *validatedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	s.received++
+	if message, ok := m.(proto.Message); ok {
+		return checkMessage(message, fmt.Sprintf("invalid message %d of the stream", s.received))
+	}
+	return nil
+}
+
+// validateMessage возвращает InvalidArgument со списком всех нарушений или nil
+func validateMessage(message proto.Message) error {
+	return checkMessage(message, "invalid request")
+}
+
+func checkMessage(message proto.Message, summary string) error {
+	violations := collectViolations(message.ProtoReflect(), "")
+	if len(violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(violations))
+	for _, v := range violations {
+		descriptions = append(descriptions, v.Field+": "+v.Description)
+	}
+	return withDetails(status.New(codes.InvalidArgument, summary+": "+strings.Join(descriptions, "; ")),
+		&errdetails.BadRequest{FieldViolations: violations})
+}
+
+func collectViolations(msg protoreflect.Message, prefix string) []*errdetails.BadRequest_FieldViolation {
+	// Поля, которые маска обновления очищает, проверяются как нулевые значения
+	var cleared []string
+	if mask, ok := msg.Interface().(interface{ GetUpdateMask() *fieldmaskpb.FieldMask }); ok {
+		cleared = mask.GetUpdateMask().GetPaths()
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		path := prefix + string(field.Name())
+		rules := fieldRules(field)
+
+		if rules != nil {
+			// optional-поля и варианты oneof необязательны: без значения они проверяются, только
+			// если их очищает маска
+			set := msg.Has(field)
+			if set || field.ContainingOneof() == nil || slices.Contains(cleared, string(field.Name())) {
+				for _, description := range checkField(field, msg.Get(field), set, rules) {
+					violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: path, Description: description})
+				}
+			}
+		}
+
+		// Вложенные сообщения проверяются по их собственным правилам
+		if field.Kind() != protoreflect.MessageKind || field.IsMap() || !msg.Has(field) {
+			continue
+		}
+		if field.IsList() {
+			list := msg.Get(field).List()
+			for j := 0; j < list.Len(); j++ {
+				violations = append(violations, collectViolations(list.Get(j).Message(), fmt.Sprintf("%s[%d].", path, j))...)
+			}
+		} else {
+			violations = append(violations, collectViolations(msg.Get(field).Message(), path+".")...)
+		}
+	}
+	return violations
+}
+
+func fieldRules(field protoreflect.FieldDescriptor) *FieldRules {
+	options, ok := field.Options().(*descriptorpb.FieldOptions)
+	if !ok || options == nil || !proto.HasExtension(options, E_Rules) {
+		return nil
+	}
+	return proto.GetExtension(options, E_Rules).(*FieldRules)
+}
+
+// checkField возвращает описания нарушений правил для значения поля
+func checkField(field protoreflect.FieldDescriptor, value protoreflect.Value, set bool, rules *FieldRules) []string {
+	if field.IsList() {
+		list := value.List()
+		var problems []string
+		if rules.Required && list.Len() == 0 {
+			problems = append(problems, "must not be empty")
+		}
+		if rules.MaxItems != nil && uint32(list.Len()) > rules.GetMaxItems() {
+			problems = append(problems, fmt.Sprintf("must contain at most %d items", rules.GetMaxItems()))
+		}
+		for j := 0; j < list.Len(); j++ {
+			for _, problem := range checkValue(field, list.Get(j), true, rules) {
+				problems = append(problems, fmt.Sprintf("item %d %s", j, problem))
+			}
+		}
+		return problems
+	}
+	return checkValue(field, value, set, rules)
+}
+
+func checkValue(field protoreflect.FieldDescriptor, value protoreflect.Value, set bool, rules *FieldRules) []string {
+	var problems []string
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		length := uint32(utf8.RuneCountInString(value.String()))
+		if rules.Required && length == 0 && !field.IsList() {
+			problems = append(problems, "is required")
+		}
+		if rules.MinLen != nil && length < rules.GetMinLen() {
+			if length == 0 {
+				problems = append(problems, "must not be empty")
+			} else {
+				problems = append(problems, fmt.Sprintf("must be at least %d characters long", rules.GetMinLen()))
+			}
+		}
+		if rules.MaxLen != nil && length > rules.GetMaxLen() {
+			problems = append(problems, fmt.Sprintf("must be at most %d characters long", rules.GetMaxLen()))
+		}
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n := value.Int()
+		if rules.Required && n == 0 && !field.IsList() {
+			problems = append(problems, "is required")
+		}
+		if rules.Gt != nil && n <= rules.GetGt() {
+			problems = append(problems, fmt.Sprintf("must be greater than %d", rules.GetGt()))
+		}
+		if rules.Gte != nil && n < rules.GetGte() {
+			problems = append(problems, fmt.Sprintf("must be greater than or equal to %d", rules.GetGte()))
+		}
+		if rules.Lte != nil && n > rules.GetLte() {
+			problems = append(problems, fmt.Sprintf("must be less than or equal to %d", rules.GetLte()))
+		}
+
+	case protoreflect.EnumKind:
+		if rules.Required && value.Enum() == 0 && !field.IsList() {
+			problems = append(problems, "is required")
+		}
+
+	case protoreflect.MessageKind:
+		if rules.Required && !set {
+			problems = append(problems, "is required")
+		}
+	}
+	return problems
+}
@@ -0,0 +1,107 @@
+package data_processor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// violatedFields возвращает поля из BadRequest ошибки валидации
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+
+	var fields []string
+	for _, v := range badRequest.FieldViolations {
+		fields = append(fields, v.Field)
+	}
+	return fields
+}
+
+func TestValidateMessage(t *testing.T) {
+	assert.NoError(t, validateMessage(&CreateOrganizationRequest{ProjectName: "core", OwnerId: 1}))
+	assert.NoError(t, validateMessage(&CreateScanRequest{ScanDate: timestamppb.Now(), VersionId: 3, ScanType: ScanType_SCAN_TYPE_SCA}))
+
+	// Все нарушения возвращаются сразу
+	err := validateMessage(&CreateOrganizationRequest{OwnerId: -1})
+	assert.Equal(t, []string{"project_name", "owner_id"}, violatedFields(t, err))
+
+	err = validateMessage(&CreateVersionRequest{ApplicationId: 1, Version: strings.Repeat("1", 51)})
+	assert.Equal(t, []string{"version"}, violatedFields(t, err))
+
+	// Длина считается в символах, а не в байтах
+	assert.NoError(t, validateMessage(&CreateVersionRequest{ApplicationId: 1, Version: strings.Repeat("я", 50)}))
+
+	err = validateMessage(&CreateScanRequest{VersionId: 3})
+	assert.Equal(t, []string{"scan_date", "scan_type"}, violatedFields(t, err))
+
+	err = validateMessage(&CreateScanRuleRequest{
+		ApplicationId: proto.Int32(1), TeamId: proto.Int32(2), OrganizationId: 3,
+		ExcludeDirRegexpQueue: []string{"vendor", "", strings.Repeat("a", 256)},
+	})
+	assert.Equal(t, []string{"exclude_dir_regexp_queue", "exclude_dir_regexp_queue"}, violatedFields(t, err))
+
+	err = validateMessage(&ReportProgressRequest{Id: 1, WorkerId: "w1", Progress: 101})
+	assert.Equal(t, []string{"progress"}, violatedFields(t, err))
+
+	// Вложенные сообщения проверяются по своим правилам
+	err = validateMessage(&CheckPermissionsRequest{UserId: 1, Checks: []*PermissionCheck{{ResourceId: 1}, {ResourceId: 0}}})
+	assert.Equal(t, []string{"checks[1].resource_id"}, violatedFields(t, err))
+}
+
+func TestValidateUpdateMessage(t *testing.T) {
+	// Незаданные optional-поля не проверяются
+	assert.NoError(t, validateMessage(&UpdateTeamRequest{Id: 1, Folder: proto.String("/dev")}))
+
+	// Заданное значение проверяется
+	err := validateMessage(&UpdateTeamRequest{Id: 1, TeamName: proto.String("")})
+	assert.Equal(t, []string{"team_name"}, violatedFields(t, err))
+
+	// Очистка маской проверяется как нулевое значение: имя очистить нельзя, папку можно
+	err = validateMessage(&UpdateTeamRequest{Id: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"team_name", "folder", "owner_id"}}})
+	assert.Equal(t, []string{"team_name", "owner_id"}, violatedFields(t, err))
+
+	err = validateMessage(&UpdateScanRequest{Id: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"scan_date"}}})
+	assert.Equal(t, []string{"scan_date"}, violatedFields(t, err))
+
+	err = validateMessage(&UpdateOrganizationRequest{Id: -5})
+	assert.Equal(t, []string{"id"}, violatedFields(t, err))
+}
+
+// recvStream отдаёт одно заранее заданное сообщение
+type recvStream struct {
+	grpc.ServerStream
+	msg proto.Message
+}
+
+func (s *recvStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.msg)
+	return nil
+}
+
+func TestValidationStreamInterceptor(t *testing.T) {
+	interceptor := ValidationStreamInterceptor()
+	recv := func(msg proto.Message) error {
+		return interceptor(nil, &recvStream{msg: msg}, &grpc.StreamServerInfo{}, func(srv interface{}, ss grpc.ServerStream) error {
+			return ss.RecvMsg(&WatchScanRequest{})
+		})
+	}
+
+	assert.NoError(t, recv(&WatchScanRequest{Id: 1}))
+	err := recv(&WatchScanRequest{})
+	assert.Equal(t, []string{"id"}, violatedFields(t, err))
+	assert.Contains(t, status.Convert(err).Message(), "invalid message 1 of the stream")
+}
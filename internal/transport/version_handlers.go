@@ -3,9 +3,11 @@ package data_processor
 import (
 	"context"
 	"data_processor/internal/common"
+	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"slices"
 )
 
 func (s *Server) CreateVersion(ctx context.Context, req *CreateVersionRequest) (*Version, error) {
@@ -15,7 +17,7 @@ func (s *Server) CreateVersion(ctx context.Context, req *CreateVersionRequest) (
 	}
 
 	if err := s.repositories.CreateVersion(ctx, version); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create version: %v", err)
+		return nil, repoError(err, "create version")
 	}
 
 	return &Version{
@@ -28,10 +30,7 @@ func (s *Server) CreateVersion(ctx context.Context, req *CreateVersionRequest) (
 func (s *Server) GetVersion(ctx context.Context, req *GetVersionRequest) (*Version, error) {
 	version, err := s.repositories.GetVersionByID(ctx, int(req.Id))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get version: %v", err)
-	}
-	if version == nil {
-		return nil, status.Errorf(codes.NotFound, "version not found")
+		return nil, repoError(err, "get version")
 	}
 
 	return &Version{
@@ -44,10 +43,7 @@ func (s *Server) GetVersion(ctx context.Context, req *GetVersionRequest) (*Versi
 func (s *Server) GetVersionByNumber(ctx context.Context, req *GetVersionByNumberRequest) (*Version, error) {
 	version, err := s.repositories.GetVersionByNumber(ctx, int(req.ApplicationId), req.Version)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get version: %v", err)
-	}
-	if version == nil {
-		return nil, status.Errorf(codes.NotFound, "version not found")
+		return nil, repoError(err, "get version")
 	}
 
 	return &Version{
@@ -58,55 +54,51 @@ func (s *Server) GetVersionByNumber(ctx context.Context, req *GetVersionByNumber
 }
 
 func (s *Server) UpdateVersion(ctx context.Context, req *UpdateVersionRequest) (*Version, error) {
-	// Получаем текущую версию
-	currentVersion, err := s.repositories.GetVersionByID(ctx, int(req.Id))
+	fields, err := updatePaths(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current version: %v", err)
+		return nil, err
 	}
-	if currentVersion == nil {
-		return nil, status.Errorf(codes.NotFound, "version not found")
+	if err := requireMaskedIDs(req, fields, "application_id"); err != nil {
+		return nil, err
 	}
-
-	// Подготавливаем обновленные данные
-	updatedVersion := &common.Version{
-		ID: int(req.Id),
+	if len(fields) == 0 {
+		return s.GetVersion(ctx, &GetVersionRequest{Id: req.Id})
 	}
 
-	// Обрабатываем ApplicationID (если не передано - оставляем текущее)
-	if req.ApplicationId != nil {
-		updatedVersion.ApplicationID = int(*req.ApplicationId)
-	} else {
-		updatedVersion.ApplicationID = currentVersion.ApplicationID
+	version := &common.Version{
+		ID:            int(req.Id),
+		ApplicationID: int(req.GetApplicationId()),
+		Version:       req.GetVersion(),
 	}
 
-	// Обрабатываем Version (если не передано - оставляем текущее)
-	if req.Version != nil {
-		updatedVersion.Version = *req.Version
-	} else {
-		updatedVersion.Version = currentVersion.Version
+	// Номер версии уникален в пределах приложения: недостающую половину пары берём из текущей строки
+	currentVersion, err := s.repositories.GetVersionByID(ctx, version.ID)
+	if err != nil {
+		return nil, repoError(err, "get current version")
+	}
+	appID, number := currentVersion.ApplicationID, currentVersion.Version
+	if slices.Contains(fields, "application_id") {
+		appID = version.ApplicationID
+	}
+	if slices.Contains(fields, "version") {
+		number = version.Version
 	}
 
-	// Проверяем, что версия с такими параметрами не существует
-	if existingVersion, err := s.repositories.GetVersionByNumber(
-		ctx,
-		updatedVersion.ApplicationID,
-		updatedVersion.Version,
-	); err == nil && existingVersion != nil && existingVersion.ID != updatedVersion.ID {
+	if existingVersion, err := s.repositories.GetVersionByNumber(ctx, appID, number); err == nil && existingVersion.ID != version.ID {
 		return nil, status.Errorf(
 			codes.AlreadyExists,
 			"version %s already exists for this application",
-			updatedVersion.Version,
+			number,
 		)
-	} else if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check version uniqueness: %v", err)
+	} else if err != nil && !common.IsNotFound(err) {
+		return nil, repoError(err, "check version uniqueness")
 	}
 
-	// Обновляем версию
-	if err := s.repositories.UpdateVersion(ctx, updatedVersion); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update version: %v", err)
+	updatedVersion, err := s.repositories.UpdateVersion(ctx, version, fields)
+	if err != nil {
+		return nil, repoError(err, "update version")
 	}
 
-	// Возвращаем обновленную версию
 	return &Version{
 		Id:            int32(updatedVersion.ID),
 		ApplicationId: int32(updatedVersion.ApplicationID),
@@ -116,18 +108,31 @@ func (s *Server) UpdateVersion(ctx context.Context, req *UpdateVersionRequest) (
 
 func (s *Server) DeleteVersion(ctx context.Context, req *DeleteVersionRequest) (*emptypb.Empty, error) {
 	if err := s.repositories.DeleteVersion(ctx, int(req.Id)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete version: %v", err)
+		return nil, repoError(err, "delete version")
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Server) ListVersions(ctx context.Context, req *ListVersionsRequest) (*ListVersionsResponse, error) {
-	versions, err := s.repositories.ListVersions(ctx, int(req.ApplicationId))
+	list := fmt.Sprintf("versions:%d", req.ApplicationId)
+	page, err := s.pageFromRequest(req, list)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, total, err := s.repositories.ListVersions(ctx, int(req.ApplicationId), page.Page)
+	if err != nil {
+		return nil, listError(err, "versions")
+	}
+
+	versions, nextPageToken, err := nextPage(s, versions, page, func(item *common.Version) common.PageKey {
+		return common.PageKey{ID: item.ID}
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list versions: %v", err)
+		return nil, err
 	}
 
-	resp := &ListVersionsResponse{}
+	resp := &ListVersionsResponse{TotalCount: int32(total), NextPageToken: nextPageToken}
 	for _, version := range versions {
 		resp.Versions = append(resp.Versions, &Version{
 			Id:            int32(version.ID),
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE sessions (
+                          id SERIAL PRIMARY KEY,
+                          user_id INTEGER NOT NULL,
+                          refresh_token_hash VARCHAR(64) NOT NULL UNIQUE,
+                          user_agent VARCHAR(512),
+                          created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                          last_used_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                          expires_at TIMESTAMPTZ NOT NULL,
+                          revoked_at TIMESTAMPTZ,
+                          FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_sessions_user_id ON sessions(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS sessions;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE team_members (
+                              team_id INTEGER NOT NULL,
+                              user_id INTEGER NOT NULL,
+                              role VARCHAR(16) NOT NULL CHECK (role IN ('maintainer', 'developer', 'viewer')),
+                              created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                              PRIMARY KEY (team_id, user_id),
+                              FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
+                              FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_team_members_user_id ON team_members(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS team_members;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Постраничные списки версий и сканов фильтруют по родителю и сортируют по id
+CREATE INDEX idx_versions_application_id ON versions(application_id, id);
+CREATE INDEX idx_scans_version_id ON scans(version_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_scans_version_id;
+DROP INDEX IF EXISTS idx_versions_application_id;
+-- +goose StatementEnd
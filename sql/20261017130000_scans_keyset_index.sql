@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Сканы листаются по (scan_date, id): дата не уникальна, id делает порядок однозначным
+DROP INDEX IF EXISTS idx_scans_version_id;
+CREATE INDEX idx_scans_version_id ON scans(version_id, scan_date, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_scans_version_id;
+CREATE INDEX idx_scans_version_id ON scans(version_id, id);
+-- +goose StatementEnd
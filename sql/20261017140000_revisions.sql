@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- revision увеличивается при каждом UPDATE и отдаётся клиентам как etag
+ALTER TABLE organizations ADD COLUMN revision INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE teams ADD COLUMN revision INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE applications ADD COLUMN revision INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE scan_rules ADD COLUMN revision INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE roles ADD COLUMN revision INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE permissions ADD COLUMN revision INTEGER NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE permissions DROP COLUMN IF EXISTS revision;
+ALTER TABLE roles DROP COLUMN IF EXISTS revision;
+ALTER TABLE scan_rules DROP COLUMN IF EXISTS revision;
+ALTER TABLE applications DROP COLUMN IF EXISTS revision;
+ALTER TABLE teams DROP COLUMN IF EXISTS revision;
+ALTER TABLE organizations DROP COLUMN IF EXISTS revision;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Правило задаётся для организации, команды или приложения: незаданные поля наследуются от более широкого уровня
+ALTER TABLE scan_rules ALTER COLUMN application_id DROP NOT NULL;
+ALTER TABLE scan_rules ALTER COLUMN team_id DROP NOT NULL;
+ALTER TABLE scan_rules ADD CONSTRAINT scan_rules_team_id_check CHECK (application_id IS NULL OR team_id IS NOT NULL);
+-- Цепочка правил приложения выбирается по организации
+CREATE INDEX idx_scan_rules_scope ON scan_rules(organization_id, team_id, application_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_scan_rules_scope;
+ALTER TABLE scan_rules DROP CONSTRAINT IF EXISTS scan_rules_team_id_check;
+DELETE FROM scan_rules WHERE application_id IS NULL OR team_id IS NULL;
+ALTER TABLE scan_rules ALTER COLUMN team_id SET NOT NULL;
+ALTER TABLE scan_rules ALTER COLUMN application_id SET NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+-- История изменений правил сканирования: снимки строки до и после каждого INSERT/UPDATE/DELETE.
+-- Внешних ключей нет намеренно: история удалённых правил и пользователей сохраняется.
+CREATE TABLE scan_rule_revisions (
+    id SERIAL PRIMARY KEY,
+    rule_id INTEGER NOT NULL,
+    operation VARCHAR(10) NOT NULL,
+    -- actor_id и restored_from задаёт репозиторий через set_config в транзакции изменения;
+    -- у изменений в обход репозитория (например, каскадного удаления) автор не известен
+    actor_id INTEGER,
+    restored_from INTEGER,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    before JSONB,
+    after JSONB
+);
+CREATE INDEX idx_scan_rule_revisions_rule_id ON scan_rule_revisions(rule_id, id);
+
+CREATE FUNCTION record_scan_rule_revision() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO scan_rule_revisions (rule_id, operation, actor_id, restored_from, before, after)
+    VALUES (
+        CASE WHEN TG_OP = 'DELETE' THEN OLD.id ELSE NEW.id END,
+        lower(TG_OP),
+        NULLIF(current_setting('data_processor.actor_id', true), '')::int,
+        NULLIF(current_setting('data_processor.restored_from', true), '')::int,
+        CASE WHEN TG_OP <> 'INSERT' THEN to_jsonb(OLD) END,
+        CASE WHEN TG_OP <> 'DELETE' THEN to_jsonb(NEW) END
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER scan_rules_history
+    AFTER INSERT OR UPDATE OR DELETE ON scan_rules
+    FOR EACH ROW EXECUTE FUNCTION record_scan_rule_revision();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS scan_rules_history ON scan_rules;
+DROP FUNCTION IF EXISTS record_scan_rule_revision();
+DROP TABLE IF EXISTS scan_rule_revisions;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- scan_date — момент создания скана; тип DATE терял время суток
+ALTER TABLE scans ALTER COLUMN scan_date TYPE TIMESTAMPTZ USING scan_date::timestamp AT TIME ZONE 'UTC';
+ALTER TABLE scans ALTER COLUMN scan_date SET DEFAULT NOW();
+
+ALTER TABLE scans ADD COLUMN status TEXT NOT NULL DEFAULT 'queued'
+    CONSTRAINT scans_status_check CHECK (status IN ('queued', 'running', 'succeeded', 'failed', 'cancelled'));
+-- Тип неизвестен только у сканов, созданных до его появления
+ALTER TABLE scans ADD COLUMN scan_type TEXT
+    CONSTRAINT scans_scan_type_check CHECK (scan_type IN ('sast', 'sca'));
+ALTER TABLE scans ADD COLUMN initiator_id INTEGER REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE scans ADD COLUMN started_at TIMESTAMPTZ;
+ALTER TABLE scans ADD COLUMN finished_at TIMESTAMPTZ;
+ALTER TABLE scans ADD COLUMN exit_reason TEXT;
+
+-- Существующие сканы записывались по факту выполнения
+UPDATE scans SET status = 'succeeded', started_at = scan_date, finished_at = scan_date;
+
+-- Время завершения есть ровно у завершённых сканов
+ALTER TABLE scans ADD CONSTRAINT scans_finished_at_check
+    CHECK ((status IN ('queued', 'running')) = (finished_at IS NULL));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE scans DROP CONSTRAINT IF EXISTS scans_finished_at_check;
+ALTER TABLE scans DROP COLUMN IF EXISTS exit_reason;
+ALTER TABLE scans DROP COLUMN IF EXISTS finished_at;
+ALTER TABLE scans DROP COLUMN IF EXISTS started_at;
+ALTER TABLE scans DROP COLUMN IF EXISTS initiator_id;
+ALTER TABLE scans DROP COLUMN IF EXISTS scan_type;
+ALTER TABLE scans DROP COLUMN IF EXISTS status;
+ALTER TABLE scans ALTER COLUMN scan_date SET DEFAULT CURRENT_DATE;
+ALTER TABLE scans ALTER COLUMN scan_date TYPE DATE USING (scan_date AT TIME ZONE 'UTC')::date;
+-- +goose StatementEnd
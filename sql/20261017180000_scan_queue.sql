@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Очередь сканов: воркер арендует скан до lease_expires_at и продлевает аренду; просроченная
+-- аренда возвращает скан в очередь не раньше available_at
+ALTER TABLE scans ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE scans ADD COLUMN available_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+ALTER TABLE scans ADD COLUMN lease_owner TEXT;
+ALTER TABLE scans ADD COLUMN lease_expires_at TIMESTAMPTZ;
+ALTER TABLE scans ADD CONSTRAINT scans_lease_owner_check
+    CHECK ((lease_owner IS NULL) = (lease_expires_at IS NULL) AND (lease_owner IS NULL OR status = 'running'));
+
+CREATE INDEX idx_scans_queue ON scans(available_at, id) WHERE status = 'queued';
+CREATE INDEX idx_scans_lease ON scans(lease_expires_at) WHERE lease_expires_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_scans_lease;
+DROP INDEX IF EXISTS idx_scans_queue;
+ALTER TABLE scans DROP CONSTRAINT IF EXISTS scans_lease_owner_check;
+ALTER TABLE scans DROP COLUMN IF EXISTS lease_expires_at;
+ALTER TABLE scans DROP COLUMN IF EXISTS lease_owner;
+ALTER TABLE scans DROP COLUMN IF EXISTS available_at;
+ALTER TABLE scans DROP COLUMN IF EXISTS attempts;
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Ход выполнения скана, о котором сообщает воркер
+ALTER TABLE scans ADD COLUMN phase TEXT;
+ALTER TABLE scans ADD COLUMN progress SMALLINT NOT NULL DEFAULT 0
+    CONSTRAINT scans_progress_check CHECK (progress BETWEEN 0 AND 100);
+ALTER TABLE scans ADD COLUMN progress_message TEXT;
+
+-- Изменения статуса и хода скана рассылаются через NOTIFY всем репликам; в сообщении только id,
+-- состояние слушатели читают сами
+CREATE FUNCTION notify_scan_change() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('scan_changes', (CASE WHEN TG_OP = 'DELETE' THEN OLD.id ELSE NEW.id END)::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER scans_notify_update
+    AFTER UPDATE ON scans
+    FOR EACH ROW
+    WHEN (OLD.status IS DISTINCT FROM NEW.status
+        OR OLD.phase IS DISTINCT FROM NEW.phase
+        OR OLD.progress IS DISTINCT FROM NEW.progress
+        OR OLD.progress_message IS DISTINCT FROM NEW.progress_message)
+    EXECUTE FUNCTION notify_scan_change();
+
+CREATE TRIGGER scans_notify_delete
+    AFTER DELETE ON scans
+    FOR EACH ROW EXECUTE FUNCTION notify_scan_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS scans_notify_delete ON scans;
+DROP TRIGGER IF EXISTS scans_notify_update ON scans;
+DROP FUNCTION IF EXISTS notify_scan_change();
+ALTER TABLE scans DROP COLUMN IF EXISTS progress_message;
+ALTER TABLE scans DROP COLUMN IF EXISTS progress;
+ALTER TABLE scans DROP COLUMN IF EXISTS phase;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Результаты сканирования; загрузка заменяет все находки скана целиком
+CREATE TABLE findings (
+    id BIGSERIAL PRIMARY KEY,
+    scan_id INTEGER NOT NULL REFERENCES scans(id) ON DELETE CASCADE,
+    tool TEXT NOT NULL,
+    rule_id TEXT NOT NULL,
+    severity TEXT NOT NULL
+        CONSTRAINT findings_severity_check CHECK (severity IN ('info', 'low', 'medium', 'high', 'critical')),
+    cwe INTEGER CONSTRAINT findings_cwe_check CHECK (cwe > 0),
+    file TEXT,
+    start_line INTEGER CONSTRAINT findings_start_line_check CHECK (start_line > 0),
+    end_line INTEGER,
+    message TEXT NOT NULL,
+    fingerprint TEXT NOT NULL,
+    CONSTRAINT findings_end_line_check CHECK (end_line IS NULL OR (start_line IS NOT NULL AND end_line >= start_line))
+);
+
+CREATE INDEX idx_findings_scan ON findings(scan_id, id);
+CREATE INDEX idx_findings_scan_severity ON findings(scan_id, severity);
+CREATE INDEX idx_findings_scan_cwe ON findings(scan_id, cwe);
+CREATE INDEX idx_findings_scan_file ON findings(scan_id, file);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS findings;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Метаданные инструмента и правил, с которыми находки были загружены (например, из SARIF)
+ALTER TABLE findings ADD COLUMN tool_version TEXT;
+ALTER TABLE findings ADD COLUMN partial_fingerprints JSONB NOT NULL DEFAULT '{}';
+
+CREATE TABLE finding_rules (
+    scan_id INTEGER NOT NULL REFERENCES scans(id) ON DELETE CASCADE,
+    tool TEXT NOT NULL,
+    rule_id TEXT NOT NULL,
+    name TEXT NOT NULL DEFAULT '',
+    short_description TEXT NOT NULL DEFAULT '',
+    full_description TEXT NOT NULL DEFAULT '',
+    help TEXT NOT NULL DEFAULT '',
+    help_uri TEXT NOT NULL DEFAULT '',
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    PRIMARY KEY (scan_id, tool, rule_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS finding_rules;
+ALTER TABLE findings DROP COLUMN IF EXISTS partial_fingerprints;
+ALTER TABLE findings DROP COLUMN IF EXISTS tool_version;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Подавление находки; статус есть ровно у подавленных находок
+ALTER TABLE findings ADD COLUMN suppression_kind TEXT
+    CONSTRAINT findings_suppression_kind_check CHECK (suppression_kind IN ('in_source', 'external'));
+ALTER TABLE findings ADD COLUMN suppression_status TEXT
+    CONSTRAINT findings_suppression_status_check CHECK (suppression_status IN ('accepted', 'under_review', 'rejected'));
+ALTER TABLE findings ADD COLUMN suppression_justification TEXT NOT NULL DEFAULT '';
+ALTER TABLE findings ADD CONSTRAINT findings_suppression_check
+    CHECK ((suppression_kind IS NULL) = (suppression_status IS NULL));
+
+-- Сравнение с предыдущим сканом ищет находки по инструменту и отпечатку
+CREATE INDEX idx_findings_scan_fingerprint ON findings(scan_id, tool, fingerprint);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_findings_scan_fingerprint;
+ALTER TABLE findings DROP CONSTRAINT IF EXISTS findings_suppression_check;
+ALTER TABLE findings DROP COLUMN IF EXISTS suppression_justification;
+ALTER TABLE findings DROP COLUMN IF EXISTS suppression_status;
+ALTER TABLE findings DROP COLUMN IF EXISTS suppression_kind;
+-- +goose StatementEnd
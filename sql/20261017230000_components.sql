@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Компоненты SBOM скана и зависимости между ними; ref — bom-ref CycloneDX или SPDXID SPDX
+CREATE TABLE components (
+    id BIGSERIAL PRIMARY KEY,
+    scan_id INTEGER NOT NULL REFERENCES scans(id) ON DELETE CASCADE,
+    ref TEXT NOT NULL,
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    name TEXT NOT NULL,
+    version TEXT,
+    purl TEXT,
+    licenses TEXT[] NOT NULL DEFAULT '{}',
+    hashes JSONB NOT NULL DEFAULT '{}',
+    UNIQUE (scan_id, ref)
+);
+
+CREATE INDEX idx_components_scan_name ON components(scan_id, name);
+CREATE INDEX idx_components_scan_purl ON components(scan_id, purl);
+
+CREATE TABLE component_dependencies (
+    scan_id INTEGER NOT NULL,
+    ref TEXT NOT NULL,
+    depends_on TEXT NOT NULL,
+    PRIMARY KEY (scan_id, ref, depends_on),
+    FOREIGN KEY (scan_id, ref) REFERENCES components(scan_id, ref) ON DELETE CASCADE,
+    FOREIGN KEY (scan_id, depends_on) REFERENCES components(scan_id, ref) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS component_dependencies;
+DROP TABLE IF EXISTS components;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- На каждом уровне остаётся одно правило — самое новое. Удалённые правила попадают в
+-- scan_rule_revisions через триггер и могут быть восстановлены из истории
+DELETE FROM scan_rules r
+USING scan_rules newer
+WHERE newer.organization_id = r.organization_id
+  AND newer.team_id IS NOT DISTINCT FROM r.team_id
+  AND newer.application_id IS NOT DISTINCT FROM r.application_id
+  AND newer.id > r.id;
+
+-- NULLS NOT DISTINCT требует PostgreSQL 15 или новее: без него правила команды и организации
+-- с NULL в application_id или team_id не считались бы дубликатами
+DROP INDEX IF EXISTS idx_scan_rules_scope;
+CREATE UNIQUE INDEX idx_scan_rules_scope ON scan_rules(organization_id, team_id, application_id) NULLS NOT DISTINCT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_scan_rules_scope;
+CREATE INDEX idx_scan_rules_scope ON scan_rules(organization_id, team_id, application_id);
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Вход ищет пользователя по имени, поэтому имя должно быть уникальным. Из дубликатов имя
+-- сохраняет самый старый пользователь, остальным к имени добавляется их id
+UPDATE users u
+SET name = left(u.name, 255 - length('#' || u.id::text)) || '#' || u.id
+WHERE EXISTS (SELECT 1 FROM users older WHERE older.name = u.name AND older.id < u.id);
+
+DROP INDEX IF EXISTS idx_users_name;
+CREATE UNIQUE INDEX idx_users_name ON users(name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_users_name;
+CREATE INDEX idx_users_name ON users(name);
+-- +goose StatementEnd